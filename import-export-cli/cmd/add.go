@@ -23,8 +23,10 @@ import (
 )
 
 const AddCmdLiteral = "add"
-const AddCmdShortDesc = "Add Environment to Config file"
-const AddCmdLongDesc = `Add new environment and its related endpoints to the config file`
+const AddCmdShortDesc = "Add Environment to Config file, or an Application or a subscription to an environment"
+const AddCmdLongDesc = `Add new environment and its related endpoints to the config file
+Add an Application of the user to the environment specified by flag (--environment, -e)
+Subscribe an Application to an API or API Product in the environment specified by flag (--environment, -e)`
 const addCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` production \
 --apim  https://localhost:9443 
 
@@ -0,0 +1,88 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var addAppEnvironment string
+var addAppName string
+var addAppThrottlingPolicy string
+var addAppDescription string
+var addAppTokenType string
+
+// AddApp command related usage Info
+const AddAppCmdLiteral = "app"
+const addAppCmdShortDesc = "Add an Application"
+const addAppCmdLongDesc = "Create an Application of the user in the devportal of the environment specified by the flag --environment, -e"
+
+const addAppCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddAppCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddAppCmdLiteral + ` -n SampleApp --throttling-policy 10PerMin --description "Mobile app" --token-type JWT -e prod
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.
+The first available application throttling policy is used when --throttling-policy is not provided.`
+
+// AddAppCmd represents the add app command
+var AddAppCmd = &cobra.Command{
+	Use:     AddAppCmdLiteral,
+	Short:   addAppCmdShortDesc,
+	Long:    addAppCmdLongDesc,
+	Example: addAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + AddAppCmdLiteral + " called")
+		accessToken := getDevPortalAccessToken(addAppEnvironment)
+		appId, err := impl.AddApplication(accessToken, addAppEnvironment, addAppName, addAppThrottlingPolicy,
+			addAppDescription, addAppTokenType)
+		if err != nil {
+			utils.HandleErrorAndExit("Error adding the Application "+addAppName, err)
+		}
+		fmt.Println("Application " + addAppName + " added successfully. ID: " + appId)
+	},
+}
+
+// getDevPortalAccessToken returns a token to call the devportal REST API of an environment
+func getDevPortalAccessToken(environment string) string {
+	cred, err := GetCredentials(environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	accessToken, err := impl.GetDevPortalAccessToken(cred, environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting access token", err)
+	}
+	return accessToken
+}
+
+func init() {
+	AddCmd.AddCommand(AddAppCmd)
+	AddAppCmd.Flags().StringVarP(&addAppName, "name", "n", "", "Name of the Application")
+	AddAppCmd.Flags().StringVarP(&addAppEnvironment, "environment", "e", "",
+		"Environment to add the Application to")
+	AddAppCmd.Flags().StringVarP(&addAppThrottlingPolicy, "throttling-policy", "", "",
+		"Application throttling policy")
+	AddAppCmd.Flags().StringVarP(&addAppDescription, "description", "", "", "Description of the Application")
+	AddAppCmd.Flags().StringVarP(&addAppTokenType, "token-type", "", "",
+		"Token type of the Application (JWT or OAUTH)")
+	_ = AddAppCmd.MarkFlagRequired("name")
+	_ = AddAppCmd.MarkFlagRequired("environment")
+}
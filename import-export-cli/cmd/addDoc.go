@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// AddDoc command related usage Info
+const AddDocCmdLiteral = "doc"
+const addDocCmdShortDesc = "Add a document to an API or API Product"
+
+const addDocCmdLongDesc = `Add a document to an API or API Product in the environment specified by the flag --environment, -e.
+The document is published as soon as it is added, without updating the API or API Product or creating a new revision.
+The content of the document is read from the file given by --file. A Markdown file is added as a MARKDOWN document,
+an HTML or a text file as an INLINE document and any other file as a FILE document, unless --source-type is given.
+The front-matter of a Markdown, HTML or text file sets the name, type, visibility and summary of the document, which
+are overridden by the flags. The name of the document defaults to the name of the file.`
+
+const addDocCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddDocCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --type HOW_TO --source-type MARKDOWN --file guide.md
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddDocCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name "Terms of Use" --file terms.pdf --visibility PRIVATE
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddDocCmdLiteral + ` -n LeasingProduct -v 1.0.0 --artifact-type api-product -e dev --doc-name Forum --type PUBLIC_FORUM --source-type URL --source-url https://forum.example.com
+NOTE: The flags (--name (-n), --version (-v) and --environment (-e)) are mandatory. Either the flag --file (-f) or the flag --source-url is required.`
+
+// AddDocCmd represents the add doc command
+var AddDocCmd = &cobra.Command{
+	Use:     AddDocCmdLiteral,
+	Short:   addDocCmdShortDesc,
+	Long:    addDocCmdLongDesc,
+	Example: addDocCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + AddDocCmdLiteral + " called")
+		artifact := getDocArtifact()
+		if docFile == "" && docSourceUrl == "" {
+			utils.HandleErrorAndExit("Error adding a document to "+artifact.String(),
+				errors.New("either --file or --source-url should be given"))
+		}
+		document, err := getLocalDocument()
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the document "+docFile, err)
+		}
+		if document.Type == "" {
+			document.Type = impl.DocumentTypeHowTo
+		}
+		accessToken := getPublisherAccessToken(docEnvironment)
+		added, err := impl.AddDocument(accessToken, docEnvironment, artifact, document)
+		if err != nil {
+			utils.HandleErrorAndExit("Error adding the document "+document.Name+" to "+artifact.String(), err)
+		}
+		fmt.Println("Document " + added.Name + " added to " + artifact.String() + ". Document ID: " +
+			added.DocumentId)
+	},
+}
+
+// init using Cobra
+func init() {
+	AddCmd.AddCommand(AddDocCmd)
+	addDocArtifactFlags(AddDocCmd)
+	addDocFlags(AddDocCmd)
+}
@@ -0,0 +1,95 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var addGatewayEnvName string
+var addGatewayEnvDisplayName string
+var addGatewayEnvDescription string
+var addGatewayEnvType string
+var addGatewayEnvGatewayType string
+var addGatewayEnvVhosts []string
+var addGatewayEnvEnvironment string
+
+// AddGatewayEnv command related usage Info
+const AddGatewayEnvCmdLiteral = "gateway-env"
+const addGatewayEnvCmdShortDesc = "Add a gateway environment to an environment"
+const addGatewayEnvCmdLongDesc = `Add a gateway environment with its vhosts to the environment specified by the flag --environment, -e.
+A vhost is defined either by a host name or by comma separated key=value pairs of host, context, http-port, https-port, ws-port and wss-port.
+The ports which are not defined are set to the defaults (80, 443, 9099 and 8099).`
+
+const addGatewayEnvCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddGatewayEnvCmdLiteral + ` -n External --vhost api.example.com -e dev
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddGatewayEnvCmdLiteral + ` -n Internal --display-name "Internal Gateway" --vhost "host=internal.example.com,context=/gw,https-port=8243" -e dev
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddGatewayEnvCmdLiteral + ` -n External --type production --vhost api.example.com --vhost api2.example.com -e dev
+NOTE: The flags (--name (-n), --vhost and --environment (-e)) are mandatory.`
+
+// AddGatewayEnvCmd represents the add gateway-env command
+var AddGatewayEnvCmd = &cobra.Command{
+	Use:     AddGatewayEnvCmdLiteral,
+	Short:   addGatewayEnvCmdShortDesc,
+	Long:    addGatewayEnvCmdLongDesc,
+	Example: addGatewayEnvCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + AddGatewayEnvCmdLiteral + " called")
+		vhosts, err := impl.ParseVhostDefinitions(addGatewayEnvVhosts)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid vhosts", err)
+		}
+		displayName := addGatewayEnvDisplayName
+		if displayName == "" {
+			displayName = addGatewayEnvName
+		}
+		gatewayEnv := utils.GatewayEnvironment{Name: addGatewayEnvName, DisplayName: displayName,
+			Description: addGatewayEnvDescription, Type: addGatewayEnvType, GatewayType: addGatewayEnvGatewayType,
+			Vhosts: vhosts}
+		accessToken := getPublisherAccessToken(addGatewayEnvEnvironment)
+		err = impl.AddGatewayEnvironment(accessToken, addGatewayEnvEnvironment, gatewayEnv)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while adding gateway environment "+addGatewayEnvName, err)
+		}
+		fmt.Println("Gateway environment " + addGatewayEnvName + " added successfully")
+	},
+}
+
+func init() {
+	AddCmd.AddCommand(AddGatewayEnvCmd)
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvName, "name", "n", "", "Name of the gateway environment")
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvDisplayName, "display-name", "", "",
+		"Display name of the gateway environment")
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvDescription, "description", "d", "",
+		"Description of the gateway environment")
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvType, "type", "", "hybrid",
+		"Type of the gateway environment (hybrid, production or sandbox)")
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvGatewayType, "gateway-type", "", "Regular",
+		"Type of the gateway")
+	AddGatewayEnvCmd.Flags().StringArrayVarP(&addGatewayEnvVhosts, "vhost", "", []string{},
+		"Vhost of the gateway environment")
+	AddGatewayEnvCmd.Flags().StringVarP(&addGatewayEnvEnvironment, "environment", "e", "",
+		"Environment to which the gateway environment should be added")
+	_ = AddGatewayEnvCmd.MarkFlagRequired("name")
+	_ = AddGatewayEnvCmd.MarkFlagRequired("vhost")
+	_ = AddGatewayEnvCmd.MarkFlagRequired("environment")
+}
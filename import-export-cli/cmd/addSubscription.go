@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var addSubscriptionEnvironment string
+var addSubscriptionApp string
+var addSubscriptionAPIName string
+var addSubscriptionAPIVersion string
+var addSubscriptionAPIProvider string
+var addSubscriptionTier string
+
+// AddSubscription command related usage Info
+const AddSubscriptionCmdLiteral = "subscription"
+const addSubscriptionCmdShortDesc = "Subscribe an Application to an API or API Product"
+const addSubscriptionCmdLongDesc = "Subscribe an Application of the user to an API or API Product in the environment specified by the flag --environment, -e"
+
+const addSubscriptionCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddSubscriptionCmdLiteral + ` --app SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddSubscriptionCmdLiteral + ` --app SampleApp -n PizzaShackAPI -v 1.0.0 -r admin --tier Gold -e prod
+NOTE: The flags (--app, --name (-n), --version (-v) and --environment (-e)) are mandatory.
+The first subscription tier of the API or API Product is used when --tier is not provided.`
+
+// AddSubscriptionCmd represents the add subscription command
+var AddSubscriptionCmd = &cobra.Command{
+	Use:     AddSubscriptionCmdLiteral,
+	Short:   addSubscriptionCmdShortDesc,
+	Long:    addSubscriptionCmdLongDesc,
+	Example: addSubscriptionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + AddSubscriptionCmdLiteral + " called")
+		accessToken := getDevPortalAccessToken(addSubscriptionEnvironment)
+		subscriptionId, err := impl.AddSubscription(accessToken, addSubscriptionEnvironment, addSubscriptionApp,
+			addSubscriptionAPIName, addSubscriptionAPIVersion, addSubscriptionAPIProvider, addSubscriptionTier)
+		if err != nil {
+			utils.HandleErrorAndExit("Error subscribing the Application "+addSubscriptionApp, err)
+		}
+		fmt.Println("Application " + addSubscriptionApp + " subscribed to " + addSubscriptionAPIName + " " +
+			addSubscriptionAPIVersion + " successfully. Subscription ID: " + subscriptionId)
+	},
+}
+
+func init() {
+	AddCmd.AddCommand(AddSubscriptionCmd)
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionApp, "app", "", "", "Name of the Application")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionAPIName, "name", "n", "",
+		"Name of the API or API Product to subscribe to")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionAPIVersion, "version", "v", "",
+		"Version of the API or API Product")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionAPIProvider, "provider", "r", "",
+		"Provider of the API or API Product")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionTier, "tier", "", "", "Subscription throttling tier")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = AddSubscriptionCmd.MarkFlagRequired("app")
+	_ = AddSubscriptionCmd.MarkFlagRequired("name")
+	_ = AddSubscriptionCmd.MarkFlagRequired("version")
+	_ = AddSubscriptionCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,150 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var applyDir string
+var applyEnvironment string
+var applyParamsDir string
+var applyPrune bool
+var applySelector string
+var applyDryRun bool
+var applyConfirm bool
+
+// Apply command related usage Info
+const ApplyCmdLiteral = "apply"
+const applyCmdShortDesc = "Apply a directory of projects to an environment"
+
+const applyCmdLongDesc = `Apply the API, API Product, application, operation policy and throttling policy projects found in a directory tree
+to an environment. Each project is identified in the environment by its name and version (name and owner for applications)
+to plan whether it is created or updated, and the plan is printed before any change is made. The projects are applied in
+dependency order: throttling policies, operation policies, APIs, API Products and then applications.
+
+The params of an API or API Product for the environment are taken from the same relative path of the directory given by
+--params, or else from the params.yaml of the project. Params which do not include the environment are not used.
+
+With --prune, the APIs, API Products and applications of the environment carrying all the labels of --selector which are
+no longer in the directory are deleted. The labels of APIs and API Products are their additional properties and the
+labels of applications are their attributes. Only the applications of the logged in user are considered for pruning.`
+
+const applyCmdExamples = utils.ProjectName + ` ` + ApplyCmdLiteral + ` -f ~/apim-artifacts -e production --dry-run
+` + utils.ProjectName + ` ` + ApplyCmdLiteral + ` -f ~/apim-artifacts -e production --params ~/deployment/production
+` + utils.ProjectName + ` ` + ApplyCmdLiteral + ` -f ~/apim-artifacts -e production --prune --selector team=payments -y
+` + utils.ProjectName + ` ` + ApplyCmdLiteral + ` ` + ApplyThrottlingPoliciesCmdLiteral + ` -f ~/policies -e production --dry-run
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ApplyCmd represents the apply command
+var ApplyCmd = &cobra.Command{
+	Use:     ApplyCmdLiteral,
+	Short:   applyCmdShortDesc,
+	Long:    applyCmdLongDesc,
+	Example: applyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ApplyCmdLiteral + " called")
+		var selector map[string]string
+		if applyPrune && applySelector == "" {
+			utils.HandleErrorAndExit("Error applying projects",
+				errors.New("--selector is required with --prune to identify the artifacts managed by the directory"))
+		}
+		if applySelector != "" {
+			var err error
+			if selector, err = impl.ParseSelector(applySelector); err != nil {
+				utils.HandleErrorAndExit("Error applying projects", err)
+			}
+		}
+		accessToken := getPublisherAccessToken(applyEnvironment)
+		executeApplyCmd(accessToken, selector)
+	},
+}
+
+func executeApplyCmd(accessToken string, selector map[string]string) {
+	projects, err := impl.DiscoverApplyProjects(applyDir, applyParamsDir, applyEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the projects of "+applyDir, err)
+	}
+	changes, err := impl.PlanProjectSync(accessToken, applyEnvironment, projects, applyPrune, selector)
+	if err != nil {
+		utils.HandleErrorAndExit("Error planning the changes to "+applyEnvironment, err)
+	}
+	impl.PrintApplyPlan(changes)
+
+	pending := 0
+	for _, change := range changes {
+		if change.Action != impl.ApplyActionUnchanged {
+			pending++
+		}
+	}
+	if pending == 0 {
+		fmt.Println("Artifacts of " + applyEnvironment + " are up to date")
+		return
+	}
+	if applyDryRun {
+		fmt.Println("Dry run: " + strconv.Itoa(pending) + " change(s) were not applied")
+		return
+	}
+	if !applyConfirm {
+		confirm, err := utils.ReadInputString("Apply "+strconv.Itoa(pending)+" change(s) to "+applyEnvironment,
+			utils.Default{Value: "N", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+		confirm = strings.ToUpper(confirm)
+		if confirm != "Y" && confirm != "YES" {
+			fmt.Println("Apply cancelled")
+			return
+		}
+	}
+
+	failed := impl.ApplyProjectChanges(accessToken, applyEnvironment, changes)
+	impl.PrintApplyResults(changes)
+	if failed > 0 {
+		fmt.Println(strconv.Itoa(failed) + " of " + strconv.Itoa(pending) + " change(s) failed")
+		os.Exit(1)
+	}
+	fmt.Println("Artifacts of " + applyEnvironment + " are in sync with " + applyDir)
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(ApplyCmd)
+	ApplyCmd.Flags().StringVarP(&applyDir, "file", "f", "", "Directory of the projects")
+	ApplyCmd.Flags().StringVarP(&applyEnvironment, "environment", "e", "",
+		"Environment to apply the projects to")
+	ApplyCmd.Flags().StringVarP(&applyParamsDir, "params", "", "",
+		"Directory with the params of the projects under the same relative paths")
+	ApplyCmd.Flags().BoolVarP(&applyPrune, "prune", "", false,
+		"Delete the artifacts carrying the labels of the selector which are not in the directory")
+	ApplyCmd.Flags().StringVarP(&applySelector, "selector", "l", "",
+		"Labels of the artifacts managed by the directory (key1=value1,key2=value2)")
+	ApplyCmd.Flags().BoolVarP(&applyDryRun, "dry-run", "", false, "Print the plan without applying it")
+	ApplyCmd.Flags().BoolVarP(&applyConfirm, "yes", "y", false, "Apply the changes without asking for confirmation")
+	_ = ApplyCmd.MarkFlagRequired("file")
+	_ = ApplyCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,122 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var applyThrottlingPoliciesDir string
+var applyThrottlingPoliciesEnvironment string
+var applyThrottlingPoliciesPrune bool
+var applyThrottlingPoliciesDryRun bool
+var applyThrottlingPoliciesConfirm bool
+
+// ApplyThrottlingPolicies command related usage Info
+const ApplyThrottlingPoliciesCmdLiteral = "throttling-policies"
+const applyThrottlingPoliciesCmdShortDesc = "Sync the throttling policies of an environment with a directory"
+const applyThrottlingPoliciesCmdLongDesc = `Sync the throttling policies of an environment with the exported throttling policy files
+(subscription, application, advanced and custom policies) of a directory. The policies which do not exist are created and
+the policies which differ from the files are updated. With --prune, the policies of the environment which are not in the
+directory are deleted (the Unlimited policies are never deleted). The plan is printed before any change is made.`
+
+const applyThrottlingPoliciesCmdExamples = utils.ProjectName + ` ` + ApplyCmdLiteral + ` ` + ApplyThrottlingPoliciesCmdLiteral + ` -f ~/policies -e production --dry-run
+` + utils.ProjectName + ` ` + ApplyCmdLiteral + ` ` + ApplyThrottlingPoliciesCmdLiteral + ` -f ~/policies -e production
+` + utils.ProjectName + ` ` + ApplyCmdLiteral + ` ` + ApplyThrottlingPoliciesCmdLiteral + ` -f ~/policies -e production --prune -y
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ApplyThrottlingPoliciesCmd represents the apply throttling-policies command
+var ApplyThrottlingPoliciesCmd = &cobra.Command{
+	Use:     ApplyThrottlingPoliciesCmdLiteral,
+	Short:   applyThrottlingPoliciesCmdShortDesc,
+	Long:    applyThrottlingPoliciesCmdLongDesc,
+	Example: applyThrottlingPoliciesCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ApplyThrottlingPoliciesCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(applyThrottlingPoliciesEnvironment)
+		executeApplyThrottlingPoliciesCmd(accessToken)
+	},
+}
+
+func executeApplyThrottlingPoliciesCmd(accessToken string) {
+	changes, err := impl.PlanThrottlingPolicySync(accessToken, applyThrottlingPoliciesEnvironment,
+		applyThrottlingPoliciesDir, applyThrottlingPoliciesPrune)
+	if err != nil {
+		utils.HandleErrorAndExit("Error planning the throttling policy sync", err)
+	}
+	impl.PrintThrottlingPolicyPlan(changes)
+
+	pending := 0
+	for _, change := range changes {
+		if change.Action != impl.ThrottlingPolicyActionUnchanged {
+			pending++
+		}
+	}
+	if pending == 0 {
+		fmt.Println("Throttling policies of " + applyThrottlingPoliciesEnvironment + " are up to date")
+		return
+	}
+	if applyThrottlingPoliciesDryRun {
+		fmt.Println("Dry run: " + strconv.Itoa(pending) + " change(s) were not applied")
+		return
+	}
+	if !applyThrottlingPoliciesConfirm {
+		confirm, err := utils.ReadInputString("Apply "+strconv.Itoa(pending)+" change(s) to "+
+			applyThrottlingPoliciesEnvironment, utils.Default{Value: "N", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+		confirm = strings.ToUpper(confirm)
+		if confirm != "Y" && confirm != "YES" {
+			fmt.Println("Throttling policy sync cancelled")
+			return
+		}
+	}
+
+	failed := impl.ApplyThrottlingPolicyChanges(accessToken, applyThrottlingPoliciesEnvironment, changes)
+	impl.PrintThrottlingPolicyResults(changes)
+	if failed > 0 {
+		fmt.Println(strconv.Itoa(failed) + " of " + strconv.Itoa(pending) + " change(s) failed")
+		os.Exit(1)
+	}
+	fmt.Println("Throttling policies of " + applyThrottlingPoliciesEnvironment + " are in sync")
+}
+
+func init() {
+	ApplyCmd.AddCommand(ApplyThrottlingPoliciesCmd)
+	ApplyThrottlingPoliciesCmd.Flags().StringVarP(&applyThrottlingPoliciesDir, "file", "f", "",
+		"Directory of the throttling policy files")
+	ApplyThrottlingPoliciesCmd.Flags().StringVarP(&applyThrottlingPoliciesEnvironment, "environment", "e", "",
+		"Environment to sync the throttling policies to")
+	ApplyThrottlingPoliciesCmd.Flags().BoolVarP(&applyThrottlingPoliciesPrune, "prune", "", false,
+		"Delete the throttling policies of the environment which are not in the directory")
+	ApplyThrottlingPoliciesCmd.Flags().BoolVarP(&applyThrottlingPoliciesDryRun, "dry-run", "", false,
+		"Print the plan without applying it")
+	ApplyThrottlingPoliciesCmd.Flags().BoolVarP(&applyThrottlingPoliciesConfirm, "yes", "y", false,
+		"Apply the changes without asking for confirmation")
+	_ = ApplyThrottlingPoliciesCmd.MarkFlagRequired("file")
+	_ = ApplyThrottlingPoliciesCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,111 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// The flags are shared by the bulk delete commands (delete apis, delete api-products and delete apps)
+var bulkDeleteEnvironment string
+var bulkDeleteQuery []string
+var bulkDeleteUndeploy bool
+var bulkDeleteDryRun bool
+var bulkDeleteConfirm bool
+
+// addBulkDeleteFlags adds the flags of a bulk delete command
+// @param cmd : Bulk delete command
+// @param artifacts : Plural name of the artifacts deleted by the command
+// @param deployable : Whether the artifacts have revisions deployed to gateways
+func addBulkDeleteFlags(cmd *cobra.Command, artifacts string, deployable bool) {
+	cmd.Flags().StringVarP(&bulkDeleteEnvironment, "environment", "e", "",
+		"Environment from which the "+artifacts+" should be deleted")
+	cmd.Flags().StringSliceVarP(&bulkDeleteQuery, "query", "q", []string{},
+		"Query pattern of the "+artifacts+" to be deleted")
+	if deployable {
+		cmd.Flags().BoolVarP(&bulkDeleteUndeploy, "undeploy", "", false,
+			"Undeploy the deployed revisions instead of skipping the "+artifacts+" which are deployed")
+	}
+	cmd.Flags().BoolVarP(&bulkDeleteDryRun, "dry-run", "", false, "Print the plan without deleting anything")
+	cmd.Flags().BoolVarP(&bulkDeleteConfirm, "yes", "y", false, "Delete without asking for confirmation")
+	addSkipBackupFlag(cmd)
+	_ = cmd.MarkFlagRequired("environment")
+	_ = cmd.MarkFlagRequired("query")
+}
+
+// executeBulkDeleteCmd plans the deletion of the artifacts matching the query, asks the user to type the name of
+// the environment to confirm it, and deletes the artifacts which are not blocked
+func executeBulkDeleteCmd(artifactType, artifacts string) {
+	accessToken := getPublisherAccessToken(bulkDeleteEnvironment)
+	query := strings.Join(bulkDeleteQuery, queryParamSeparator)
+	targets, err := impl.PlanBulkDelete(accessToken, bulkDeleteEnvironment, artifactType, query, bulkDeleteUndeploy)
+	if err != nil {
+		utils.HandleErrorAndExit("Error resolving the "+artifacts+" to be deleted", err)
+	}
+	if len(targets) == 0 {
+		fmt.Println("No " + artifacts + " of " + bulkDeleteEnvironment + " match " + query)
+		return
+	}
+	impl.PrintBulkDeletePlan(targets)
+
+	pending := 0
+	for _, target := range targets {
+		if target.Action == impl.BulkDeleteActionDelete {
+			pending++
+		}
+	}
+	blocked := len(targets) - pending
+	if blocked > 0 {
+		fmt.Println(strconv.Itoa(blocked) + " of " + strconv.Itoa(len(targets)) + " " + artifacts +
+			" are blocked and will not be deleted")
+	}
+	if pending == 0 {
+		return
+	}
+	if bulkDeleteDryRun {
+		fmt.Println("Dry run: " + strconv.Itoa(pending) + " " + artifacts + " were not deleted")
+		return
+	}
+	if !bulkDeleteConfirm {
+		confirm, err := utils.ReadInputString("Type the environment name ("+bulkDeleteEnvironment+") to delete "+
+			strconv.Itoa(pending)+" "+artifacts, utils.Default{}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+		if strings.TrimSpace(confirm) != bulkDeleteEnvironment {
+			fmt.Println("Delete cancelled")
+			return
+		}
+	}
+
+	failed := impl.ApplyBulkDelete(accessToken, bulkDeleteEnvironment, targets, !skipBackup)
+	impl.PrintBulkDeleteResults(targets)
+	if failed > 0 {
+		fmt.Println(strconv.Itoa(failed) + " of " + strconv.Itoa(pending) + " " + artifacts + " could not be deleted")
+		os.Exit(1)
+	}
+	fmt.Println(strconv.Itoa(pending) + " " + artifacts + " deleted from " + bulkDeleteEnvironment)
+}
@@ -36,7 +36,9 @@ const BundleCmdLiteral = "bundle"
 const BundleCmdShortDesc = "Archive any source project artifact to zip format"
 
 const BundleCmdLongDesc = "Archive API, Application or API Product projects to a zip format. Bundle name will have " +
-	"project name and version"
+	"project name and version. The bundle is reproducible: the same project content always produces the same bytes. " +
+	"VCS and editor files, and the files matching the patterns in the " + utils.ApictlIgnoreFileName + " file of the " +
+	"project are excluded. The sha256 content hash of the bundle is printed once it is generated."
 
 const BundleCmdExamples = utils.ProjectName + ` ` + BundleCmdLiteral + ` -s /home/prod/APIs/API1-1.0.0 -d /home/prod/Projects/
 ` + utils.ProjectName + ` ` + BundleCmdLiteral + ` -s /home/prod/APIs/API1-1.0.0 
@@ -66,15 +68,25 @@ var BundleCmd = &cobra.Command{
 }
 
 func executeBundleCmd() error {
+	bundleName, err := generateBundleName(bundleSource)
+	if err != nil {
+		return err
+	}
+	return writeBundle(bundleSource, bundleName, bundleDestination)
+}
+
+// writeBundle archives a project directory as a bundle with the given name in the destination directory, or in the
+// working directory if the destination is empty, and prints the content hash of the bundle
+func writeBundle(source, bundleName, destination string) error {
 	var bundleDirParent string
 
 	// Check the validity of destination path when it is given. if not given, use the working directory
-	if bundleDestination != "" {
-		err := os.MkdirAll(bundleDestination, os.ModePerm)
+	if destination != "" {
+		err := os.MkdirAll(destination, os.ModePerm)
 		if err != nil {
 			return err
 		}
-		p, err := filepath.Abs(bundleDestination)
+		p, err := filepath.Abs(destination)
 		if err != nil {
 			return err
 		}
@@ -87,18 +99,19 @@ func executeBundleCmd() error {
 		bundleDirParent = pwd
 	}
 
-	bundleName, err := generateBundleName(bundleSource)
+	bundleLocation := filepath.Join(bundleDirParent, bundleName+utils.ZipFileSuffix)
+	err := utils.Zip(source, bundleLocation)
 	if err != nil {
 		return err
 	}
 
-	bundleLocation := filepath.Join(bundleDirParent, bundleName+utils.ZipFileSuffix)
-	err = utils.Zip(bundleSource, bundleLocation)
+	bundleHash, err := utils.GetSHA256HashOfFile(bundleLocation)
 	if err != nil {
 		return err
 	}
 
 	fmt.Println("The bundle for the " + bundleName + " is generated at " + bundleLocation)
+	fmt.Println("Content hash: sha256:" + bundleHash)
 	return nil
 }
 
@@ -0,0 +1,101 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var bundleAPIProductAPIsDir string
+var bundleAPIProductEnvironment string
+var bundleAPIProductDestination string
+
+// BundleAPIProduct command related usage Info
+const BundleAPIProductCmdLiteral = "api-product"
+const bundleAPIProductCmdShortDesc = "Archive an API Product project along with its dependent APIs"
+
+const bundleAPIProductCmdLongDesc = `Archive an API Product project to a zip format along with the APIs used by the product, so that
+the bundle can be imported with "` + utils.ProjectName + ` import api-product --import-apis". Each API referred in the
+api_product.yaml of the project is resolved from the API projects in the directory given by --apis-dir, or else
+exported from the environment given by --environment, and is embedded in the APIs directory of the bundle with the
+version referred by the product. The bundle is not generated if an API cannot be resolved or if an operation used by
+the product is not found in the definition of its API.`
+
+const bundleAPIProductCmdExamples = utils.ProjectName + ` ` + BundleCmdLiteral + ` ` + BundleAPIProductCmdLiteral + ` ~/products/LeasingProduct --apis-dir ~/apis
+` + utils.ProjectName + ` ` + BundleCmdLiteral + ` ` + BundleAPIProductCmdLiteral + ` ~/products/LeasingProduct --apis-dir ~/apis -e dev -d ~/bundles
+` + utils.ProjectName + ` ` + BundleCmdLiteral + ` ` + BundleAPIProductCmdLiteral + ` ~/products/LeasingProduct -e dev
+NOTE: At least one of the flags (--apis-dir and --environment (-e)) is required.`
+
+// BundleAPIProductCmd represents the bundle api-product command
+var BundleAPIProductCmd = &cobra.Command{
+	Use:     BundleAPIProductCmdLiteral + " [product-project]",
+	Short:   bundleAPIProductCmdShortDesc,
+	Long:    bundleAPIProductCmdLongDesc,
+	Example: bundleAPIProductCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + BundleAPIProductCmdLiteral + " called")
+		if bundleAPIProductAPIsDir == "" && bundleAPIProductEnvironment == "" {
+			utils.HandleErrorAndExit("Error bundling the API Product",
+				errors.New("either --apis-dir or --environment should be given to resolve the dependent APIs"))
+		}
+		accessToken := ""
+		if bundleAPIProductEnvironment != "" {
+			accessToken = getPublisherAccessToken(bundleAPIProductEnvironment)
+		}
+		executeBundleAPIProductCmd(accessToken, args[0])
+	},
+}
+
+func executeBundleAPIProductCmd(accessToken, productDir string) {
+	bundleDir, entries, cleanup, err := impl.BundleAPIProduct(accessToken, bundleAPIProductEnvironment, productDir,
+		bundleAPIProductAPIsDir)
+	if err != nil {
+		utils.HandleErrorAndExit("Error bundling the API Product "+productDir, err)
+	}
+	defer cleanup()
+	for _, entry := range entries {
+		fmt.Println("Embedded API " + entry.Dependency.Name + " " + entry.Dependency.Version + " from " +
+			entry.Source)
+	}
+
+	bundleName, err := generateBundleName(productDir)
+	if err != nil {
+		utils.HandleErrorAndExit("Error bundling the API Product "+productDir, err)
+	}
+	if err = writeBundle(bundleDir, bundleName, bundleAPIProductDestination); err != nil {
+		utils.HandleErrorAndExit("Error archiving the API Product "+productDir, err)
+	}
+}
+
+// init using Cobra
+func init() {
+	BundleCmd.AddCommand(BundleAPIProductCmd)
+	BundleAPIProductCmd.Flags().StringVarP(&bundleAPIProductAPIsDir, "apis-dir", "", "", "Directory with the "+
+		"API projects used by the API Product")
+	BundleAPIProductCmd.Flags().StringVarP(&bundleAPIProductEnvironment, "environment", "e", "", "Environment to "+
+		"export the APIs which are not found in the APIs directory from")
+	BundleAPIProductCmd.Flags().StringVarP(&bundleAPIProductDestination, "destination", "d", "", "Path of "+
+		"the directory where the bundle should be generated")
+}
@@ -0,0 +1,141 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var apisStateChangeEnvironment string
+var apisStateChangeAction string
+var apisStateChangeQuery string
+var apisStateChangeFromFile string
+var apisStateChangeConcurrency int
+var apisStateChangeDryRun bool
+var apisStateChangeConfirm bool
+
+// ChangeAPIsStatus command related usage info
+const changeAPIsStatusCmdLiteral = "apis"
+const changeAPIsStatusCmdShortDesc = "Change Status of multiple APIs"
+const changeAPIsStatusCmdLongDesc = `Change the lifecycle status of the APIs matching the query specified by the flag --query, -q or listed in the file specified by the flag --from-file in an environment.
+Query terms with a * wildcard (eg: name:Pet*) are matched against the name, version, provider and context of the APIs. A * matches any characters, including /.
+Each line of the file is name,version[,provider]. Lines starting with # are ignored.
+The planned transitions, including the ones not allowed from the current lifecycle state, are shown and have to be confirmed before they are performed.`
+
+const changeAPIsStatusCmdExamples = utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIsStatusCmdLiteral + ` -a Deprecate -q "name:Pet* version:1.*" -e dev
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIsStatusCmdLiteral + ` -a Retire -q "provider:admin version:1.0.0" -e production --dry-run
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIsStatusCmdLiteral + ` -a Publish --from-file apis.csv -e production --concurrency 10 -y
+NOTE: The flags (--action (-a) and --environment (-e)) and one of the flags (--query (-q) or --from-file) are mandatory.`
+
+// ChangeAPIsStatusCmd represents change-status apis command
+var ChangeAPIsStatusCmd = &cobra.Command{
+	Use:     changeAPIsStatusCmdLiteral,
+	Short:   changeAPIsStatusCmdShortDesc,
+	Long:    changeAPIsStatusCmdLongDesc,
+	Example: changeAPIsStatusCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + changeAPIsStatusCmdLiteral + " called")
+		if (apisStateChangeQuery == "") == (apisStateChangeFromFile == "") {
+			utils.HandleErrorAndExit("Invalid flags", errors.New("one of --query or --from-file should be specified"))
+		}
+		accessToken := getPublisherAccessToken(apisStateChangeEnvironment)
+		executeChangeAPIsStatusCmd(accessToken)
+	},
+}
+
+// executeChangeAPIsStatusCmd executes the change apis status command
+func executeChangeAPIsStatusCmd(accessToken string) {
+	var apis []utils.API
+	var err error
+	if apisStateChangeFromFile != "" {
+		apis, err = impl.ResolveAPIsFromFile(accessToken, apisStateChangeEnvironment, apisStateChangeFromFile)
+	} else {
+		apis, err = impl.ResolveAPIsByQuery(accessToken, apisStateChangeEnvironment, apisStateChangeQuery)
+	}
+	if err != nil {
+		utils.HandleErrorAndExit("Error while resolving the APIs to change the status of", err)
+	}
+	if len(apis) == 0 {
+		fmt.Println("No APIs found to change the status of")
+		return
+	}
+
+	changes := impl.PlanAPIStatusChanges(accessToken, apisStateChangeEnvironment, apis, apisStateChangeAction,
+		apisStateChangeConcurrency)
+	impl.PrintAPIStatusChangePlan(changes)
+	valid := 0
+	for _, change := range changes {
+		if change.IsValid() {
+			valid++
+		}
+	}
+	fmt.Println("\n" + strconv.Itoa(valid) + " of " + strconv.Itoa(len(changes)) + " API(s) can be changed with the action " +
+		apisStateChangeAction)
+	if apisStateChangeDryRun || valid == 0 {
+		return
+	}
+	if !apisStateChangeConfirm {
+		confirm, err := utils.ReadInputString("Change the status of "+strconv.Itoa(valid)+" API(s)",
+			utils.Default{Value: "N", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+		confirm = strings.ToUpper(confirm)
+		if confirm != "Y" && confirm != "YES" {
+			fmt.Println("Status change cancelled")
+			return
+		}
+	}
+
+	failed := impl.ExecuteAPIStatusChanges(accessToken, apisStateChangeEnvironment, changes,
+		apisStateChangeConcurrency)
+	impl.PrintAPIStatusChangeResults(changes)
+	if failed > 0 {
+		utils.HandleErrorAndExit("Error while changing the API status",
+			errors.New(strconv.Itoa(failed)+" of "+strconv.Itoa(valid)+" status change(s) failed"))
+	}
+}
+
+func init() {
+	ChangeStatusCmd.AddCommand(ChangeAPIsStatusCmd)
+	ChangeAPIsStatusCmd.Flags().StringVarP(&apisStateChangeAction, "action", "a", "",
+		"Action to be taken to change the status of the APIs")
+	ChangeAPIsStatusCmd.Flags().StringVarP(&apisStateChangeQuery, "query", "q", "",
+		"Query to search the APIs to be state changed")
+	ChangeAPIsStatusCmd.Flags().StringVarP(&apisStateChangeFromFile, "from-file", "", "",
+		"File with the list of APIs to be state changed")
+	ChangeAPIsStatusCmd.Flags().IntVarP(&apisStateChangeConcurrency, "concurrency", "", 5,
+		"Maximum number of APIs to be state changed concurrently")
+	ChangeAPIsStatusCmd.Flags().BoolVarP(&apisStateChangeDryRun, "dry-run", "", false,
+		"Show the planned status changes without performing them")
+	ChangeAPIsStatusCmd.Flags().BoolVarP(&apisStateChangeConfirm, "yes", "y", false,
+		"Change the status without asking for confirmation")
+	ChangeAPIsStatusCmd.Flags().StringVarP(&apisStateChangeEnvironment, "environment", "e",
+		"", "Environment of which the API state should be changed")
+	// Mark required flags
+	_ = ChangeAPIsStatusCmd.MarkFlagRequired("action")
+	_ = ChangeAPIsStatusCmd.MarkFlagRequired("environment")
+}
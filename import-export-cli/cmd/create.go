@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Create command related usage Info
+const CreateCmdLiteral = "create"
+const createCmdShortDesc = "Create an artifact in an environment"
+
+const createCmdLongDesc = `Create an artifact such as an API/API Product revision in the environment specified by flag (--environment, -e)`
+
+const createCmdExamples = utils.ProjectName + ` ` + CreateCmdLiteral + ` ` + CreateRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev`
+
+// CreateCmd represents the create command
+var CreateCmd = &cobra.Command{
+	Use:     CreateCmdLiteral,
+	Short:   createCmdShortDesc,
+	Long:    createCmdLongDesc,
+	Example: createCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + CreateCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(CreateCmd)
+}
@@ -0,0 +1,99 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var createRevisionName string
+var createRevisionVersion string
+var createRevisionProvider string
+var createRevisionType string
+var createRevisionDescription string
+var createRevisionEnvironment string
+
+// CreateRevision command related usage Info
+const CreateRevisionCmdLiteral = "revision"
+const createRevisionCmdShortDesc = "Create a revision of an API/API Product"
+const createRevisionCmdLongDesc = "Create a revision from the current working copy of an API or API Product in the environment specified by the flag --environment, -e"
+
+const createRevisionCmdExamples = utils.ProjectName + ` ` + CreateCmdLiteral + ` ` + CreateRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + CreateCmdLiteral + ` ` + CreateRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -r admin -d "Release 1.0.0" -e dev
+` + utils.ProjectName + ` ` + CreateCmdLiteral + ` ` + CreateRevisionCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --type api-product -e dev
+NOTE: The flags (--name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// CreateRevisionCmd represents the create revision command
+var CreateRevisionCmd = &cobra.Command{
+	Use:     CreateRevisionCmdLiteral,
+	Short:   createRevisionCmdShortDesc,
+	Long:    createRevisionCmdLongDesc,
+	Example: createRevisionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + CreateRevisionCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(createRevisionEnvironment)
+		artifact := impl.RevisionArtifact{Type: createRevisionType, Name: createRevisionName,
+			Version: createRevisionVersion, Provider: createRevisionProvider}
+		revision, err := impl.CreateRevision(accessToken, createRevisionEnvironment, artifact, createRevisionDescription)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while creating a revision of "+artifact.String(), err)
+		}
+		fmt.Println(revision.RevisionNumber + " of " + artifact.String() + " created successfully. Revision ID: " +
+			revision.ID)
+	},
+}
+
+// getPublisherAccessToken returns an access token of the publisher REST API of the environment
+func getPublisherAccessToken(environment string) string {
+	cred, err := GetCredentials(environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(cred, environment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens", err)
+	}
+	return accessToken
+}
+
+// addRevisionArtifactFlags adds the flags used to identify the API or API Product of a revision command
+func addRevisionArtifactFlags(cmd *cobra.Command, name, version, provider, artifactType, environment *string) {
+	cmd.Flags().StringVarP(name, "name", "n", "", "Name of the API or API Product")
+	cmd.Flags().StringVarP(version, "version", "v", "", "Version of the API or API Product")
+	cmd.Flags().StringVarP(provider, "provider", "r", "", "Provider of the API or API Product")
+	cmd.Flags().StringVarP(artifactType, "type", "t", impl.RevisionArtifactTypeAPI,
+		"Type of the artifact ("+impl.RevisionArtifactTypeAPI+","+impl.RevisionArtifactTypeAPIProduct+")")
+	cmd.Flags().StringVarP(environment, "environment", "e", "", "Environment of the API or API Product")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("version")
+	_ = cmd.MarkFlagRequired("environment")
+}
+
+func init() {
+	CreateCmd.AddCommand(CreateRevisionCmd)
+	addRevisionArtifactFlags(CreateRevisionCmd, &createRevisionName, &createRevisionVersion, &createRevisionProvider,
+		&createRevisionType, &createRevisionEnvironment)
+	CreateRevisionCmd.Flags().StringVarP(&createRevisionDescription, "description", "d", "",
+		"Description of the revision")
+}
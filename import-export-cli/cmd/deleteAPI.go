@@ -71,6 +71,8 @@ var DeleteAPICmd = &cobra.Command{
 func executeDeleteAPICmd(credential credentials.Credential) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAPIEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, deleteAPIEnvironment, impl.RecycleBinCommandDelete, impl.RecycleBinArtifact{
+			Type: utils.ProjectTypeApi, Name: deleteAPIName, Version: deleteAPIVersion, Owner: deleteAPIProvider})
 		resp, err := impl.DeleteAPI(accessToken, deleteAPIEnvironment, deleteAPIName, deleteAPIVersion, deleteAPIProvider)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while deleting API ", err)
@@ -93,6 +95,7 @@ func init() {
 		"Provider of the API to be deleted")
 	DeleteAPICmd.Flags().StringVarP(&deleteAPIEnvironment, "environment", "e",
 		"", "Environment from which the API should be deleted")
+	addSkipBackupFlag(DeleteAPICmd)
 
 	// fetches the main-config.yaml file silently; i.e. if it's not created, ignore the error and assume that
 	//	this is the default mode.
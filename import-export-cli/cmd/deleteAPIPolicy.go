@@ -63,6 +63,9 @@ var DeleteAPIPolicyCmd = &cobra.Command{
 func executeDeleteAPIPolicyCmd(credential credentials.Credential) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAPIPolicyEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, deleteAPIPolicyEnvironment, impl.RecycleBinCommandDelete,
+			impl.RecycleBinArtifact{Type: utils.ProjectTypeAPIPolicy, Name: deleteAPIPolicyName,
+				Version: deleteAPIPolicyVersion})
 		_, err := impl.DeleteAPIPolicy(accessToken, deleteAPIPolicyName, deleteAPIPolicyVersion, deleteAPIPolicyEnvironment)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while deleting API Policy ", err)
@@ -83,6 +86,7 @@ func init() {
 		"", "Version of the API Policy to be deleted")
 	DeleteAPIPolicyCmd.Flags().StringVarP(&deleteAPIPolicyEnvironment, "environment", "e",
 		"", "Environment from which the API Policy should be deleted")
+	addSkipBackupFlag(DeleteAPIPolicyCmd)
 
 	_ = DeleteAPIPolicyCmd.MarkFlagRequired("name")
 	_ = DeleteAPIPolicyCmd.MarkFlagRequired("version")
@@ -63,6 +63,9 @@ var DeleteAPIProductCmd = &cobra.Command{
 func executeDeleteAPIProductCmd(credential credentials.Credential) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAPIProductEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, deleteAPIProductEnvironment, impl.RecycleBinCommandDelete,
+			impl.RecycleBinArtifact{Type: utils.ProjectTypeApiProduct, Name: deleteAPIProductName,
+				Version: deleteAPIProductVersion, Owner: deleteAPIProductProvider})
 		resp, err := impl.DeleteAPIProduct(accessToken, deleteAPIProductEnvironment, deleteAPIProductName, deleteAPIProductVersion, deleteAPIProductProvider)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while deleting API Product", err)
@@ -85,6 +88,7 @@ func init() {
 		"Provider of the API Product to be deleted")
 	DeleteAPIProductCmd.Flags().StringVarP(&deleteAPIProductEnvironment, "environment", "e",
 		"", "Environment from which the API Product should be deleted")
+	addSkipBackupFlag(DeleteAPIProductCmd)
 	// Mark required flags
 	_ = DeleteAPIProductCmd.MarkFlagRequired("name")
 	_ = DeleteAPIProductCmd.MarkFlagRequired("version")
@@ -0,0 +1,56 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// DeleteAPIProducts command related usage info
+const deleteAPIProductsCmdLiteral = "api-products"
+const deleteAPIProductsCmdShortDesc = "Delete the API Products matching a query"
+
+const deleteAPIProductsCmdLongDesc = `Delete the API Products of an environment matching a query. Each API Product is checked
+before anything is deleted. API Products with subscriptions and API Products with deployed revisions are blocked and are
+not deleted. The deployed revisions are undeployed instead of blocking the API Products with the flag --undeploy. The plan
+is printed and the name of the environment has to be typed to confirm the deletion unless the flag --yes is given. Each
+API Product is backed up to the recycle bin before it is deleted unless the flag --skip-backup is given.`
+
+const deleteAPIProductsCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteAPIProductsCmdLiteral + ` -e dev -q name:Sandbox
+` + utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteAPIProductsCmdLiteral + ` -e dev -q provider:ci-bot --undeploy -y
+NOTE: Both the flags (--environment (-e) and --query (-q)) are mandatory.`
+
+// DeleteAPIProductsCmd represents the delete api-products command
+var DeleteAPIProductsCmd = &cobra.Command{
+	Use:     deleteAPIProductsCmdLiteral,
+	Short:   deleteAPIProductsCmdShortDesc,
+	Long:    deleteAPIProductsCmdLongDesc,
+	Example: deleteAPIProductsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + deleteAPIProductsCmdLiteral + " called")
+		executeBulkDeleteCmd(utils.ProjectTypeApiProduct, "API Products")
+	},
+}
+
+// Init using Cobra
+func init() {
+	DeleteCmd.AddCommand(DeleteAPIProductsCmd)
+	addBulkDeleteFlags(DeleteAPIProductsCmd, "API Products", true)
+}
@@ -65,6 +65,8 @@ func executeDeleteAppCmd(credential credentials.Credential) {
 		if deleteAppOwner == "" {
 			deleteAppOwner = credential.Username
 		}
+		backupToRecycleBin(accessToken, deleteAppEnvironment, impl.RecycleBinCommandDelete, impl.RecycleBinArtifact{
+			Type: utils.ProjectTypeApplication, Name: deleteAppName, Owner: deleteAppOwner})
 		resp, err := impl.DeleteApplication(accessToken, deleteAppEnvironment, deleteAppName, deleteAppOwner)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while deleting Application ", err)
@@ -85,6 +87,7 @@ func init() {
 		"Owner of the Application to be deleted")
 	DeleteAppCmd.Flags().StringVarP(&deleteAppEnvironment, "environment", "e",
 		"", "Environment from which the Application should be deleted")
+	addSkipBackupFlag(DeleteAppCmd)
 	// Mark required flags
 	_ = DeleteAppCmd.MarkFlagRequired("name")
 	_ = DeleteAppCmd.MarkFlagRequired("environment")
@@ -0,0 +1,57 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// DeleteApps command related usage info
+const deleteAppsCmdLiteral = "apps"
+const deleteAppsCmdShortDesc = "Delete the applications matching a query"
+
+const deleteAppsCmdLongDesc = `Delete the applications of an environment matching a query. The query is made of name:<name> and owner:<owner> terms,
+where the name term is required and matches the applications with the given name, or with a * wildcard (eg: name:test-*)
+the applications whose name matches the pattern. Applications with subscriptions are blocked
+and are not deleted. The plan is printed and the name of the environment has to be typed to confirm the deletion
+unless the flag --yes is given. Each application is backed up to the recycle bin before it is deleted unless the flag
+--skip-backup is given.`
+
+const deleteAppsCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteAppsCmdLiteral + ` -e dev -q name:test-*
+` + utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteAppsCmdLiteral + ` -e dev -q name:* -q owner:ci-bot --dry-run
+NOTE: Both the flags (--environment (-e) and --query (-q)) are mandatory.`
+
+// DeleteAppsCmd represents the delete apps command
+var DeleteAppsCmd = &cobra.Command{
+	Use:     deleteAppsCmdLiteral,
+	Short:   deleteAppsCmdShortDesc,
+	Long:    deleteAppsCmdLongDesc,
+	Example: deleteAppsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + deleteAppsCmdLiteral + " called")
+		executeBulkDeleteCmd(utils.ProjectTypeApplication, "applications")
+	},
+}
+
+// Init using Cobra
+func init() {
+	DeleteCmd.AddCommand(DeleteAppsCmd)
+	addBulkDeleteFlags(DeleteAppsCmd, "applications", false)
+}
@@ -0,0 +1,79 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteDocConfirm bool
+
+// DeleteDoc command related usage Info
+const DeleteDocCmdLiteral = "doc"
+const deleteDocCmdShortDesc = "Delete a document of an API or API Product"
+
+const deleteDocCmdLongDesc = `Delete a document of an API or API Product in the environment specified by the flag --environment, -e.
+The API or API Product is not updated and no new revision is created.`
+
+const deleteDocCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteDocCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name "Getting Started"
+` + utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteDocCmdLiteral + ` -n LeasingProduct -v 1.0.0 --artifact-type api-product -e dev --doc-name Forum -y
+NOTE: The flags (--name (-n), --version (-v), --environment (-e) and --doc-name) are mandatory.`
+
+// DeleteDocCmd represents the delete doc command
+var DeleteDocCmd = &cobra.Command{
+	Use:     DeleteDocCmdLiteral,
+	Short:   deleteDocCmdShortDesc,
+	Long:    deleteDocCmdLongDesc,
+	Example: deleteDocCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeleteDocCmdLiteral + " called")
+		artifact := getDocArtifact()
+		if !deleteDocConfirm {
+			confirm, err := utils.ReadInputString("Delete the document "+docName+" of "+artifact.String(),
+				utils.Default{Value: "N", IsDefault: true}, "", false)
+			if err != nil {
+				utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+			}
+			confirm = strings.ToUpper(confirm)
+			if confirm != "Y" && confirm != "YES" {
+				fmt.Println("Delete cancelled")
+				return
+			}
+		}
+		accessToken := getPublisherAccessToken(docEnvironment)
+		if err := impl.DeleteDocument(accessToken, docEnvironment, artifact, docName); err != nil {
+			utils.HandleErrorAndExit("Error deleting the document "+docName+" of "+artifact.String(), err)
+		}
+		fmt.Println("Document " + docName + " of " + artifact.String() + " deleted successfully")
+	},
+}
+
+// init using Cobra
+func init() {
+	DeleteCmd.AddCommand(DeleteDocCmd)
+	addDocArtifactFlags(DeleteDocCmd)
+	DeleteDocCmd.Flags().StringVarP(&docName, "doc-name", "", "", "Name of the document")
+	DeleteDocCmd.Flags().BoolVarP(&deleteDocConfirm, "yes", "y", false, "Delete without asking for confirmation")
+	_ = DeleteDocCmd.MarkFlagRequired("doc-name")
+}
@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteGatewayEnvName string
+var deleteGatewayEnvEnvironment string
+
+// DeleteGatewayEnv command related usage Info
+const DeleteGatewayEnvCmdLiteral = "gateway-env"
+const deleteGatewayEnvCmdShortDesc = "Delete a gateway environment"
+const deleteGatewayEnvCmdLongDesc = `Delete a gateway environment from the environment specified by the flag --environment, -e.
+Gateway environments defined in the deployment.toml of the server are read only and cannot be deleted.`
+
+const deleteGatewayEnvCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteGatewayEnvCmdLiteral + ` -n External -e dev
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// DeleteGatewayEnvCmd represents the delete gateway-env command
+var DeleteGatewayEnvCmd = &cobra.Command{
+	Use:     DeleteGatewayEnvCmdLiteral,
+	Short:   deleteGatewayEnvCmdShortDesc,
+	Long:    deleteGatewayEnvCmdLongDesc,
+	Example: deleteGatewayEnvCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeleteGatewayEnvCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(deleteGatewayEnvEnvironment)
+		err := impl.DeleteGatewayEnvironment(accessToken, deleteGatewayEnvEnvironment, deleteGatewayEnvName)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while deleting gateway environment "+deleteGatewayEnvName, err)
+		}
+		fmt.Println("Gateway environment " + deleteGatewayEnvName + " deleted successfully")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(DeleteGatewayEnvCmd)
+	DeleteGatewayEnvCmd.Flags().StringVarP(&deleteGatewayEnvName, "name", "n", "",
+		"Name of the gateway environment to be deleted")
+	DeleteGatewayEnvCmd.Flags().StringVarP(&deleteGatewayEnvEnvironment, "environment", "e", "",
+		"Environment from which the gateway environment should be deleted")
+	_ = DeleteGatewayEnvCmd.MarkFlagRequired("name")
+	_ = DeleteGatewayEnvCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,65 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteKeyManagerName string
+var deleteKeyManagerEnvironment string
+
+// DeleteKeyManager command related usage Info
+const DeleteKeyManagerCmdLiteral = "key-manager"
+const deleteKeyManagerCmdShortDesc = "Delete a key manager"
+const deleteKeyManagerCmdLongDesc = `Delete a key manager from the environment specified by the flag --environment, -e`
+
+const deleteKeyManagerCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteKeyManagerCmdLiteral + ` -n Okta -e dev
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// DeleteKeyManagerCmd represents the delete key-manager command
+var DeleteKeyManagerCmd = &cobra.Command{
+	Use:     DeleteKeyManagerCmdLiteral,
+	Short:   deleteKeyManagerCmdShortDesc,
+	Long:    deleteKeyManagerCmdLongDesc,
+	Example: deleteKeyManagerCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeleteKeyManagerCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(deleteKeyManagerEnvironment)
+		err := impl.DeleteKeyManager(accessToken, deleteKeyManagerEnvironment, deleteKeyManagerName)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while deleting key manager "+deleteKeyManagerName, err)
+		}
+		fmt.Println("Key manager " + deleteKeyManagerName + " deleted successfully")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(DeleteKeyManagerCmd)
+	DeleteKeyManagerCmd.Flags().StringVarP(&deleteKeyManagerName, "name", "n", "",
+		"Name of the key manager to be deleted")
+	DeleteKeyManagerCmd.Flags().StringVarP(&deleteKeyManagerEnvironment, "environment", "e", "",
+		"Environment from which the key manager should be deleted")
+	_ = DeleteKeyManagerCmd.MarkFlagRequired("name")
+	_ = DeleteKeyManagerCmd.MarkFlagRequired("environment")
+}
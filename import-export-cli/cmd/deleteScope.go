@@ -0,0 +1,74 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteScopeName string
+var deleteScopeCheckUsage bool
+var deleteScopeEnvironment string
+
+// DeleteScope command related usage Info
+const DeleteScopeCmdLiteral = "scope"
+const deleteScopeCmdShortDesc = "Delete a shared scope"
+const deleteScopeCmdLongDesc = `Delete a shared scope from the environment specified by the flag --environment, -e.
+With the flag --check-usage, the APIs using the shared scope are listed and the shared scope is deleted only if it is not used.`
+
+const deleteScopeCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteScopeCmdLiteral + ` -n read_orders -e dev
+` + utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + DeleteScopeCmdLiteral + ` -n read_orders -e dev --check-usage
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// DeleteScopeCmd represents the delete scope command
+var DeleteScopeCmd = &cobra.Command{
+	Use:     DeleteScopeCmdLiteral,
+	Short:   deleteScopeCmdShortDesc,
+	Long:    deleteScopeCmdLongDesc,
+	Example: deleteScopeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeleteScopeCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(deleteScopeEnvironment)
+		usage, err := impl.DeleteSharedScope(accessToken, deleteScopeEnvironment, deleteScopeName,
+			deleteScopeCheckUsage)
+		if len(usage) > 0 {
+			fmt.Println("Shared scope " + deleteScopeName + " is used in the following APIs:")
+			impl.PrintSharedScopeUsage(usage)
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error while deleting shared scope "+deleteScopeName, err)
+		}
+		fmt.Println("Shared scope " + deleteScopeName + " deleted successfully")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(DeleteScopeCmd)
+	DeleteScopeCmd.Flags().StringVarP(&deleteScopeName, "name", "n", "", "Name of the shared scope to be deleted")
+	DeleteScopeCmd.Flags().BoolVarP(&deleteScopeCheckUsage, "check-usage", "", false,
+		"List the APIs using the shared scope and do not delete it if it is used")
+	DeleteScopeCmd.Flags().StringVarP(&deleteScopeEnvironment, "environment", "e", "",
+		"Environment from which the shared scope should be deleted")
+	_ = DeleteScopeCmd.MarkFlagRequired("name")
+	_ = DeleteScopeCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,77 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteSubscriptionEnvironment string
+var deleteSubscriptionApp string
+var deleteSubscriptionAPIName string
+var deleteSubscriptionAPIVersion string
+var deleteSubscriptionAPIProvider string
+
+// DeleteSubscription command related usage Info
+const deleteSubscriptionCmdLiteral = "subscription"
+const deleteSubscriptionCmdShortDesc = "Delete a subscription of an Application"
+const deleteSubscriptionCmdLongDesc = "Unsubscribe an Application of the user from an API or API Product in the environment specified by the flag --environment, -e"
+
+const deleteSubscriptionCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteSubscriptionCmdLiteral + ` --app SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+NOTE: The flags (--app, --name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// DeleteSubscriptionCmd represents the delete subscription command
+var DeleteSubscriptionCmd = &cobra.Command{
+	Use:     deleteSubscriptionCmdLiteral,
+	Short:   deleteSubscriptionCmdShortDesc,
+	Long:    deleteSubscriptionCmdLongDesc,
+	Example: deleteSubscriptionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + deleteSubscriptionCmdLiteral + " called")
+		accessToken := getDevPortalAccessToken(deleteSubscriptionEnvironment)
+		subscriptionId, err := impl.DeleteSubscription(accessToken, deleteSubscriptionEnvironment,
+			deleteSubscriptionApp, deleteSubscriptionAPIName, deleteSubscriptionAPIVersion,
+			deleteSubscriptionAPIProvider)
+		if err != nil {
+			utils.HandleErrorAndExit("Error deleting the subscription of the Application "+deleteSubscriptionApp, err)
+		}
+		fmt.Println("Subscription " + subscriptionId + " deleted successfully!")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(DeleteSubscriptionCmd)
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionApp, "app", "", "", "Name of the Application")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionAPIName, "name", "n", "",
+		"Name of the subscribed API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionAPIVersion, "version", "v", "",
+		"Version of the subscribed API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionAPIProvider, "provider", "r", "",
+		"Provider of the subscribed API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("app")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("name")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("version")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("environment")
+}
@@ -65,6 +65,9 @@ var DeleteThrottlingPolicyCmd = &cobra.Command{
 func executeDeleteThrottlingPolicyCmd(credential credentials.Credential) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteThrottlingPolicyEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, deleteThrottlingPolicyEnvironment, impl.RecycleBinCommandDelete,
+			impl.RecycleBinArtifact{Type: utils.ProjectTypeThrottlingPolicy, Name: deleteThrottlingPolicyName,
+				PolicyType: deleteThrottlingPolicyType})
 		_, err := impl.DeleteThrottlingPolicy(accessToken, deleteThrottlingPolicyName, deleteThrottlingPolicyType, deleteThrottlingPolicyEnvironment)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while deleting Throttling Policy ", err)
@@ -85,6 +88,7 @@ func init() {
 		"", "Environment from which the Throttling Policy should be deleted")
 	DeleteThrottlingPolicyCmd.Flags().StringVarP(&deleteThrottlingPolicyType, "type", "t",
 		"", "Type of the Throttling Policies to be exported (sub,app,custom,advanced)")
+	addSkipBackupFlag(DeleteThrottlingPolicyCmd)
 	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("name")
 	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("environment")
 	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("type")
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Deploy command related usage Info
+const DeployRevisionRootCmdLiteral = "deploy"
+const deployRevisionRootCmdShortDesc = "Deploy an API/API Product revision to gateway environments"
+
+const deployRevisionRootCmdLongDesc = `Deploy an API/API Product revision available in the environment specified by flag (--environment, -e) to the gateway environments specified by flag (--gateway-env, -g)`
+
+const deployRevisionRootCmdExamples = utils.ProjectName + ` ` + DeployRevisionRootCmdLiteral + ` ` + DeployRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -g Default -e dev`
+
+// DeployRevisionRootCmd represents the deploy command
+var DeployRevisionRootCmd = &cobra.Command{
+	Use:     DeployRevisionRootCmdLiteral,
+	Short:   deployRevisionRootCmdShortDesc,
+	Long:    deployRevisionRootCmdLongDesc,
+	Example: deployRevisionRootCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeployRevisionRootCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(DeployRevisionRootCmd)
+}
@@ -0,0 +1,116 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deployRevisionName string
+var deployRevisionVersion string
+var deployRevisionProvider string
+var deployRevisionType string
+var deployRevisionNum string
+var deployRevisionGatewayEnvs []string
+var deployRevisionVhosts []string
+var deployRevisionHideOnDevportal bool
+var deployRevisionEnvironment string
+
+// DeployRevision command related usage Info
+const DeployRevisionCmdLiteral = "revision"
+const deployRevisionCmdShortDesc = "Deploy a revision of an API/API Product"
+const deployRevisionCmdLongDesc = "Deploy a revision of an API or API Product in the environment specified by the flag --environment, -e to the gateway environments specified by the flag --gateway-env, -g"
+
+const deployRevisionCmdExamples = utils.ProjectName + ` ` + DeployRevisionRootCmdLiteral + ` ` + DeployRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -g Default -e dev
+` + utils.ProjectName + ` ` + DeployRevisionRootCmdLiteral + ` ` + DeployRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -g Default --vhost api.example.com -e dev
+` + utils.ProjectName + ` ` + DeployRevisionRootCmdLiteral + ` ` + DeployRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -g Default -g External --vhost localhost --vhost api.example.com -e dev
+` + utils.ProjectName + ` ` + DeployRevisionRootCmdLiteral + ` ` + DeployRevisionCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --type api-product --rev 1 -g Default -e dev
+NOTE: The flags (--name (-n), --version (-v), --rev, --gateway-env (-g) and --environment (-e)) are mandatory.
+A single --vhost is used for all the gateway environments. Otherwise a --vhost should be given for each --gateway-env in the same order.`
+
+// DeployRevisionCmd represents the deploy revision command
+var DeployRevisionCmd = &cobra.Command{
+	Use:     DeployRevisionCmdLiteral,
+	Short:   deployRevisionCmdShortDesc,
+	Long:    deployRevisionCmdLongDesc,
+	Example: deployRevisionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + DeployRevisionCmdLiteral + " called")
+		deployments, err := generateDeploymentsWithVhosts(deployRevisionGatewayEnvs, deployRevisionVhosts,
+			!deployRevisionHideOnDevportal)
+		if err != nil {
+			utils.HandleErrorAndExit("Invalid gateway environments", err)
+		}
+		accessToken := getPublisherAccessToken(deployRevisionEnvironment)
+		artifact := impl.RevisionArtifact{Type: deployRevisionType, Name: deployRevisionName,
+			Version: deployRevisionVersion, Provider: deployRevisionProvider}
+		err = impl.DeployRevision(accessToken, deployRevisionEnvironment, artifact, deployRevisionNum, deployments)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while deploying revision "+deployRevisionNum+" of "+artifact.String(), err)
+		}
+		fmt.Println("Revision " + deployRevisionNum + " of " + artifact.String() +
+			" successfully deployed to the gateway environments: " + strings.Join(deployRevisionGatewayEnvs, ", "))
+	},
+}
+
+// generateDeploymentsWithVhosts creates the deployments array of the gateway environments with their vhosts
+// @param gatewayEnvs : Gateway environments to deploy to
+// @param vhosts : Either a single vhost for all the gateway environments or a vhost for each gateway environment
+// @param displayOnDevportal : Whether the gateway environments should be displayed in the devportal
+// @return array of deployments, error
+func generateDeploymentsWithVhosts(gatewayEnvs, vhosts []string, displayOnDevportal bool) ([]utils.Deployment,
+	error) {
+	if len(gatewayEnvs) == 0 {
+		return nil, errors.New("at least one gateway environment should be specified")
+	}
+	if len(vhosts) > 1 && len(vhosts) != len(gatewayEnvs) {
+		return nil, fmt.Errorf("%d vhosts specified for %d gateway environments", len(vhosts), len(gatewayEnvs))
+	}
+	deployments := generateGatewayEnvsArray(gatewayEnvs)
+	for i := range deployments {
+		deployments[i].DisplayOnDevportal = displayOnDevportal
+		if len(vhosts) == 1 {
+			deployments[i].Vhost = vhosts[0]
+		} else if len(vhosts) > 1 {
+			deployments[i].Vhost = vhosts[i]
+		}
+	}
+	return deployments, nil
+}
+
+func init() {
+	DeployRevisionRootCmd.AddCommand(DeployRevisionCmd)
+	addRevisionArtifactFlags(DeployRevisionCmd, &deployRevisionName, &deployRevisionVersion, &deployRevisionProvider,
+		&deployRevisionType, &deployRevisionEnvironment)
+	DeployRevisionCmd.Flags().StringVarP(&deployRevisionNum, "rev", "", "", "Revision number to be deployed")
+	DeployRevisionCmd.Flags().StringSliceVarP(&deployRevisionGatewayEnvs, "gateway-env", "g", []string{},
+		"Gateway environment to which the revision has to be deployed")
+	DeployRevisionCmd.Flags().StringSliceVarP(&deployRevisionVhosts, "vhost", "", []string{},
+		"Virtual host of the gateway environment")
+	DeployRevisionCmd.Flags().BoolVarP(&deployRevisionHideOnDevportal, "hide-on-devportal", "", false,
+		"Hide the gateway environments in the devportal")
+	_ = DeployRevisionCmd.MarkFlagRequired("rev")
+	_ = DeployRevisionCmd.MarkFlagRequired("gateway-env")
+}
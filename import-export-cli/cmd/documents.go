@@ -0,0 +1,131 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+)
+
+// The flags are shared by the document commands (get docs, add doc, update doc, delete doc and sync docs)
+var docArtifactName string
+var docArtifactVersion string
+var docArtifactProvider string
+var docArtifactType string
+var docEnvironment string
+
+var docName string
+var docType string
+var docSourceType string
+var docFile string
+var docSourceUrl string
+var docSummary string
+var docVisibility string
+var docOtherTypeName string
+
+// addDocArtifactFlags adds the flags used to identify the API or API Product of a document command. The type of the
+// artifact is given by --artifact-type since --type is the type of the document.
+func addDocArtifactFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&docArtifactName, "name", "n", "", "Name of the API or API Product")
+	cmd.Flags().StringVarP(&docArtifactVersion, "version", "v", "", "Version of the API or API Product")
+	cmd.Flags().StringVarP(&docArtifactProvider, "provider", "r", "", "Provider of the API or API Product")
+	cmd.Flags().StringVarP(&docArtifactType, "artifact-type", "", impl.RevisionArtifactTypeAPI,
+		"Type of the artifact ("+impl.RevisionArtifactTypeAPI+","+impl.RevisionArtifactTypeAPIProduct+")")
+	cmd.Flags().StringVarP(&docEnvironment, "environment", "e", "", "Environment of the API or API Product")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("version")
+	_ = cmd.MarkFlagRequired("environment")
+}
+
+// addDocFlags adds the flags with the metadata and the content of a document to the add doc and update doc commands
+func addDocFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&docName, "doc-name", "", "", "Name of the document")
+	cmd.Flags().StringVarP(&docType, "type", "", "", "Type of the document (HOWTO, SAMPLES, PUBLIC_FORUM, "+
+		"SUPPORT_FORUM, API_MESSAGE_FORMAT, SWAGGER_DOC, OTHER)")
+	cmd.Flags().StringVarP(&docSourceType, "source-type", "", "", "Source type of the document (INLINE, MARKDOWN, "+
+		"URL, FILE). Inferred from the extension of --file if not given")
+	cmd.Flags().StringVarP(&docFile, "file", "f", "", "File with the content of the document")
+	cmd.Flags().StringVarP(&docSourceUrl, "source-url", "", "", "URL of a document of the source type URL")
+	cmd.Flags().StringVarP(&docSummary, "summary", "", "", "Summary of the document")
+	cmd.Flags().StringVarP(&docVisibility, "visibility", "", "", "Visibility of the document (API_LEVEL, PRIVATE, "+
+		"OWNER_ONLY)")
+	cmd.Flags().StringVarP(&docOtherTypeName, "other-type-name", "", "", "Name of the type of a document of "+
+		"the type OTHER")
+}
+
+// getDocArtifact returns the API or API Product given by the document artifact flags
+func getDocArtifact() impl.RevisionArtifact {
+	return impl.RevisionArtifact{Type: docArtifactType, Name: docArtifactName, Version: docArtifactVersion,
+		Provider: docArtifactProvider}
+}
+
+// getLocalDocument returns the document given by the document flags. The front-matter of the file given by --file is
+// overridden by the flags.
+func getLocalDocument() (impl.LocalDocument, error) {
+	document := impl.LocalDocument{}
+	if docFile != "" {
+		var err error
+		if document, err = impl.ReadDocumentFile(docFile); err != nil {
+			return document, err
+		}
+		if docSourceType != "" {
+			if err = setDocumentSourceType(&document, strings.ToUpper(docSourceType)); err != nil {
+				return document, err
+			}
+		}
+	} else {
+		document.SourceType = docSourceType
+	}
+	for _, field := range []struct{ value, target *string }{
+		{&docName, &document.Name},
+		{&docType, &document.Type},
+		{&docSourceUrl, &document.SourceUrl},
+		{&docSummary, &document.Summary},
+		{&docVisibility, &document.Visibility},
+		{&docOtherTypeName, &document.OtherTypeName},
+	} {
+		if *field.value != "" {
+			*field.target = *field.value
+		}
+	}
+	return document, nil
+}
+
+// setDocumentSourceType changes the source type inferred from the extension of a document file to the one given by
+// --source-type
+func setDocumentSourceType(document *impl.LocalDocument, sourceType string) error {
+	switch {
+	case sourceType == document.SourceType:
+	case sourceType == impl.DocumentSourceTypeFile:
+		document.Content = ""
+		document.FileName = ""
+	case document.SourceType == impl.DocumentSourceTypeFile:
+		content, err := ioutil.ReadFile(document.Path)
+		if err != nil {
+			return err
+		}
+		document.Content = string(content)
+		document.FileName = ""
+	}
+	document.SourceType = sourceType
+	return nil
+}
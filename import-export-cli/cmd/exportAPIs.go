@@ -35,10 +35,12 @@ const exportAPIsCmdLongDesc = "Export all the APIs of a tenant from one environm
 	"into another environment"
 const exportAPIsCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPIsCmdLiteral + ` -e production --force
 ` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPIsCmdLiteral + ` -e production
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPIsCmdLiteral + ` -e production --shared-scopes
 NOTE: The flag (--environment (-e)) is mandatory`
 
 var exportAPIsFormat string
 var exportAPIsAllRevisions bool
+var exportAPIsSharedScopes bool
 
 //e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
 var startFromBeginning bool
@@ -88,6 +90,28 @@ func executeExportAPIsCmd(credential credentials.Credential, exportDirectory str
 
 	impl.ExportAPIs(credential, exportRelatedFilesPath, CmdExportEnvironment, CmdResourceTenantDomain, exportAPIsFormat,
 		CmdUsername, apiExportDir, exportAPIPreserveStatus, runningExportApiCommand, exportAPIsAllRevisions, false)
+
+	if exportAPIsSharedScopes {
+		exportSharedScopesOfAPIs(credential, exportRelatedFilesPath)
+	}
+}
+
+// exportSharedScopesOfAPIs exports the shared scopes of the resource tenant along with the APIs. A failure is only
+// reported as a warning since the APIs have already been exported.
+func exportSharedScopesOfAPIs(credential credentials.Credential, exportRelatedFilesPath string) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, CmdExportEnvironment)
+	if err != nil {
+		fmt.Println(utils.LogPrefixWarning + "Shared scopes were not exported: " + err.Error())
+		return
+	}
+	scopesExportDir := filepath.Join(exportRelatedFilesPath, utils.ExportedScopesDirName)
+	paths, err := impl.ExportSharedScopes(accessToken, CmdExportEnvironment, CmdResourceTenantDomain, nil,
+		scopesExportDir, exportAPIsFormat)
+	if err != nil {
+		fmt.Println(utils.LogPrefixWarning + "Shared scopes were not exported: " + err.Error())
+		return
+	}
+	fmt.Printf("%d shared scope(s) exported to %s\n", len(paths), scopesExportDir)
 }
 
 func init() {
@@ -101,6 +125,8 @@ func init() {
 		"Preserve API status when exporting. Otherwise API will be exported in CREATED status")
 	ExportAPIsCmd.Flags().BoolVarP(&exportAPIsAllRevisions, "all", "", false,
 		"Export working copy and all revisions for the APIs in the environments ")
+	ExportAPIsCmd.Flags().BoolVarP(&exportAPIsSharedScopes, "shared-scopes", "", false,
+		"Export the shared scopes of the tenant along with the APIs")
 	ExportAPIsCmd.Flags().StringVarP(&exportAPIsFormat, "format", "", utils.DefaultExportFormat, "File format of exported archives(json or yaml)")
 	_ = ExportAPIsCmd.MarkFlagRequired("environment")
 }
@@ -0,0 +1,77 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportKeyManagerName string
+var exportKeyManagerFormat string
+var exportKeyManagerEnvironment string
+
+// ExportKeyManager command related usage Info
+const ExportKeyManagerCmdLiteral = "key-manager"
+const exportKeyManagerCmdShortDesc = "Export a key manager"
+const exportKeyManagerCmdLongDesc = `Export the configuration of a key manager from the environment specified by the flag --environment, -e.
+The key manager is written to the exported/key-managers/<environment> directory. Client secrets, passwords and API keys
+of the key manager are not exported. Provide them, along with environment specific values such as the issuer and JWKS URL,
+with a params file when importing the key manager.`
+
+const exportKeyManagerCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportKeyManagerCmdLiteral + ` -n Okta -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportKeyManagerCmdLiteral + ` -n Keycloak -e dev --format JSON
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportKeyManagerCmd represents the export key-manager command
+var ExportKeyManagerCmd = &cobra.Command{
+	Use:     ExportKeyManagerCmdLiteral,
+	Short:   exportKeyManagerCmdShortDesc,
+	Long:    exportKeyManagerCmdLongDesc,
+	Example: exportKeyManagerCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportKeyManagerCmdLiteral + " called")
+		exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedKeyManagersDirName,
+			exportKeyManagerEnvironment)
+		accessToken := getPublisherAccessToken(exportKeyManagerEnvironment)
+		path, err := impl.ExportKeyManager(accessToken, exportKeyManagerEnvironment, exportKeyManagerName,
+			exportDirectory, exportKeyManagerFormat)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while exporting key manager "+exportKeyManagerName, err)
+		}
+		fmt.Println("Successfully exported key manager!")
+		fmt.Println("Find the exported key manager at " + path)
+	},
+}
+
+func init() {
+	ExportCmd.AddCommand(ExportKeyManagerCmd)
+	ExportKeyManagerCmd.Flags().StringVarP(&exportKeyManagerName, "name", "n", "",
+		"Name of the key manager to be exported")
+	ExportKeyManagerCmd.Flags().StringVarP(&exportKeyManagerFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported key manager (json or yaml)")
+	ExportKeyManagerCmd.Flags().StringVarP(&exportKeyManagerEnvironment, "environment", "e", "",
+		"Environment from which the key manager should be exported")
+	_ = ExportKeyManagerCmd.MarkFlagRequired("name")
+	_ = ExportKeyManagerCmd.MarkFlagRequired("environment")
+}
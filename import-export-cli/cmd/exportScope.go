@@ -0,0 +1,82 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportScopeNames []string
+var exportScopeAll bool
+var exportScopeFormat string
+var exportScopeEnvironment string
+
+// ExportScope command related usage Info
+const ExportScopeCmdLiteral = "scope"
+const exportScopeCmdShortDesc = "Export shared scopes"
+const exportScopeCmdLongDesc = `Export shared scopes with their role bindings from the environment specified by the flag --environment, -e.
+Each shared scope is written to a separate file in the exported/scopes/<environment> directory.`
+
+const exportScopeCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportScopeCmdLiteral + ` -n read_orders -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportScopeCmdLiteral + ` -n read_orders -n write_orders -e dev --format JSON
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportScopeCmdLiteral + ` --all -e dev
+NOTE: The flag (--environment (-e)) and either the flag --name (-n) or --all are mandatory.`
+
+// ExportScopeCmd represents the export scope command
+var ExportScopeCmd = &cobra.Command{
+	Use:     ExportScopeCmdLiteral,
+	Short:   exportScopeCmdShortDesc,
+	Long:    exportScopeCmdLongDesc,
+	Example: exportScopeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportScopeCmdLiteral + " called")
+		if exportScopeAll == (len(exportScopeNames) > 0) {
+			utils.HandleErrorAndExit("Invalid flags", errors.New("either --name or --all should be specified"))
+		}
+		exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedScopesDirName, exportScopeEnvironment)
+		accessToken := getPublisherAccessToken(exportScopeEnvironment)
+		paths, err := impl.ExportSharedScopes(accessToken, exportScopeEnvironment, "", exportScopeNames, exportDirectory,
+			exportScopeFormat)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while exporting shared scopes", err)
+		}
+		for _, path := range paths {
+			fmt.Println("Successfully exported shared scope!")
+			fmt.Println("Find the exported shared scope at " + path)
+		}
+	},
+}
+
+func init() {
+	ExportCmd.AddCommand(ExportScopeCmd)
+	ExportScopeCmd.Flags().StringSliceVarP(&exportScopeNames, "name", "n", []string{},
+		"Name of the shared scope to be exported")
+	ExportScopeCmd.Flags().BoolVarP(&exportScopeAll, "all", "", false, "Export all the shared scopes")
+	ExportScopeCmd.Flags().StringVarP(&exportScopeFormat, "format", "", utils.DefaultExportFormat,
+		"File format of exported shared scopes (json or yaml)")
+	ExportScopeCmd.Flags().StringVarP(&exportScopeEnvironment, "environment", "e", "",
+		"Environment from which the shared scopes should be exported")
+	_ = ExportScopeCmd.MarkFlagRequired("environment")
+}
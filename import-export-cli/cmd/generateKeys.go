@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var generateKeysEnvironment string
+var generateKeysApp string
+var generateKeysKeyType string
+var generateKeysKeyManager string
+var generateKeysGrantTypes []string
+var generateKeysCallbackURL string
+var generateKeysValidityPeriod int
+
+// GenerateKeys command related usage Info
+const GenerateKeysCmdLiteral = "keys"
+const generateKeysCmdShortDesc = "Generate keys of an Application"
+const generateKeysCmdLongDesc = "Generate the consumer key, consumer secret and an access token of an Application of the user for a key type and a key manager in the environment specified by the flag --environment, -e"
+
+const generateKeysCmdExamples = utils.ProjectName + ` ` + GenerateCmdLiteral + ` ` + GenerateKeysCmdLiteral + ` --app SampleApp -e dev
+` + utils.ProjectName + ` ` + GenerateCmdLiteral + ` ` + GenerateKeysCmdLiteral + ` --app SampleApp --key-type SANDBOX --key-manager Resident\ Key\ Manager -e dev
+` + utils.ProjectName + ` ` + GenerateCmdLiteral + ` ` + GenerateKeysCmdLiteral + ` --app SampleApp --grant-types client_credentials,authorization_code --callback-url https://localhost/callback -e prod
+NOTE: Both the flags (--app and --environment (-e)) are mandatory.
+The flag --callback-url is mandatory with the authorization_code and implicit grant types.`
+
+// GenerateKeysCmd represents the generate keys command
+var GenerateKeysCmd = &cobra.Command{
+	Use:     GenerateKeysCmdLiteral,
+	Short:   generateKeysCmdShortDesc,
+	Long:    generateKeysCmdLongDesc,
+	Example: generateKeysCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GenerateKeysCmdLiteral + " called")
+		accessToken := getDevPortalAccessToken(generateKeysEnvironment)
+		keygenResponse, err := impl.GenerateKeys(accessToken, generateKeysEnvironment, generateKeysApp,
+			utils.KeygenRequest{
+				KeyType:                 generateKeysKeyType,
+				KeyManager:              generateKeysKeyManager,
+				GrantTypesToBeSupported: generateKeysGrantTypes,
+				CallbackURL:             generateKeysCallbackURL,
+				ValidityTime:            generateKeysValidityPeriod,
+			})
+		if err != nil {
+			utils.HandleErrorAndExit("Error generating keys of the Application "+generateKeysApp, err)
+		}
+		impl.PrintGeneratedKeys(keygenResponse)
+	},
+}
+
+func init() {
+	GenerateCmd.AddCommand(GenerateKeysCmd)
+	GenerateKeysCmd.Flags().StringVarP(&generateKeysApp, "app", "", "", "Name of the Application")
+	GenerateKeysCmd.Flags().StringVarP(&generateKeysKeyType, "key-type", "", utils.ProductionKeyType,
+		"Key type to generate the keys for (PRODUCTION or SANDBOX)")
+	GenerateKeysCmd.Flags().StringVarP(&generateKeysKeyManager, "key-manager", "", "",
+		"Key manager to generate the keys with. The default key manager is used if this is not provided")
+	GenerateKeysCmd.Flags().StringSliceVarP(&generateKeysGrantTypes, "grant-types", "", nil,
+		"Grant types supported by the keys. Defaults to refresh_token,password,client_credentials")
+	GenerateKeysCmd.Flags().StringVarP(&generateKeysCallbackURL, "callback-url", "", "",
+		"Callback URL of the keys")
+	GenerateKeysCmd.Flags().IntVarP(&generateKeysValidityPeriod, "validity-period", "", utils.DefaultTokenValidityPeriod,
+		"Validity period of the access token in seconds")
+	GenerateKeysCmd.Flags().StringVarP(&generateKeysEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = GenerateKeysCmd.MarkFlagRequired("app")
+	_ = GenerateKeysCmd.MarkFlagRequired("environment")
+}
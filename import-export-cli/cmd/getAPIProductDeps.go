@@ -0,0 +1,88 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getAPIProductDepsCmdEnvironment string
+var getAPIProductDepsCmdProject string
+var getAPIProductDepsCmdQuery []string
+var getAPIProductDepsCmdFormat string
+
+// GetAPIProductDeps command related usage Info
+const GetAPIProductDepsCmdLiteral = "api-product-deps"
+const getAPIProductDepsCmdShortDesc = "Display the APIs used by API Products"
+
+const getAPIProductDepsCmdLongDesc = `Display the APIs used by the API Products in the environment specified by the flag --environment, -e
+or by the API Product project specified by the flag --project, along with the operations of each API used by the product`
+
+const getAPIProductDepsCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetAPIProductDepsCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetAPIProductDepsCmdLiteral + ` -e dev -q name:LeasingProduct
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetAPIProductDepsCmdLiteral + ` --project ~/products/LeasingProduct
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetAPIProductDepsCmdLiteral + ` -e dev --format "{{ jsonPretty . }}"
+NOTE: Either the flag (--environment (-e)) or the flag (--project) is mandatory`
+
+// getAPIProductDepsCmd represents the get api-product-deps command
+var getAPIProductDepsCmd = &cobra.Command{
+	Use:     GetAPIProductDepsCmdLiteral,
+	Short:   getAPIProductDepsCmdShortDesc,
+	Long:    getAPIProductDepsCmdLongDesc,
+	Example: getAPIProductDepsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetAPIProductDepsCmdLiteral + " called")
+		var dependencies []impl.APIProductDependency
+		var err error
+		if getAPIProductDepsCmdProject == "" && getAPIProductDepsCmdEnvironment == "" {
+			utils.HandleErrorAndExit("Error getting the APIs used by the API Products",
+				errors.New("either --environment or --project should be given"))
+		}
+		if getAPIProductDepsCmdProject != "" {
+			dependencies, err = impl.GetAPIProductDependenciesOfProject(getAPIProductDepsCmdProject)
+		} else {
+			accessToken := getPublisherAccessToken(getAPIProductDepsCmdEnvironment)
+			dependencies, err = impl.GetAPIProductDependenciesFromEnv(accessToken, getAPIProductDepsCmdEnvironment,
+				strings.Join(getAPIProductDepsCmdQuery, queryParamSeparator))
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the APIs used by the API Products", err)
+		}
+		impl.PrintAPIProductDependencies(dependencies, getAPIProductDepsCmdFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	GetCmd.AddCommand(getAPIProductDepsCmd)
+	getAPIProductDepsCmd.Flags().StringVarP(&getAPIProductDepsCmdEnvironment, "environment", "e", "",
+		"Environment to be searched")
+	getAPIProductDepsCmd.Flags().StringVarP(&getAPIProductDepsCmdProject, "project", "", "",
+		"Path of an API Product project to read instead of an environment")
+	getAPIProductDepsCmd.Flags().StringSliceVarP(&getAPIProductDepsCmdQuery, "query", "q", []string{},
+		"Query pattern of the API Products")
+	getAPIProductDepsCmd.Flags().StringVarP(&getAPIProductDepsCmdFormat, "format", "", "", "Pretty-print "+
+		"the dependencies using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	getAPIProductDepsCmd.MarkFlagsMutuallyExclusive("environment", "project")
+}
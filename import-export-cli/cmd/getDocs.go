@@ -0,0 +1,70 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getDocsCmdFormat string
+
+// GetDocs command related usage Info
+const GetDocsCmdLiteral = "docs"
+const getDocsCmdShortDesc = "Display a list of documents of an API or API Product"
+
+const getDocsCmdLongDesc = `Display a list of documents of an API or API Product in the environment specified by the flag --environment, -e`
+
+const getDocsCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetDocsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetDocsCmdLiteral + ` -n LeasingProduct -v 1.0.0 --artifact-type api-product -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetDocsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --format "{{ jsonPretty . }}"
+NOTE: The flags (--name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// GetDocsCmd represents the get docs command
+var GetDocsCmd = &cobra.Command{
+	Use:     GetDocsCmdLiteral,
+	Short:   getDocsCmdShortDesc,
+	Long:    getDocsCmdLongDesc,
+	Example: getDocsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetDocsCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(docEnvironment)
+		artifact := getDocArtifact()
+		documents, err := impl.GetDocuments(accessToken, docEnvironment, artifact)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the documents of "+artifact.String(), err)
+		}
+		if len(documents) == 0 && getDocsCmdFormat == "" {
+			fmt.Println(artifact.String() + " has no documents")
+			return
+		}
+		impl.PrintDocuments(documents, getDocsCmdFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	GetCmd.AddCommand(GetDocsCmd)
+	addDocArtifactFlags(GetDocsCmd)
+	GetDocsCmd.Flags().StringVarP(&getDocsCmdFormat, "format", "", "", "Pretty-print the documents "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+}
@@ -0,0 +1,63 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getGatewayEnvsCmdEnvironment string
+var getGatewayEnvsCmdFormat string
+
+// GetGatewayEnvs command related usage Info
+const GetGatewayEnvsCmdLiteral = "gateway-envs"
+const getGatewayEnvsCmdShortDesc = "Display a list of gateway environments in an environment"
+const getGatewayEnvsCmdLongDesc = `Display a list of gateway environments with their vhosts in the environment specified by the flag --environment, -e`
+
+const getGatewayEnvsCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetGatewayEnvsCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetGatewayEnvsCmdLiteral + ` -e dev --format "{{.Name}}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// GetGatewayEnvsCmd represents the get gateway-envs command
+var GetGatewayEnvsCmd = &cobra.Command{
+	Use:     GetGatewayEnvsCmdLiteral,
+	Short:   getGatewayEnvsCmdShortDesc,
+	Long:    getGatewayEnvsCmdLongDesc,
+	Example: getGatewayEnvsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetGatewayEnvsCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(getGatewayEnvsCmdEnvironment)
+		gatewayEnvs, err := impl.GetGatewayEnvironments(accessToken, getGatewayEnvsCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while getting gateway environments", err)
+		}
+		impl.PrintGatewayEnvironments(gatewayEnvs, getGatewayEnvsCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(GetGatewayEnvsCmd)
+	GetGatewayEnvsCmd.Flags().StringVarP(&getGatewayEnvsCmdEnvironment, "environment", "e", "",
+		"Environment to be searched")
+	GetGatewayEnvsCmd.Flags().StringVarP(&getGatewayEnvsCmdFormat, "format", "", "", "Pretty-print gateway "+
+		"environments using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = GetGatewayEnvsCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,63 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getKeyManagersCmdEnvironment string
+var getKeyManagersCmdFormat string
+
+// GetKeyManagers command related usage Info
+const GetKeyManagersCmdLiteral = "key-managers"
+const getKeyManagersCmdShortDesc = "Display a list of key managers in an environment"
+const getKeyManagersCmdLongDesc = `Display a list of key managers in the environment specified by the flag --environment, -e`
+
+const getKeyManagersCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetKeyManagersCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetKeyManagersCmdLiteral + ` -e dev --format "{{.Name}}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// GetKeyManagersCmd represents the get key-managers command
+var GetKeyManagersCmd = &cobra.Command{
+	Use:     GetKeyManagersCmdLiteral,
+	Short:   getKeyManagersCmdShortDesc,
+	Long:    getKeyManagersCmdLongDesc,
+	Example: getKeyManagersCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetKeyManagersCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(getKeyManagersCmdEnvironment)
+		keyManagers, err := impl.GetKeyManagers(accessToken, getKeyManagersCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while getting key managers", err)
+		}
+		impl.PrintKeyManagers(keyManagers, getKeyManagersCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(GetKeyManagersCmd)
+	GetKeyManagersCmd.Flags().StringVarP(&getKeyManagersCmdEnvironment, "environment", "e", "",
+		"Environment to be searched")
+	GetKeyManagersCmd.Flags().StringVarP(&getKeyManagersCmdFormat, "format", "", "", "Pretty-print key managers "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = GetKeyManagersCmd.MarkFlagRequired("environment")
+}
@@ -19,6 +19,8 @@
 package cmd
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -29,14 +31,32 @@ const GetKeysCmdLiteral = "keys"
 const getKeysCmdShortDesc = "Generate access token to invoke the API or API Product"
 const getKeysCmdLongDesc = `Generate JWT token to invoke the API or API Product by subscribing to a default application for testing purposes`
 const getKeysCmdExamples = utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --provider admin
-NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.
-You can override the default token endpoint using --token (-t) optional flag providing a new token endpoint`
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --scopes read,write --validity-period 600 --decode
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --grant-type password -u alice -p secret
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --grant-type authorization_code --callback-port 8765
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n TwitterAPI -v 1.0.0 -e dev --grant-type refresh_token --refresh-token 5f2b9c1e-...
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` -n PetStoreAPI -v 1.0.0 -e dev --api-key
+` + utils.ProjectName + " " + GetCmdLiteral + " " + GetKeysCmdLiteral + ` --decode --jwt eyJ4NXQiOiJ...
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory unless a token is decoded with --jwt.
+You can override the default token endpoint using --token (-t) optional flag providing a new token endpoint.
+The password grant uses the credentials of the environment unless --username (-u) and --password (-p) are provided.
+An API key is generated instead of a token for APIs which are secured only with API keys.`
 
 var keyGenEnv string
 var apiName string
 var apiVersion string
 var apiProvider string
 var keyGenTokenEndpoint string
+var keyGenGrantType string
+var keyGenScopes []string
+var keyGenValidityPeriod int
+var keyGenUsername string
+var keyGenPassword string
+var keyGenRefreshToken string
+var keyGenCallbackPort int
+var keyGenAPIKey bool
+var keyGenDecode bool
+var keyGenJWT string
 
 var getKeysCmd = &cobra.Command{
 	Use:     GetKeysCmdLiteral,
@@ -45,11 +65,22 @@ var getKeysCmd = &cobra.Command{
 	Example: getKeysCmdExamples,
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + GetKeysCmdLiteral + " called")
+		if keyGenJWT != "" {
+			impl.PrintDecodedJWT(keyGenJWT)
+			return
+		}
+		if apiName == "" || keyGenEnv == "" {
+			utils.HandleErrorAndExit("Error generating keys",
+				errors.New("both the flags --name (-n) and --environment (-e) are mandatory"))
+		}
 		cred, err := GetCredentials(keyGenEnv)
 		if err != nil {
 			utils.HandleErrorAndExit("Error getting credentials", err)
 		}
 		utils.Logln(utils.LogPrefixInfo + "Retrieved credentials of the environment successfully")
+		if keyGenGrantType == utils.GrantTypePassword && keyGenUsername == "" {
+			keyGenUsername, keyGenPassword = cred.Username, cred.Password
+		}
 		//Calling the DCR endpoint to get the credentials of the env
 		cred.ClientId, cred.ClientSecret, err = impl.CallDCREndpoint(cred, keyGenEnv)
 		//If the DCR call fails exit with the error
@@ -57,11 +88,22 @@ var getKeysCmd = &cobra.Command{
 			utils.HandleErrorAndExit("Internal error occurred", err)
 		}
 		utils.Logln(utils.LogPrefixInfo + "Called DCR endpoint successfully")
-		impl.GetKeys(cred, keyGenEnv, apiName, apiVersion, apiProvider, keyGenTokenEndpoint)
+		impl.GetKeysWithOptions(cred, keyGenEnv, apiName, apiVersion, apiProvider, keyGenTokenEndpoint,
+			impl.KeyGenOptions{
+				GrantType:      keyGenGrantType,
+				Scopes:         keyGenScopes,
+				ValidityPeriod: keyGenValidityPeriod,
+				Username:       keyGenUsername,
+				Password:       keyGenPassword,
+				RefreshToken:   keyGenRefreshToken,
+				CallbackPort:   keyGenCallbackPort,
+				APIKey:         keyGenAPIKey,
+				Decode:         keyGenDecode,
+			})
 	},
 }
 
-//init function to add the cli command to the root command
+// init function to add the cli command to the root command
 func init() {
 	GetCmd.AddCommand(getKeysCmd)
 	getKeysCmd.Flags().StringVarP(&keyGenEnv, "environment", "e", "", "Key generation environment")
@@ -69,6 +111,19 @@ func init() {
 	getKeysCmd.Flags().StringVarP(&apiVersion, "version", "v", "", "Version of the API")
 	getKeysCmd.Flags().StringVarP(&apiProvider, "provider", "r", "", "Provider of the API or API Product")
 	getKeysCmd.Flags().StringVarP(&keyGenTokenEndpoint, "token", "t", "", "Token endpoint URL of Environment")
-	_ = getKeysCmd.MarkFlagRequired("name")
-	_ = getKeysCmd.MarkFlagRequired("environment")
+	getKeysCmd.Flags().StringVarP(&keyGenGrantType, "grant-type", "", utils.GrantTypeClientCredentials,
+		"Grant type to generate the token (client_credentials, password, authorization_code or refresh_token)")
+	getKeysCmd.Flags().StringSliceVarP(&keyGenScopes, "scopes", "", nil,
+		"Scopes of the token. Defaults to the scopes of the subscribed APIs and API Products")
+	getKeysCmd.Flags().IntVarP(&keyGenValidityPeriod, "validity-period", "", 0,
+		"Validity period of the token or the API key in seconds")
+	getKeysCmd.Flags().StringVarP(&keyGenUsername, "username", "u", "", "Username of the resource owner for the password grant")
+	getKeysCmd.Flags().StringVarP(&keyGenPassword, "password", "p", "", "Password of the resource owner for the password grant")
+	getKeysCmd.Flags().StringVarP(&keyGenRefreshToken, "refresh-token", "", "", "Refresh token for the refresh_token grant")
+	getKeysCmd.Flags().IntVarP(&keyGenCallbackPort, "callback-port", "", 0,
+		"Port of the local callback listener for the authorization_code grant. A free port is used by default")
+	getKeysCmd.Flags().BoolVarP(&keyGenAPIKey, "api-key", "", false, "Generate an API key instead of a token")
+	getKeysCmd.Flags().BoolVarP(&keyGenDecode, "decode", "", false,
+		"Print the header and the claims of the generated token")
+	getKeysCmd.Flags().StringVarP(&keyGenJWT, "jwt", "", "", "Decode the given JWT instead of generating a token")
 }
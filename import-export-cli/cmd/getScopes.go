@@ -0,0 +1,63 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getScopesCmdEnvironment string
+var getScopesCmdFormat string
+
+// GetScopes command related usage Info
+const GetScopesCmdLiteral = "scopes"
+const getScopesCmdShortDesc = "Display a list of shared scopes in an environment"
+const getScopesCmdLongDesc = `Display a list of shared scopes with their role bindings in the environment specified by the flag --environment, -e`
+
+const getScopesCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetScopesCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetScopesCmdLiteral + ` -e dev --format "{{.Name}}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// GetScopesCmd represents the get scopes command
+var GetScopesCmd = &cobra.Command{
+	Use:     GetScopesCmdLiteral,
+	Short:   getScopesCmdShortDesc,
+	Long:    getScopesCmdLongDesc,
+	Example: getScopesCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetScopesCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(getScopesCmdEnvironment)
+		scopes, err := impl.GetSharedScopes(accessToken, getScopesCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while getting shared scopes", err)
+		}
+		impl.PrintSharedScopes(scopes, getScopesCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(GetScopesCmd)
+	GetScopesCmd.Flags().StringVarP(&getScopesCmdEnvironment, "environment", "e", "",
+		"Environment to be searched")
+	GetScopesCmd.Flags().StringVarP(&getScopesCmdFormat, "format", "", "", "Pretty-print shared scopes "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = GetScopesCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,68 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getSubscriptionsCmdEnvironment string
+var getSubscriptionsCmdApp string
+var getSubscriptionsCmdFormat string
+
+// GetSubscriptionsCmd related info
+const GetSubscriptionsCmdLiteral = "subscriptions"
+const getSubscriptionsCmdShortDesc = "Display a list of subscriptions of an Application"
+const getSubscriptionsCmdLongDesc = "Display a list of subscriptions of an Application of the user in the environment specified by the flag --environment, -e"
+
+const getSubscriptionsCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` --app SampleApp -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` --app SampleApp -e dev --format "{{.APIName}} {{.Tier}}"
+NOTE: Both the flags (--app and --environment (-e)) are mandatory`
+
+// getSubscriptionsCmd represents the get subscriptions command
+var getSubscriptionsCmd = &cobra.Command{
+	Use:     GetSubscriptionsCmdLiteral,
+	Short:   getSubscriptionsCmdShortDesc,
+	Long:    getSubscriptionsCmdLongDesc,
+	Example: getSubscriptionsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetSubscriptionsCmdLiteral + " called")
+		accessToken := getDevPortalAccessToken(getSubscriptionsCmdEnvironment)
+		subscriptions, err := impl.GetSubscriptions(accessToken, getSubscriptionsCmdEnvironment,
+			getSubscriptionsCmdApp)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the subscriptions of the Application "+getSubscriptionsCmdApp,
+				err)
+		}
+		impl.PrintSubscriptions(subscriptions, getSubscriptionsCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(getSubscriptionsCmd)
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdApp, "app", "", "", "Name of the Application")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdEnvironment, "environment", "e",
+		"", "Environment of the Application")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdFormat, "format", "", "", "Pretty-print output"+
+		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
+	_ = getSubscriptionsCmd.MarkFlagRequired("app")
+	_ = getSubscriptionsCmd.MarkFlagRequired("environment")
+}
@@ -20,9 +20,14 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
+// identityMapFlagDesc describes the --identity-map flag of the import commands
+const identityMapFlagDesc = "Mapping file (users, roles, tenants and userStores) used to rewrite the providers, " +
+	"owners, subscribers, roles and context tenants of the imported artifacts"
+
 // Import command related usage Info
 const ImportCmdLiteral = "import"
 const importCmdShortDesc = "Import an API/API Product/Application to an environment"
@@ -47,6 +52,18 @@ var ImportCmd = &cobra.Command{
 	},
 }
 
+// loadIdentityMap reads the identity map given to an import command, or returns nil if none is given
+func loadIdentityMap(path string) *impl.IdentityMap {
+	if path == "" {
+		return nil
+	}
+	identityMap, err := impl.LoadIdentityMap(path)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the identity map", err)
+	}
+	return identityMap
+}
+
 // init using Cobra
 func init() {
 	RootCmd.AddCommand(ImportCmd)
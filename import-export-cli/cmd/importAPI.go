@@ -36,19 +36,23 @@ var (
 	importAPISkipDeployments     bool
 	dryRun                       bool
 	apiLoggingCmdFormat          string
+	importAPIIdentityMapFile     string
 )
 
 const (
 	// ImportAPI command related usage info
 	ImportAPICmdLiteral   = "api"
 	importAPICmdShortDesc = "Import API"
-	importAPICmdLongDesc  = "Import an API to an environment"
+	importAPICmdLongDesc  = "Import an API to an environment. The gateway environments and vhosts in the " +
+		"deployment_environments.yaml of the API are validated against the environment before importing"
 )
 
 const importAPICmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f qa/TwitterAPI.zip -e dev
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f staging/FacebookAPI.zip -e production
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/myapi -e production --update --rotate-revision
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/myapi -e production --update
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f oci://localhost:5000/apis/pizzashack:1.0.0 -e dev
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f ~/migration/PizzaAPI.zip -e production --identity-map ~/migration/identity-map.yaml
 NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory`
 
 // ImportAPICmd represents the importAPI command
@@ -70,7 +74,7 @@ var ImportAPICmd = &cobra.Command{
 		}
 		err = impl.ImportAPIToEnv(accessOAuthToken, importEnvironment, importAPIFile, importAPIParamsFile, importAPIUpdate,
 			importAPICmdPreserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments, dryRun,
-			apiLoggingCmdFormat)
+			apiLoggingCmdFormat, loadIdentityMap(importAPIIdentityMapFile))
 		if err != nil {
 			utils.HandleErrorAndExit("Error importing API", err)
 			return
@@ -82,7 +86,8 @@ var ImportAPICmd = &cobra.Command{
 func init() {
 	ImportCmd.AddCommand(ImportAPICmd)
 	ImportAPICmd.Flags().StringVarP(&importAPIFile, "file", "f", "",
-		"Name of the API to be imported")
+		"Name of the API to be imported. An OCI reference (oci://registry/repository:tag) can be given "+
+			"to import an API pushed with the push command")
 	ImportAPICmd.Flags().StringVarP(&importEnvironment, "environment", "e",
 		"", "Environment from the which the API should be imported")
 	ImportAPICmd.Flags().BoolVar(&importAPICmdPreserveProvider, "preserve-provider", true,
@@ -101,6 +106,7 @@ func init() {
 		"verification of the governance compliance of the API without importing it")
 	ImportAPICmd.Flags().StringVarP(&apiLoggingCmdFormat, "format", "", "", "Output format of violation results in "+
 		"dry-run mode. Supported formats: [table, json, list]. If not provided, the default format is table.")
+	ImportAPICmd.Flags().StringVarP(&importAPIIdentityMapFile, "identity-map", "", "", identityMapFlagDesc)
 	// Mark required flags
 	_ = ImportAPICmd.MarkFlagRequired("environment")
 	_ = ImportAPICmd.MarkFlagRequired("file")
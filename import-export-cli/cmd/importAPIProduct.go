@@ -36,6 +36,7 @@ var (
 	importAPIProductSkipCleanup         bool
 	importAPIProductRotateRevision      bool
 	importAPIProductSkipDeployments     bool
+	importAPIProductIdentityMapFile     string
 )
 
 const (
@@ -49,6 +50,7 @@ const importAPIProductCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral +
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + importAPIProductCmdLiteral + ` -f staging/CreditAPIProduct.zip -e production --update-api-product
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + importAPIProductCmdLiteral + ` -f ~/myapiproduct -e production
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + importAPIProductCmdLiteral + ` -f ~/myapiproduct -e production --update-api-product --update-apis
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + importAPIProductCmdLiteral + ` -f ~/migration/LeasingAPIProduct.zip -e production --import-apis --identity-map ~/migration/identity-map.yaml
 NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory`
 
 // ImportAPIProductCmd represents the importAPIProduct command
@@ -71,7 +73,7 @@ var ImportAPIProductCmd = &cobra.Command{
 		}
 		err = impl.ImportAPIProductToEnv(accessOAuthToken, importAPIProductEnvironment, importAPIProductFile, importAPIProductParamsFile,
 			importAPIs, importAPIsUpdate, importAPIProductUpdate, importAPIProductCmdPreserveProvider, importAPIProductSkipCleanup,
-			importAPIProductRotateRevision, importAPIProductSkipDeployments)
+			importAPIProductRotateRevision, importAPIProductSkipDeployments, loadIdentityMap(importAPIProductIdentityMapFile))
 		if err != nil {
 			utils.HandleErrorAndExit("Error importing API Product", err)
 			return
@@ -83,7 +85,8 @@ var ImportAPIProductCmd = &cobra.Command{
 func init() {
 	ImportCmd.AddCommand(ImportAPIProductCmd)
 	ImportAPIProductCmd.Flags().StringVarP(&importAPIProductFile, "file", "f", "",
-		"Name of the API Product to be imported")
+		"Name of the API Product to be imported. An OCI reference (oci://registry/repository:tag) can be given "+
+			"to import an API Product pushed with the push command")
 	ImportAPIProductCmd.Flags().StringVarP(&importAPIProductEnvironment, "environment", "e",
 		"", "Environment from the which the API Product should be imported")
 	ImportAPIProductCmd.Flags().BoolVar(&importAPIProductRotateRevision, "rotate-revision", false,
@@ -102,6 +105,7 @@ func init() {
 		"all temporary files created during import process")
 	ImportAPIProductCmd.Flags().BoolVar(&importAPIProductSkipDeployments, "skip-deployments", false, "Update only "+
 		"the working copy and skip deployment steps in import")
+	ImportAPIProductCmd.Flags().StringVarP(&importAPIProductIdentityMapFile, "identity-map", "", "", identityMapFlagDesc)
 	// Mark required flags
 	_ = ImportAPIProductCmd.MarkFlagRequired("environment")
 	_ = ImportAPIProductCmd.MarkFlagRequired("file")
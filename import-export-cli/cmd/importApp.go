@@ -33,16 +33,27 @@ var skipSubscriptions bool
 var importAppSkipKeys bool
 var importAppUpdateApplication bool
 var importAppSkipCleanup bool
+var importAppIdentityMapFile string
+var importAppKeyManagerMapFile string
+var importAppRegenerateKeys bool
+var importAppParamsFile string
 
 // ImportApp command related usage info
 const ImportAppCmdLiteral = "app"
 const importAppCmdShortDesc = "Import App"
 
-const importAppCmdLongDesc = "Import an Application to an environment"
+const importAppCmdLongDesc = `Import an Application to an environment. The key managers and the key types of the keys of the
+Application can be renamed for the environment with a key manager map given by --key-manager-map, and the callback
+URLs and the grant types of the keys can be set per environment with a params file given by --params. The key
+managers of the keys are validated against the environment before importing. Use --regenerate-keys to generate new
+keys for the Application instead of importing the exported keys.`
 
 const importAppCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f qa/apps/sampleApp.zip -e dev
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f staging/apps/sampleApp.zip -e prod -o testUser
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f qa/apps/sampleApp.zip --preserve-owner --skip-subscriptions -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f ~/migration/apps/sampleApp.zip --preserve-owner -e prod --identity-map ~/migration/identity-map.yaml
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f ~/apps/sampleApp.zip -e prod --key-manager-map key-manager-map.yaml --params app_params.yaml
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f ~/apps/sampleApp.zip -e prod --key-manager-map key-manager-map.yaml --regenerate-keys
 NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory`
 
 // importAppCmd represents the importApp command
@@ -68,16 +79,35 @@ func executeImportAppCmd(credential credentials.Credential) {
 		utils.HandleErrorAndExit("Error getting OAuth Tokens", err)
 	}
 	_, err = impl.ImportApplicationToEnv(accessToken, importAppEnvironment, importAppFile, importAppOwner,
-		importAppUpdateApplication, preserveOwner, skipSubscriptions, importAppSkipKeys, importAppSkipCleanup)
+		importAppUpdateApplication, preserveOwner, skipSubscriptions, importAppSkipKeys, importAppSkipCleanup,
+		loadIdentityMap(importAppIdentityMapFile), getAppKeyImportOptions())
 	if err != nil {
 		utils.HandleErrorAndExit("Error importing Application", err)
 	}
 }
 
+// getAppKeyImportOptions returns how the keys of the application are moved to the environment, or nil if the keys
+// are imported as they are
+func getAppKeyImportOptions() *impl.AppKeyImportOptions {
+	if importAppKeyManagerMapFile == "" && !importAppRegenerateKeys && importAppParamsFile == "" {
+		return nil
+	}
+	options := &impl.AppKeyImportOptions{RegenerateKeys: importAppRegenerateKeys, ParamsPath: importAppParamsFile}
+	if importAppKeyManagerMapFile != "" {
+		keyManagerMap, err := impl.LoadKeyManagerMap(importAppKeyManagerMapFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the key manager map", err)
+		}
+		options.KeyManagerMap = keyManagerMap
+	}
+	return options
+}
+
 func init() {
 	ImportCmd.AddCommand(ImportAppCmd)
 	ImportAppCmd.Flags().StringVarP(&importAppFile, "file", "f", "",
-		"Name of the ZIP file of the Application to be imported")
+		"Name of the ZIP file of the Application to be imported. An OCI reference (oci://registry/repository:tag) "+
+			"can be given to import an Application pushed with the push command")
 	ImportAppCmd.Flags().StringVarP(&importAppOwner, "owner", "o", "",
 		"Name of the target owner of the Application as desired by the Importer")
 	ImportAppCmd.Flags().StringVarP(&importAppEnvironment, "environment", "e",
@@ -92,6 +122,14 @@ func init() {
 		"Update the Application if it is already imported")
 	ImportAppCmd.Flags().BoolVarP(&importAppSkipCleanup, "skip-cleanup", "", false, "Leave "+
 		"all temporary files created during import process")
+	ImportAppCmd.Flags().StringVarP(&importAppIdentityMapFile, "identity-map", "", "", identityMapFlagDesc)
+	ImportAppCmd.Flags().StringVarP(&importAppKeyManagerMapFile, "key-manager-map", "", "",
+		"Mapping file (keyManagers and keyTypes) used to rename the key managers and the key types of the keys")
+	ImportAppCmd.Flags().BoolVarP(&importAppRegenerateKeys, "regenerate-keys", "", false,
+		"Generate new keys for the Application instead of importing the exported keys")
+	ImportAppCmd.Flags().StringVarP(&importAppParamsFile, "params", "", "",
+		"Provide a params file with the callback URLs and the grant types of the keys of the environments")
+	ImportAppCmd.MarkFlagsMutuallyExclusive("skip-keys", "regenerate-keys")
 	_ = ImportAppCmd.MarkFlagRequired("file")
 	_ = ImportAppCmd.MarkFlagRequired("environment")
 }
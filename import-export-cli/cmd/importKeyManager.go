@@ -0,0 +1,83 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importKeyManagerFile string
+var importKeyManagerParamsFile string
+var importKeyManagerUpdate bool
+var importKeyManagerEnvironment string
+
+// ImportKeyManager command related usage Info
+const ImportKeyManagerCmdLiteral = "key-manager"
+const importKeyManagerCmdShortDesc = "Import a key manager"
+const importKeyManagerCmdLongDesc = `Import a key manager to the environment specified by the flag --environment, -e.
+Environment specific values are read from the configs of the matching environment in the params file specified by the flag --params.
+The configs are merged over the key manager configuration and environment variables in them (${VAR}) are substituted.
+The secrets of the key manager, which are not exported, should be provided in the params file or the import fails.`
+
+const importKeyManagerCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportKeyManagerCmdLiteral + ` -f ~/Okta.yaml -e dev
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportKeyManagerCmdLiteral + ` -f ~/Okta.yaml --params ~/okta_params.yaml -e prod --update
+NOTE: The flags (--file (-f) and --environment (-e)) are mandatory.
+A params file looks like below.
+environments:
+  - name: prod
+    configs:
+      issuer: https://prod.okta.com/oauth2/default
+      additionalProperties:
+        client_secret: ${OKTA_CLIENT_SECRET}`
+
+// ImportKeyManagerCmd represents the import key-manager command
+var ImportKeyManagerCmd = &cobra.Command{
+	Use:     ImportKeyManagerCmdLiteral,
+	Short:   importKeyManagerCmdShortDesc,
+	Long:    importKeyManagerCmdLongDesc,
+	Example: importKeyManagerCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportKeyManagerCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(importKeyManagerEnvironment)
+		name, err := impl.ImportKeyManager(accessToken, importKeyManagerEnvironment, importKeyManagerFile,
+			importKeyManagerParamsFile, importKeyManagerUpdate)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while importing key manager", err)
+		}
+		fmt.Println("Key manager " + name + " imported successfully")
+	},
+}
+
+func init() {
+	ImportCmd.AddCommand(ImportKeyManagerCmd)
+	ImportKeyManagerCmd.Flags().StringVarP(&importKeyManagerFile, "file", "f", "",
+		"Key manager file to be imported")
+	ImportKeyManagerCmd.Flags().StringVarP(&importKeyManagerParamsFile, "params", "", "",
+		"Params file with the environment specific values of the key manager")
+	ImportKeyManagerCmd.Flags().BoolVarP(&importKeyManagerUpdate, "update", "u", false,
+		"Update the key manager if it already exists")
+	ImportKeyManagerCmd.Flags().StringVarP(&importKeyManagerEnvironment, "environment", "e", "",
+		"Environment to which the key manager should be imported")
+	_ = ImportKeyManagerCmd.MarkFlagRequired("file")
+	_ = ImportKeyManagerCmd.MarkFlagRequired("environment")
+}
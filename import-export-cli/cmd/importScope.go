@@ -0,0 +1,73 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importScopeFile string
+var importScopeUpdate bool
+var importScopeEnvironment string
+
+// ImportScope command related usage Info
+const ImportScopeCmdLiteral = "scope"
+const importScopeCmdShortDesc = "Import shared scopes"
+const importScopeCmdLongDesc = `Import shared scopes with their role bindings to the environment specified by the flag --environment, -e.
+The flag --file, -f can be a shared scope file or a directory of shared scope files, such as the scopes directory written by the export apis command.`
+
+const importScopeCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportScopeCmdLiteral + ` -f ~/read_orders.yaml -e dev
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportScopeCmdLiteral + ` -f ~/.wso2apictl/exported/scopes/dev -e prod --update
+NOTE: The flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportScopeCmd represents the import scope command
+var ImportScopeCmd = &cobra.Command{
+	Use:     ImportScopeCmdLiteral,
+	Short:   importScopeCmdShortDesc,
+	Long:    importScopeCmdLongDesc,
+	Example: importScopeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportScopeCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(importScopeEnvironment)
+		imported, err := impl.ImportSharedScopes(accessToken, importScopeEnvironment, importScopeFile,
+			importScopeUpdate)
+		for _, name := range imported {
+			fmt.Println("Shared scope " + name + " imported successfully")
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error while importing shared scopes", err)
+		}
+	},
+}
+
+func init() {
+	ImportCmd.AddCommand(ImportScopeCmd)
+	ImportScopeCmd.Flags().StringVarP(&importScopeFile, "file", "f", "",
+		"Shared scope file or directory to be imported")
+	ImportScopeCmd.Flags().BoolVarP(&importScopeUpdate, "update", "u", false,
+		"Update the shared scopes if they already exist")
+	ImportScopeCmd.Flags().StringVarP(&importScopeEnvironment, "environment", "e", "",
+		"Environment to which the shared scopes should be imported")
+	_ = ImportScopeCmd.MarkFlagRequired("file")
+	_ = ImportScopeCmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var initPolicyGateways []string
+var initPolicyFlows []string
+var initPolicyForced bool
+
+// InitPolicy command related usage Info
+const InitPolicyCmdLiteral = "policy"
+const initPolicyCmdShortDesc = "Initialize an operation policy in given path"
+const initPolicyCmdLongDesc = `Initialize an operation policy in given path with its specification, templates and sample parameters.
+The name of the policy is the name of the directory. The templates of the supported gateways are created (.j2 for Synapse and .gotmpl for ChoreoConnect)
+and the sample parameters are written to ` + utils.PolicySampleParamsFile + `, which is not imported with the policy.`
+
+const initPolicyCmdExamples = utils.ProjectName + ` init ` + InitPolicyCmdLiteral + ` AddHeader
+` + utils.ProjectName + ` init ` + InitPolicyCmdLiteral + ` policies/AddHeader --gateways Synapse --flows request,response`
+
+// InitPolicyCmd represents the init policy command
+var InitPolicyCmd = &cobra.Command{
+	Use:     InitPolicyCmdLiteral + " [policy path]",
+	Short:   initPolicyCmdShortDesc,
+	Long:    initPolicyCmdLongDesc,
+	Example: initPolicyCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + "init " + InitPolicyCmdLiteral + " called")
+		err := impl.InitOperationPolicy(args[0], initPolicyGateways, initPolicyFlows, initPolicyForced)
+		if err != nil {
+			utils.HandleErrorAndExit("Error initializing policy", err)
+		}
+		fmt.Println("Policy initialized in " + args[0])
+	},
+}
+
+func init() {
+	InitCommand.AddCommand(InitPolicyCmd)
+	InitPolicyCmd.Flags().StringSliceVarP(&initPolicyGateways, "gateways", "", []string{impl.PolicyGatewaySynapse,
+		impl.PolicyGatewayChoreoConnect}, "Gateways supported by the policy")
+	InitPolicyCmd.Flags().StringSliceVarP(&initPolicyFlows, "flows", "", []string{"request", "response", "fault"},
+		"Flows the policy is applicable to")
+	InitPolicyCmd.Flags().BoolVarP(&initPolicyForced, "force", "f", false, "Force create policy")
+}
@@ -0,0 +1,50 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Migrate command related usage Info
+const MigrateCmdLiteral = "migrate"
+const migrateCmdShortDesc = "Migrate projects to the schema of a newer API Manager version"
+
+const migrateCmdLongDesc = `Migrate API, API Product and Application projects exported from an older API Manager version ` +
+	`to the project schema of a newer version offline`
+
+const migrateCmdExamples = utils.ProjectName + ` ` + MigrateCmdLiteral + ` ` + MigrateProjectCmdLiteral + ` ./PizzaShackAPI-1.0.0 --to 4.x`
+
+// MigrateCmd represents the migrate command
+var MigrateCmd = &cobra.Command{
+	Use:     MigrateCmdLiteral,
+	Short:   migrateCmdShortDesc,
+	Long:    migrateCmdLongDesc,
+	Example: migrateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + MigrateCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(MigrateCmd)
+}
@@ -0,0 +1,83 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var migrateProjectTargetVersion string
+var migrateProjectDryRun bool
+
+// MigrateProject command related usage Info
+const MigrateProjectCmdLiteral = "project"
+const migrateProjectCmdShortDesc = "Migrate projects to the schema of a newer API Manager version"
+
+const migrateProjectCmdLongDesc = `Migrate the projects found in the given path to the project schema of the API Manager ` +
+	`version specified by flag (--to). The path can be a project directory, a project archive, a deployment directory ` +
+	`or a directory containing any of them such as the output directory of export apis. API definitions, deployment ` +
+	`environments and params files are rewritten in place: version headers are updated, endpoint configurations of ` +
+	`older versions are converted and mediation policies are converted to operation policies. Conversions which lose ` +
+	`information are reported as warnings. Use the flag (--dry-run) to see the changes without writing them.`
+
+const migrateProjectCmdExamples = utils.ProjectName + ` ` + MigrateCmdLiteral + ` ` + MigrateProjectCmdLiteral + ` ./PizzaShackAPI-1.0.0 --to 4.x
+` + utils.ProjectName + ` ` + MigrateCmdLiteral + ` ` + MigrateProjectCmdLiteral + ` ./PizzaShackAPI_1.0.0.zip --to 4.2.0
+` + utils.ProjectName + ` ` + MigrateCmdLiteral + ` ` + MigrateProjectCmdLiteral + ` ~/.wso2apictl/exported/migration/dev/tenant-default/apis --to 4.x --dry-run
+NOTE: The flag (--to) is mandatory.`
+
+// MigrateProjectCmd represents the migrate project command
+var MigrateProjectCmd = &cobra.Command{
+	Use:     MigrateProjectCmdLiteral + " <path> --to <version>",
+	Short:   migrateProjectCmdShortDesc,
+	Long:    migrateProjectCmdLongDesc,
+	Example: migrateProjectCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + MigrateProjectCmdLiteral + " called")
+		executeMigrateProjectCmd(args[0])
+	},
+}
+
+func executeMigrateProjectCmd(path string) {
+	targetVersion, err := impl.ResolveMigrationTargetVersion(migrateProjectTargetVersion)
+	if err != nil {
+		utils.HandleErrorAndExit("Error migrating projects", err)
+	}
+	reports, err := impl.MigrateProjects(path, targetVersion, migrateProjectDryRun)
+	if err != nil {
+		utils.HandleErrorAndExit("Error migrating projects in "+path, err)
+	}
+	if failed := impl.PrintProjectMigrationReports(reports, migrateProjectDryRun); failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// init using Cobra
+func init() {
+	MigrateCmd.AddCommand(MigrateProjectCmd)
+	MigrateProjectCmd.Flags().StringVar(&migrateProjectTargetVersion, "to", "", "API Manager version to migrate "+
+		"the projects to (eg: 4.x, 4.2.0)")
+	MigrateProjectCmd.Flags().BoolVar(&migrateProjectDryRun, "dry-run", false, "Report the changes without "+
+		"writing them")
+	_ = MigrateProjectCmd.MarkFlagRequired("to")
+}
@@ -0,0 +1,63 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var mockAPIPort int
+
+// MockAPI command related usage Info
+const MockAPICmdLiteral = "api"
+const mockAPICmdShortDesc = "Run a local mock server for an API project"
+
+const mockAPICmdLongDesc = `Serve the operations in the OpenAPI or GraphQL definition of an API project locally. ` +
+	`Responses are taken from the examples of the definition or generated from the schemas. The API is served under ` +
+	`its context and version as in the gateway. Any bearer token, basic credential or API key sent in the ` +
+	`authorization header or the API key header of the API is accepted for secured operations, the CORS ` +
+	`configuration of the API is applied and every request is logged.`
+
+const mockAPICmdExamples = utils.ProjectName + ` ` + MockCmdLiteral + ` ` + MockAPICmdLiteral + ` ./PizzaShackAPI
+` + utils.ProjectName + ` ` + MockCmdLiteral + ` ` + MockAPICmdLiteral + ` ./PizzaShackAPI --port 9090
+` + utils.ProjectName + ` ` + MockCmdLiteral + ` ` + MockAPICmdLiteral + ` ./PizzaShackAPI_1.0.0.zip -p 9090`
+
+// MockAPICmd represents the mock api command
+var MockAPICmd = &cobra.Command{
+	Use:     MockAPICmdLiteral + " <project>",
+	Short:   mockAPICmdShortDesc,
+	Long:    mockAPICmdLongDesc,
+	Example: mockAPICmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + MockAPICmdLiteral + " called")
+		err := impl.RunMockServer(args[0], mockAPIPort)
+		if err != nil {
+			utils.HandleErrorAndExit("Error running the mock server for "+args[0], err)
+		}
+	},
+}
+
+// init using Cobra
+func init() {
+	MockCmd.AddCommand(MockAPICmd)
+	MockAPICmd.Flags().IntVarP(&mockAPIPort, "port", "p", 8080, "Port of the mock server")
+}
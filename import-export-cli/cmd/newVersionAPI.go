@@ -0,0 +1,107 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var newVersionAPIName string
+var newVersionAPIVersion string
+var newVersionAPIProvider string
+var newVersionAPIEnvironment string
+var newVersionAPIOptions impl.NewAPIVersionOptions
+var newVersionAPICopyDocuments bool
+var newVersionAPICopyPolicies bool
+
+// NewVersionAPI command related usage Info
+const NewVersionAPICmdLiteral = "api"
+const newVersionAPICmdShortDesc = "Create a new version of an API"
+const newVersionAPICmdLongDesc = `Create a new version of an API in the environment specified by the flag --environment, -e by copying an existing version.
+The new version can be published and the existing version can be deprecated in the same run. If a step fails, the new version is deleted and the existing version is made the default version again if it was the default version.
+If the working directory is in a git repository (or a directory is specified by the flag --project), the local project of the existing version is copied to a sibling directory <name>-<new-version> and updated.`
+
+const newVersionAPICmdExamples = utils.ProjectName + ` ` + NewVersionCmdLiteral + ` ` + NewVersionAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --new-version 2.0.0 -e dev
+` + utils.ProjectName + ` ` + NewVersionCmdLiteral + ` ` + NewVersionAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -r admin --new-version 2.0.0 --default-version --publish --deprecate-old -e dev
+` + utils.ProjectName + ` ` + NewVersionCmdLiteral + ` ` + NewVersionAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --new-version 2.0.0 --copy-docs=false --copy-policies=false -e dev
+` + utils.ProjectName + ` ` + NewVersionCmdLiteral + ` ` + NewVersionAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --new-version 2.0.0 --project ./apis -e dev
+NOTE: The flags (--name (-n), --version (-v), --new-version and --environment (-e)) are mandatory.`
+
+// NewVersionAPICmd represents the new-version api command
+var NewVersionAPICmd = &cobra.Command{
+	Use:     NewVersionAPICmdLiteral,
+	Short:   newVersionAPICmdShortDesc,
+	Long:    newVersionAPICmdLongDesc,
+	Example: newVersionAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + NewVersionAPICmdLiteral + " called")
+		if newVersionAPIOptions.NewVersion == newVersionAPIVersion {
+			utils.HandleErrorAndExit("Invalid flags", errors.New("the new version should be different from "+
+				newVersionAPIVersion))
+		}
+		if newVersionAPIOptions.DeprecateOld && !newVersionAPIOptions.Publish {
+			utils.HandleErrorAndExit("Invalid flags", errors.New("--deprecate-old requires --publish"))
+		}
+		newVersionAPIOptions.SkipDocuments = !newVersionAPICopyDocuments
+		newVersionAPIOptions.SkipOperationPolicies = !newVersionAPICopyPolicies
+		accessToken := getPublisherAccessToken(newVersionAPIEnvironment)
+		result, err := impl.CreateNewAPIVersion(accessToken, newVersionAPIEnvironment, newVersionAPIName,
+			newVersionAPIVersion, newVersionAPIProvider, newVersionAPIOptions)
+		if result != nil {
+			impl.PrintNewAPIVersionResult(result)
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error while creating version "+newVersionAPIOptions.NewVersion+" of API "+
+				newVersionAPIName, err)
+		}
+		fmt.Println("Version " + newVersionAPIOptions.NewVersion + " of API " + newVersionAPIName +
+			" created successfully. API ID: " + result.NewAPIId)
+	},
+}
+
+func init() {
+	NewVersionCmd.AddCommand(NewVersionAPICmd)
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIName, "name", "n", "", "Name of the API")
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIVersion, "version", "v", "", "Existing version of the API")
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIProvider, "provider", "r", "", "Provider of the API")
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIOptions.NewVersion, "new-version", "", "",
+		"Version of the API to be created")
+	NewVersionAPICmd.Flags().BoolVarP(&newVersionAPIOptions.DefaultVersion, "default-version", "", false,
+		"Make the new version the default version of the API")
+	NewVersionAPICmd.Flags().BoolVarP(&newVersionAPICopyDocuments, "copy-docs", "", true,
+		"Copy the documents of the existing version")
+	NewVersionAPICmd.Flags().BoolVarP(&newVersionAPICopyPolicies, "copy-policies", "", true,
+		"Copy the operation policies of the existing version")
+	NewVersionAPICmd.Flags().BoolVarP(&newVersionAPIOptions.Publish, "publish", "", false,
+		"Publish the new version")
+	NewVersionAPICmd.Flags().BoolVarP(&newVersionAPIOptions.DeprecateOld, "deprecate-old", "", false,
+		"Deprecate the existing version after publishing the new version")
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIOptions.ProjectPath, "project", "", "",
+		"Directory to search the local project of the API in")
+	NewVersionAPICmd.Flags().StringVarP(&newVersionAPIEnvironment, "environment", "e", "", "Environment of the API")
+	_ = NewVersionAPICmd.MarkFlagRequired("name")
+	_ = NewVersionAPICmd.MarkFlagRequired("version")
+	_ = NewVersionAPICmd.MarkFlagRequired("new-version")
+	_ = NewVersionAPICmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Prune command related usage Info
+const PruneCmdLiteral = "prune"
+const pruneCmdShortDesc = "Prune unused artifacts in an environment"
+
+const pruneCmdLongDesc = `Delete unused artifacts such as old API/API Product revisions in the environment specified by flag (--environment, -e)`
+
+const pruneCmdExamples = utils.ProjectName + ` ` + PruneCmdLiteral + ` ` + PruneRevisionsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --keep 2 -e dev`
+
+// PruneCmd represents the prune command
+var PruneCmd = &cobra.Command{
+	Use:     PruneCmdLiteral,
+	Short:   pruneCmdShortDesc,
+	Long:    pruneCmdLongDesc,
+	Example: pruneCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PruneCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(PruneCmd)
+}
@@ -0,0 +1,86 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var pruneRevisionsName string
+var pruneRevisionsVersion string
+var pruneRevisionsProvider string
+var pruneRevisionsType string
+var pruneRevisionsKeep int
+var pruneRevisionsDryRun bool
+var pruneRevisionsEnvironment string
+
+// PruneRevisions command related usage Info
+const PruneRevisionsCmdLiteral = "revisions"
+const pruneRevisionsCmdShortDesc = "Delete old revisions of an API/API Product"
+const pruneRevisionsCmdLongDesc = "Delete the revisions of an API or API Product in the environment specified by the flag --environment, -e except the latest revisions specified by the flag --keep. Revisions deployed in a gateway environment are never deleted."
+
+const pruneRevisionsCmdExamples = utils.ProjectName + ` ` + PruneCmdLiteral + ` ` + PruneRevisionsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --keep 2 -e dev
+` + utils.ProjectName + ` ` + PruneCmdLiteral + ` ` + PruneRevisionsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --keep 0 --dry-run -e dev
+` + utils.ProjectName + ` ` + PruneCmdLiteral + ` ` + PruneRevisionsCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --type api-product --keep 1 -e dev
+NOTE: The flags (--name (-n), --version (-v), --keep and --environment (-e)) are mandatory.
+Deployed revisions are counted towards the revisions kept by --keep.`
+
+// PruneRevisionsCmd represents the prune revisions command
+var PruneRevisionsCmd = &cobra.Command{
+	Use:     PruneRevisionsCmdLiteral,
+	Short:   pruneRevisionsCmdShortDesc,
+	Long:    pruneRevisionsCmdLongDesc,
+	Example: pruneRevisionsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PruneRevisionsCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(pruneRevisionsEnvironment)
+		artifact := impl.RevisionArtifact{Type: pruneRevisionsType, Name: pruneRevisionsName,
+			Version: pruneRevisionsVersion, Provider: pruneRevisionsProvider}
+		revisions, err := impl.PruneRevisions(accessToken, pruneRevisionsEnvironment, artifact, pruneRevisionsKeep,
+			pruneRevisionsDryRun)
+		for _, revision := range revisions {
+			if pruneRevisionsDryRun {
+				fmt.Println(revision.RevisionNumber + " of " + artifact.String() + " will be deleted")
+			} else {
+				fmt.Println(revision.RevisionNumber + " of " + artifact.String() + " deleted")
+			}
+		}
+		if err != nil {
+			utils.HandleErrorAndExit("Error while pruning the revisions of "+artifact.String(), err)
+		}
+		if !pruneRevisionsDryRun {
+			fmt.Println(strconv.Itoa(len(revisions)) + " revision(s) of " + artifact.String() + " deleted successfully")
+		}
+	},
+}
+
+func init() {
+	PruneCmd.AddCommand(PruneRevisionsCmd)
+	addRevisionArtifactFlags(PruneRevisionsCmd, &pruneRevisionsName, &pruneRevisionsVersion, &pruneRevisionsProvider,
+		&pruneRevisionsType, &pruneRevisionsEnvironment)
+	PruneRevisionsCmd.Flags().IntVarP(&pruneRevisionsKeep, "keep", "", 0, "Number of latest revisions to keep")
+	PruneRevisionsCmd.Flags().BoolVarP(&pruneRevisionsDryRun, "dry-run", "", false,
+		"List the revisions to be deleted without deleting them")
+	_ = PruneRevisionsCmd.MarkFlagRequired("keep")
+}
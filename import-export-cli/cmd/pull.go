@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var pullDestination string
+var pullRegistryUsername string
+var pullRegistryPassword string
+var pullPlainHTTP bool
+
+// Pull command related usage Info
+const PullCmdLiteral = "pull"
+const pullCmdShortDesc = "Pull an API/API Product/Application project from an OCI registry"
+
+const pullCmdLongDesc = `Pull an API, API Product or Application project stored as an artifact in an OCI registry. ` +
+	`The archive of the project is written to the destination directory and can be imported with the import commands. ` +
+	`Registry credentials can be given with the flags or the ` + utils.OCIUsernameEnvVariable + ` and ` +
+	utils.OCIPasswordEnvVariable + ` environment variables`
+
+const pullCmdExamples = utils.ProjectName + ` ` + PullCmdLiteral + ` oci://localhost:5000/apis/pizzashack:1.0.0
+` + utils.ProjectName + ` ` + PullCmdLiteral + ` oci://registry.example.com/products/leasing:1.0.0 -d /home/prod/Projects/ --username admin --password admin
+` + utils.ProjectName + ` ` + PullCmdLiteral + ` oci://registry.example.com/apis/pizzashack@sha256:5b0bcabd1ed22e9fb1310cf6c2dec7cdef19f0ad69efa1f392e94a4333501270`
+
+// PullCmd represents the pull command
+var PullCmd = &cobra.Command{
+	Use:     PullCmdLiteral + " <oci-reference>",
+	Short:   pullCmdShortDesc,
+	Long:    pullCmdLongDesc,
+	Example: pullCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PullCmdLiteral + " called")
+		executePullCmd(args[0])
+	},
+}
+
+func executePullCmd(reference string) {
+	destination := pullDestination
+	if destination == "" {
+		pwd, err := os.Getwd()
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the current directory", err)
+		}
+		destination = pwd
+	}
+	bundlePath, info, err := impl.PullProjectFromOCIRegistry(reference, destination, pullRegistryUsername,
+		pullRegistryPassword, pullPlainHTTP)
+	if err != nil {
+		utils.HandleErrorAndExit("Error pulling "+reference, err)
+	}
+	fmt.Println("The " + info.Type + " project " + info.Name + " is pulled to " + bundlePath)
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(PullCmd)
+	PullCmd.Flags().StringVarP(&pullDestination, "destination", "d", "", "Path of "+
+		"the directory where the project should be written")
+	PullCmd.Flags().StringVar(&pullRegistryUsername, "username", "", "Username of the OCI registry")
+	PullCmd.Flags().StringVar(&pullRegistryPassword, "password", "", "Password of the OCI registry")
+	PullCmd.Flags().BoolVar(&pullPlainHTTP, "plain-http", false, "Connect to the OCI registry with plain HTTP. "+
+		"Plain HTTP is always used for registries in localhost")
+}
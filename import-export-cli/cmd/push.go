@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var pushRegistryUsername string
+var pushRegistryPassword string
+var pushPlainHTTP bool
+
+// Push command related usage Info
+const PushCmdLiteral = "push"
+const pushCmdShortDesc = "Push an API/API Product/Application project to an OCI registry"
+
+const pushCmdLongDesc = `Push an API, API Product or Application project to an OCI registry as an artifact. The annotations ` +
+	`of the artifact are taken from the meta information of the project (name, version and provider). Registry ` +
+	`credentials can be given with the flags or the ` + utils.OCIUsernameEnvVariable + ` and ` +
+	utils.OCIPasswordEnvVariable + ` environment variables`
+
+const pushCmdExamples = utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAPICmdLiteral + ` PizzaShackAPI-1.0.0 oci://localhost:5000/apis/pizzashack:1.0.0
+` + utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAPIProductCmdLiteral + ` LeasingProduct-1.0.0 oci://registry.example.com/products/leasing:1.0.0 --username admin --password admin
+` + utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAppCmdLiteral + ` admin_SampleApp.zip oci://registry.example.com/apps/sample-app:1.0.0`
+
+// PushCmd represents the push command
+var PushCmd = &cobra.Command{
+	Use:     PushCmdLiteral,
+	Short:   pushCmdShortDesc,
+	Long:    pushCmdLongDesc,
+	Example: pushCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PushCmdLiteral + " called")
+
+	},
+}
+
+// executePushCmd pushes the project given in the arguments to the OCI reference given in the arguments
+func executePushCmd(projectType string, args []string) {
+	projectPath, reference := args[0], args[1]
+	digest, err := impl.PushProjectToOCIRegistry(projectPath, projectType, reference, pushRegistryUsername,
+		pushRegistryPassword, pushPlainHTTP)
+	if err != nil {
+		utils.HandleErrorAndExit("Error pushing "+projectPath+" to "+reference, err)
+	}
+	fmt.Println("Successfully pushed " + projectPath + " to " + reference)
+	fmt.Println("Digest: " + digest)
+}
+
+// addPushFlags adds the flags common to all the push commands
+func addPushFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&pushRegistryUsername, "username", "", "Username of the OCI registry")
+	cmd.Flags().StringVar(&pushRegistryPassword, "password", "", "Password of the OCI registry")
+	cmd.Flags().BoolVar(&pushPlainHTTP, "plain-http", false, "Connect to the OCI registry with plain HTTP. "+
+		"Plain HTTP is always used for registries in localhost")
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(PushCmd)
+}
@@ -0,0 +1,51 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// PushAPI command related usage Info
+const PushAPICmdLiteral = "api"
+const pushAPICmdShortDesc = "Push an API project to an OCI registry"
+
+const pushAPICmdLongDesc = "Push an API project directory or archive to an OCI registry as an artifact"
+
+const pushAPICmdExamples = utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAPICmdLiteral + ` PizzaShackAPI-1.0.0 oci://localhost:5000/apis/pizzashack:1.0.0`
+
+// PushAPICmd represents the push api command
+var PushAPICmd = &cobra.Command{
+	Use:     PushAPICmdLiteral + " <path-to-project> <oci-reference>",
+	Short:   pushAPICmdShortDesc,
+	Long:    pushAPICmdLongDesc,
+	Example: pushAPICmdExamples,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PushCmdLiteral + " " + PushAPICmdLiteral + " called")
+		executePushCmd(utils.ProjectTypeApi, args)
+	},
+}
+
+// init using Cobra
+func init() {
+	PushCmd.AddCommand(PushAPICmd)
+	addPushFlags(PushAPICmd)
+}
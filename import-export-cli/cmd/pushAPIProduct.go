@@ -0,0 +1,51 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// PushAPIProduct command related usage Info
+const PushAPIProductCmdLiteral = "api-product"
+const pushAPIProductCmdShortDesc = "Push an API Product project to an OCI registry"
+
+const pushAPIProductCmdLongDesc = "Push an API Product project directory or archive to an OCI registry as an artifact"
+
+const pushAPIProductCmdExamples = utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAPIProductCmdLiteral + ` LeasingProduct-1.0.0 oci://localhost:5000/products/leasing:1.0.0`
+
+// PushAPIProductCmd represents the push api-product command
+var PushAPIProductCmd = &cobra.Command{
+	Use:     PushAPIProductCmdLiteral + " <path-to-project> <oci-reference>",
+	Short:   pushAPIProductCmdShortDesc,
+	Long:    pushAPIProductCmdLongDesc,
+	Example: pushAPIProductCmdExamples,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PushCmdLiteral + " " + PushAPIProductCmdLiteral + " called")
+		executePushCmd(utils.ProjectTypeApiProduct, args)
+	},
+}
+
+// init using Cobra
+func init() {
+	PushCmd.AddCommand(PushAPIProductCmd)
+	addPushFlags(PushAPIProductCmd)
+}
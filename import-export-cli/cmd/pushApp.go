@@ -0,0 +1,51 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// PushApp command related usage Info
+const PushAppCmdLiteral = "app"
+const pushAppCmdShortDesc = "Push an Application project to an OCI registry"
+
+const pushAppCmdLongDesc = "Push an Application project directory or archive to an OCI registry as an artifact"
+
+const pushAppCmdExamples = utils.ProjectName + ` ` + PushCmdLiteral + ` ` + PushAppCmdLiteral + ` admin_SampleApp.zip oci://localhost:5000/apps/sample-app:latest`
+
+// PushAppCmd represents the push app command
+var PushAppCmd = &cobra.Command{
+	Use:     PushAppCmdLiteral + " <path-to-project> <oci-reference>",
+	Short:   pushAppCmdShortDesc,
+	Long:    pushAppCmdLongDesc,
+	Example: pushAppCmdExamples,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PushCmdLiteral + " " + PushAppCmdLiteral + " called")
+		executePushCmd(utils.ProjectTypeApplication, args)
+	},
+}
+
+// init using Cobra
+func init() {
+	PushCmd.AddCommand(PushAppCmd)
+	addPushFlags(PushAppCmd)
+}
@@ -0,0 +1,80 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// skipBackup is shared by the destructive commands which back up the affected artifact to the recycle bin
+var skipBackup bool
+
+// RecycleBin command related usage Info
+const RecycleBinCmdLiteral = "recycle-bin"
+const recycleBinCmdShortDesc = "Manage the artifacts backed up before destructive commands"
+
+const recycleBinCmdLongDesc = `Manage the recycle bin where the artifacts are backed up before the delete and undeploy commands.
+The recycle bin is kept under the config directory and its oldest entries are removed when it grows beyond
+the size set with "` + utils.ProjectName + ` set --recycle-bin-max-size". Use "` + utils.ProjectName + ` undo" to restore an entry.`
+
+const recycleBinCmdExamples = utils.ProjectName + ` ` + RecycleBinCmdLiteral + ` ` + RecycleBinListCmdLiteral
+
+// RecycleBinCmd represents the recycle-bin command
+var RecycleBinCmd = &cobra.Command{
+	Use:     RecycleBinCmdLiteral,
+	Short:   recycleBinCmdShortDesc,
+	Long:    recycleBinCmdLongDesc,
+	Example: recycleBinCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RecycleBinCmdLiteral + " called")
+	},
+}
+
+// addSkipBackupFlag adds the flag to skip the recycle bin backup to a destructive command
+func addSkipBackupFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&skipBackup, "skip-backup", "", false,
+		"Do not back up the artifact to the recycle bin before the command")
+}
+
+// backupToRecycleBin backs up the artifact affected by a destructive command unless --skip-backup is given. A
+// failed backup is reported as a warning and the command continues without a backup.
+func backupToRecycleBin(accessToken, environment, command string, artifact impl.RecycleBinArtifact) {
+	if skipBackup {
+		return
+	}
+	entry, err := impl.BackupToRecycleBin(accessToken, environment, command, artifact)
+	if err != nil {
+		fmt.Println(utils.LogPrefixWarning + "Could not back up " + artifact.Type + " " + artifact.Name +
+			" to the recycle bin. Continuing without a backup: " + err.Error())
+		return
+	}
+	id := strconv.Itoa(entry.ID)
+	fmt.Println("Backed up " + artifact.Type + " " + artifact.Name + " to the recycle bin (id " + id + "). " +
+		"Use \"" + utils.ProjectName + " " + UndoCmdLiteral + " " + id + "\" to restore it")
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(RecycleBinCmd)
+}
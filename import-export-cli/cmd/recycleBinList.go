@@ -0,0 +1,64 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var recycleBinListCmdFormat string
+
+// RecycleBinList command related usage Info
+const RecycleBinListCmdLiteral = "list"
+const recycleBinListCmdShortDesc = "List the entries of the recycle bin"
+const recycleBinListCmdLongDesc = `List the artifacts backed up to the recycle bin before the delete and undeploy commands`
+
+const recycleBinListCmdExamples = utils.ProjectName + ` ` + RecycleBinCmdLiteral + ` ` + RecycleBinListCmdLiteral + `
+` + utils.ProjectName + ` ` + RecycleBinCmdLiteral + ` ` + RecycleBinListCmdLiteral + ` --format "{{ jsonPretty . }}"`
+
+// RecycleBinListCmd represents the recycle-bin list command
+var RecycleBinListCmd = &cobra.Command{
+	Use:     RecycleBinListCmdLiteral,
+	Short:   recycleBinListCmdShortDesc,
+	Long:    recycleBinListCmdLongDesc,
+	Example: recycleBinListCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RecycleBinListCmdLiteral + " called")
+		entries, err := impl.ListRecycleBinEntries()
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the recycle bin", err)
+		}
+		if len(entries) == 0 && recycleBinListCmdFormat == "" {
+			fmt.Println("The recycle bin is empty")
+			return
+		}
+		impl.PrintRecycleBinEntries(entries, recycleBinListCmdFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	RecycleBinCmd.AddCommand(RecycleBinListCmd)
+	RecycleBinListCmd.Flags().StringVarP(&recycleBinListCmdFormat, "format", "", "", "Pretty-print the entries "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+}
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Render command related usage Info
+const RenderCmdLiteral = "render"
+const renderCmdShortDesc = "Render an artifact locally"
+
+const renderCmdLongDesc = `Render the templates of an artifact such as an operation policy locally for inspection`
+
+const renderCmdExamples = utils.ProjectName + ` ` + RenderCmdLiteral + ` ` + RenderPolicyCmdLiteral + ` -f ~/AddHeader --params ~/AddHeader/sample_params.yaml`
+
+// RenderCmd represents the render command
+var RenderCmd = &cobra.Command{
+	Use:     RenderCmdLiteral,
+	Short:   renderCmdShortDesc,
+	Long:    renderCmdLongDesc,
+	Example: renderCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RenderCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(RenderCmd)
+}
@@ -0,0 +1,85 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var renderPolicyFile string
+var renderPolicyParams string
+var renderPolicyGateway string
+
+// RenderPolicy command related usage Info
+const RenderPolicyCmdLiteral = "policy"
+const renderPolicyCmdShortDesc = "Render the templates of an operation policy"
+const renderPolicyCmdLongDesc = `Render the templates of an operation policy with the parameter values given in the file specified by the flag --params.
+The values are validated against the policy attributes and the default values are used for the parameters which are not given.
+If the flag --params is not given, ` + utils.PolicySampleParamsFile + ` of the policy directory is used when it exists.`
+
+const renderPolicyCmdExamples = utils.ProjectName + ` ` + RenderCmdLiteral + ` ` + RenderPolicyCmdLiteral + ` -f ~/AddHeader
+` + utils.ProjectName + ` ` + RenderCmdLiteral + ` ` + RenderPolicyCmdLiteral + ` -f ~/AddHeader --params prod_params.yaml --gateway Synapse
+NOTE: The flag (--file (-f)) is mandatory.`
+
+// RenderPolicyCmd represents the render policy command
+var RenderPolicyCmd = &cobra.Command{
+	Use:     RenderPolicyCmdLiteral,
+	Short:   renderPolicyCmdShortDesc,
+	Long:    renderPolicyCmdLongDesc,
+	Example: renderPolicyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RenderPolicyCmdLiteral + " called")
+		paramsPath := renderPolicyParams
+		if paramsPath == "" {
+			sampleParamsPath := filepath.Join(renderPolicyFile, utils.PolicySampleParamsFile)
+			if utils.IsFileExist(sampleParamsPath) {
+				paramsPath = sampleParamsPath
+			}
+		}
+		rendered, err := impl.RenderOperationPolicy(renderPolicyFile, paramsPath, renderPolicyGateway)
+		if err != nil {
+			utils.HandleErrorAndExit("Error rendering policy", err)
+		}
+		var gateways []string
+		for gateway := range rendered {
+			gateways = append(gateways, gateway)
+		}
+		sort.Strings(gateways)
+		for _, gateway := range gateways {
+			fmt.Println("--- " + gateway + " ---")
+			fmt.Println(rendered[gateway])
+		}
+	},
+}
+
+func init() {
+	RenderCmd.AddCommand(RenderPolicyCmd)
+	RenderPolicyCmd.Flags().StringVarP(&renderPolicyFile, "file", "f", "", "Directory of the policy")
+	RenderPolicyCmd.Flags().StringVarP(&renderPolicyParams, "params", "", "",
+		"YAML or JSON file with the values of the policy parameters")
+	RenderPolicyCmd.Flags().StringVarP(&renderPolicyGateway, "gateway", "", "",
+		"Gateway to render the template of ("+impl.PolicyGatewaySynapse+" or "+impl.PolicyGatewayChoreoConnect+")")
+	_ = RenderPolicyCmd.MarkFlagRequired("file")
+}
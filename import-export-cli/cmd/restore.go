@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Restore command related usage Info
+const RestoreCmdLiteral = "restore"
+const restoreCmdShortDesc = "Restore an API/API Product revision"
+
+const restoreCmdLongDesc = `Restore the working copy of an API/API Product in the environment specified by flag (--environment, -e) to a revision`
+
+const restoreCmdExamples = utils.ProjectName + ` ` + RestoreCmdLiteral + ` ` + RestoreRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -e dev`
+
+// RestoreCmd represents the restore command
+var RestoreCmd = &cobra.Command{
+	Use:     RestoreCmdLiteral,
+	Short:   restoreCmdShortDesc,
+	Long:    restoreCmdLongDesc,
+	Example: restoreCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RestoreCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(RestoreCmd)
+}
@@ -0,0 +1,71 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var restoreRevisionName string
+var restoreRevisionVersion string
+var restoreRevisionProvider string
+var restoreRevisionType string
+var restoreRevisionNum string
+var restoreRevisionEnvironment string
+
+// RestoreRevision command related usage Info
+const RestoreRevisionCmdLiteral = "revision"
+const restoreRevisionCmdShortDesc = "Restore a revision of an API/API Product"
+const restoreRevisionCmdLongDesc = "Restore the working copy of an API or API Product in the environment specified by the flag --environment, -e to the revision specified by the flag --rev"
+
+const restoreRevisionCmdExamples = utils.ProjectName + ` ` + RestoreCmdLiteral + ` ` + RestoreRevisionCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 2 -e dev
+` + utils.ProjectName + ` ` + RestoreCmdLiteral + ` ` + RestoreRevisionCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 -r admin --type api-product --rev 1 -e dev
+NOTE: The flags (--name (-n), --version (-v), --rev and --environment (-e)) are mandatory.`
+
+// RestoreRevisionCmd represents the restore revision command
+var RestoreRevisionCmd = &cobra.Command{
+	Use:     RestoreRevisionCmdLiteral,
+	Short:   restoreRevisionCmdShortDesc,
+	Long:    restoreRevisionCmdLongDesc,
+	Example: restoreRevisionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RestoreRevisionCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(restoreRevisionEnvironment)
+		artifact := impl.RevisionArtifact{Type: restoreRevisionType, Name: restoreRevisionName,
+			Version: restoreRevisionVersion, Provider: restoreRevisionProvider}
+		err := impl.RestoreRevision(accessToken, restoreRevisionEnvironment, artifact, restoreRevisionNum)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while restoring revision "+restoreRevisionNum+" of "+artifact.String(), err)
+		}
+		fmt.Println("Working copy of " + artifact.String() + " restored to revision " + restoreRevisionNum +
+			" successfully")
+	},
+}
+
+func init() {
+	RestoreCmd.AddCommand(RestoreRevisionCmd)
+	addRevisionArtifactFlags(RestoreRevisionCmd, &restoreRevisionName, &restoreRevisionVersion,
+		&restoreRevisionProvider, &restoreRevisionType, &restoreRevisionEnvironment)
+	RestoreRevisionCmd.Flags().StringVarP(&restoreRevisionNum, "rev", "", "", "Revision number to be restored")
+	_ = RestoreRevisionCmd.MarkFlagRequired("rev")
+}
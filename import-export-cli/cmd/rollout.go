@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Rollout command related usage Info
+const RolloutCmdLiteral = "rollout"
+const rolloutCmdShortDesc = "Progressively roll out a revision to gateway environments"
+
+const rolloutCmdLongDesc = `Deploy a revision available in the environment specified by flag (--environment, -e) to gateway environments stage by stage`
+
+const rolloutCmdExamples = utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 3 --stages gw-canary,gw-eu,gw-us -e prod`
+
+// RolloutCmd represents the rollout command
+var RolloutCmd = &cobra.Command{
+	Use:     RolloutCmdLiteral,
+	Short:   rolloutCmdShortDesc,
+	Long:    rolloutCmdLongDesc,
+	Example: rolloutCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RolloutCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(RolloutCmd)
+}
@@ -0,0 +1,136 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var rolloutAPIName string
+var rolloutAPIVersion string
+var rolloutAPIProvider string
+var rolloutAPIRevisionNum string
+var rolloutAPIStages []string
+var rolloutAPIVhost string
+var rolloutAPIVerify string
+var rolloutAPIWait time.Duration
+var rolloutAPIResume bool
+var rolloutAPIAbort bool
+var rolloutAPIStatus bool
+var rolloutAPIEnvironment string
+
+// RolloutAPI command related usage Info
+const RolloutAPICmdLiteral = "api"
+const rolloutAPICmdShortDesc = "Progressively roll out an API revision"
+const rolloutAPICmdLongDesc = `Deploy a revision of an API in the environment specified by the flag --environment, -e to the gateway environments specified by the flag --stages one stage at a time.
+After deploying to a stage, the verification step specified by the flag --verify is run and the rollout waits for the duration specified by the flag --wait before the next stage.
+The verification step is either a URL that should respond with a 2xx status or a command that should exit with 0. ` + impl.RolloutGatewayEnvPlaceholder + ` in the verification step is replaced with the gateway environment of the stage, which is also available to commands as the environment variable ` + impl.RolloutGatewayEnvVariable + `.
+If a stage fails, the new revision is undeployed and the previously deployed revision is redeployed in each gateway environment that was rolled out to. Gateway environments the revision was already deployed to before the rollout are left as they are.
+The state of the rollout is persisted in the config directory, so an interrupted rollout can be continued with --resume or rolled back with --abort.`
+
+const rolloutAPICmdExamples = utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 3 --stages gw-canary,gw-eu,gw-us -e prod
+` + utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 3 --stages gw-canary,gw-eu --verify https://{gatewayEnv}.example.com/pizzashack/1.0.0/menu --wait 5m -e prod
+` + utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --rev 3 --stages gw-canary,gw-eu --verify "./smoke-test.sh" -e prod
+` + utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --resume -e prod
+` + utils.ProjectName + ` ` + RolloutCmdLiteral + ` ` + RolloutAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 --abort -e prod
+NOTE: The flags (--name (-n), --version (-v) and --environment (-e)) are mandatory.
+The flags (--rev and --stages) are mandatory when starting a new rollout.`
+
+// RolloutAPICmd represents the rollout api command
+var RolloutAPICmd = &cobra.Command{
+	Use:     RolloutAPICmdLiteral,
+	Short:   rolloutAPICmdShortDesc,
+	Long:    rolloutAPICmdLongDesc,
+	Example: rolloutAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RolloutAPICmdLiteral + " called")
+		statePath := impl.GetRolloutStatePath(rolloutAPIEnvironment, rolloutAPIName, rolloutAPIVersion)
+		state, err := getRolloutState(statePath)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while preparing the rollout", err)
+		}
+		if rolloutAPIStatus {
+			impl.PrintRolloutState(state)
+			return
+		}
+		accessToken := getPublisherAccessToken(rolloutAPIEnvironment)
+		if rolloutAPIAbort {
+			err = impl.AbortRollout(accessToken, statePath, state)
+		} else {
+			err = impl.RunRollout(accessToken, statePath, state)
+		}
+		impl.PrintRolloutState(state)
+		if err != nil {
+			utils.HandleErrorAndExit("Rollout of revision "+state.Revision+" of API "+rolloutAPIName+" failed", err)
+		}
+	},
+}
+
+// getRolloutState loads the persisted rollout state when resuming, aborting or checking the status of a rollout,
+// or creates the state of a new rollout otherwise
+func getRolloutState(statePath string) (*impl.RolloutState, error) {
+	if rolloutAPIResume || rolloutAPIAbort || rolloutAPIStatus {
+		if rolloutAPIResume && rolloutAPIAbort {
+			return nil, errors.New("only one of --resume and --abort can be specified")
+		}
+		return impl.LoadRolloutState(statePath)
+	}
+	if rolloutAPIRevisionNum == "" || len(rolloutAPIStages) == 0 {
+		return nil, errors.New("--rev and --stages are required to start a rollout")
+	}
+	if utils.IsFileExist(statePath) {
+		state, err := impl.LoadRolloutState(statePath)
+		if err == nil && !state.IsRolloutFinished() {
+			return nil, fmt.Errorf("rollout of revision %s is in progress. Use --resume to continue it or "+
+				"--abort to roll it back", state.Revision)
+		}
+	}
+	return impl.NewRolloutState(rolloutAPIEnvironment, rolloutAPIName, rolloutAPIVersion, rolloutAPIProvider,
+		rolloutAPIRevisionNum, rolloutAPIStages, rolloutAPIVhost, rolloutAPIVerify, rolloutAPIWait), nil
+}
+
+func init() {
+	RolloutCmd.AddCommand(RolloutAPICmd)
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIName, "name", "n", "", "Name of the API")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIVersion, "version", "v", "", "Version of the API")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIProvider, "provider", "r", "", "Provider of the API")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIRevisionNum, "rev", "", "", "Revision number to be rolled out")
+	RolloutAPICmd.Flags().StringSliceVarP(&rolloutAPIStages, "stages", "", []string{},
+		"Gateway environments to roll out to, in order")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIVhost, "vhost", "", "",
+		"Virtual host to deploy the revision with")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIVerify, "verify", "", "",
+		"URL to probe or command to run after deploying to each stage")
+	RolloutAPICmd.Flags().DurationVarP(&rolloutAPIWait, "wait", "", 0, "Time to wait between the stages")
+	RolloutAPICmd.Flags().BoolVarP(&rolloutAPIResume, "resume", "", false, "Resume the persisted rollout")
+	RolloutAPICmd.Flags().BoolVarP(&rolloutAPIAbort, "abort", "", false,
+		"Abort the persisted rollout and roll back the stages rolled out to")
+	RolloutAPICmd.Flags().BoolVarP(&rolloutAPIStatus, "status", "", false,
+		"Print the status of the persisted rollout")
+	RolloutAPICmd.Flags().StringVarP(&rolloutAPIEnvironment, "environment", "e", "", "Environment of the API")
+	_ = RolloutAPICmd.MarkFlagRequired("name")
+	_ = RolloutAPICmd.MarkFlagRequired("version")
+	_ = RolloutAPICmd.MarkFlagRequired("environment")
+}
@@ -0,0 +1,154 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var rotateAppKeysEnvironment string
+var rotateAppKeysApp string
+var rotateAppKeysAllApps bool
+var rotateAppKeysOwner string
+var rotateAppKeysKeyType string
+var rotateAppKeysGracePeriod int
+var rotateAppKeysAPIKeys bool
+var rotateAppKeysAPIKeyValidityPeriod int
+var rotateAppKeysOutput string
+var rotateAppKeysFile string
+var rotateAppKeysSecretName string
+var rotateAppKeysNamespace string
+var rotateAppKeysCipher string
+var rotateAppKeysAuditLog string
+
+// RotateAppKeys command related usage Info
+const RotateAppKeysCmdLiteral = "app-keys"
+const rotateAppKeysCmdShortDesc = "Rotate the keys of Applications"
+const rotateAppKeysCmdLongDesc = `Regenerate the consumer secrets, and optionally the API keys, of one or all the Applications of the logged in user, ` +
+	`write the new credentials to the chosen output and append an audit record of each rotation to the audit log. ` +
+	`Previous API keys remain valid until they expire as the server does not keep them to be revoked.`
+
+const rotateAppKeysCmdExamples = utils.ProjectName + ` ` + RotateCmdLiteral + ` ` + RotateAppKeysCmdLiteral + ` --app SampleApp -e prod
+` + utils.ProjectName + ` ` + RotateCmdLiteral + ` ` + RotateAppKeysCmdLiteral + ` --all-apps --api-keys -e prod --output file --file ./rotated.properties
+` + utils.ProjectName + ` ` + RotateCmdLiteral + ` ` + RotateAppKeysCmdLiteral + ` --app SampleApp --key-type PRODUCTION --grace-period 3600 -e prod --output k8s --secret-name sample-app-credentials --namespace wso2
+NOTE: The flag --environment (-e) and either --app or --all-apps are mandatory.
+The output file is encrypted with the keystore initialized with '` + utils.ProjectName + ` secret init'.`
+
+// RotateAppKeysCmd represents the rotate app-keys command
+var RotateAppKeysCmd = &cobra.Command{
+	Use:     RotateAppKeysCmdLiteral,
+	Short:   rotateAppKeysCmdShortDesc,
+	Long:    rotateAppKeysCmdLongDesc,
+	Example: rotateAppKeysCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RotateAppKeysCmdLiteral + " called")
+		executeRotateAppKeysCmd()
+	},
+}
+
+func executeRotateAppKeysCmd() {
+	if (rotateAppKeysApp == "") == !rotateAppKeysAllApps {
+		utils.HandleErrorAndExit("Error rotating Application keys",
+			errors.New("either --app or --all-apps should be provided"))
+	}
+	cred, err := GetCredentials(rotateAppKeysEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	accessToken, err := impl.GetDevPortalAccessToken(cred, rotateAppKeysEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting access token", err)
+	}
+	owner := rotateAppKeysOwner
+	if rotateAppKeysAllApps && owner == "" {
+		owner = cred.Username
+	}
+
+	credentials, records := impl.RotateAppKeys(accessToken, rotateAppKeysEnvironment, cred.Username,
+		impl.AppKeyRotationOptions{
+			AppName:              rotateAppKeysApp,
+			Owner:                owner,
+			KeyType:              rotateAppKeysKeyType,
+			GracePeriod:          rotateAppKeysGracePeriod,
+			APIKeys:              rotateAppKeysAPIKeys,
+			APIKeyValidityPeriod: rotateAppKeysAPIKeyValidityPeriod,
+		})
+	auditLog := rotateAppKeysAuditLog
+	if auditLog == "" {
+		auditLog = impl.GetAppKeyRotationAuditLogPath()
+	}
+	if err = impl.WriteAppKeyRotationAuditRecords(auditLog, records); err != nil {
+		utils.HandleErrorAndContinue("Error writing the audit log", err)
+	}
+	if len(credentials) > 0 {
+		secretName := rotateAppKeysSecretName
+		if secretName == "" {
+			secretName = "apim-app-credentials"
+		}
+		if err = impl.WriteRotatedAppCredentials(credentials, rotateAppKeysOutput, rotateAppKeysFile, secretName,
+			rotateAppKeysNamespace, rotateAppKeysCipher); err != nil {
+			// The previous secrets are already revoked, hence print the new ones rather than losing them
+			utils.HandleErrorAndContinue("Error writing the rotated credentials", err)
+			_ = impl.WriteRotatedAppCredentials(credentials, impl.RotationOutputJSON, "", "", "", "")
+			os.Exit(1)
+		}
+	}
+	if impl.PrintAppKeyRotationSummary(records) > 0 {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	RotateCmd.AddCommand(RotateAppKeysCmd)
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysApp, "app", "", "", "Name of the Application to be rotated")
+	RotateAppKeysCmd.Flags().BoolVarP(&rotateAppKeysAllApps, "all-apps", "", false,
+		"Rotate all the Applications of the owner")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysOwner, "owner", "o", "",
+		"Owner of the Applications to be rotated with --all-apps. Only the Applications of the logged in user "+
+			"can be rotated")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysKeyType, "key-type", "", "",
+		"Rotate only the PRODUCTION or SANDBOX keys. Both are rotated by default")
+	RotateAppKeysCmd.Flags().IntVarP(&rotateAppKeysGracePeriod, "grace-period", "", 0,
+		"Seconds to keep the previous consumer secret valid for, where the server supports it")
+	RotateAppKeysCmd.Flags().BoolVarP(&rotateAppKeysAPIKeys, "api-keys", "", false,
+		"Generate new API keys along with the consumer secrets")
+	RotateAppKeysCmd.Flags().IntVarP(&rotateAppKeysAPIKeyValidityPeriod, "api-key-validity-period", "", 0,
+		"Validity period of the new API keys in seconds. The API keys do not expire by default")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysOutput, "output", "", impl.RotationOutputJSON,
+		"Output of the new credentials (json, file or k8s)")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysFile, "file", "", "",
+		"File to write the new credentials to. The json and k8s outputs are printed to the console by default")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysSecretName, "secret-name", "", "",
+		"Name of the Kubernetes Secret of the k8s output")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysNamespace, "namespace", "", "",
+		"Namespace of the Kubernetes Secret of the k8s output")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysCipher, "cipher", "c", "RSA/ECB/OAEPWithSHA1AndMGF1Padding",
+		"Encryption algorithm of the file output")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysAuditLog, "audit-log", "", "",
+		"File to append the audit records to. Defaults to "+utils.AppKeyRotationAuditLogFileName+
+			" in the config directory")
+	RotateAppKeysCmd.Flags().StringVarP(&rotateAppKeysEnvironment, "environment", "e", "",
+		"Environment of the Applications")
+	_ = RotateAppKeysCmd.MarkFlagRequired("environment")
+}
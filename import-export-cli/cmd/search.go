@@ -0,0 +1,84 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var searchCmdEnvironment string
+var searchCmdLimit int
+var searchCmdFormat string
+
+// Search command related usage Info
+const SearchCmdLiteral = "search"
+const searchCmdShortDesc = "Search APIs, API Products and documents in an environment"
+
+const searchCmdLongDesc = `Search the APIs, API Products, documents and API definitions in the environment specified by the flag --environment, -e
+using the unified search of the publisher. The query can be restricted with the prefixes name:, context:, version:, provider:,
+status:, tags:, description:, content: and doc:. A query without a prefix searches the content of the artifacts.
+All the pages of results are fetched unless the flag --limit is given.`
+
+const searchCmdExamples = utils.ProjectName + ` ` + SearchCmdLiteral + ` -e prod "context:/orders"
+` + utils.ProjectName + ` ` + SearchCmdLiteral + ` -e prod "name:Pizza provider:admin"
+` + utils.ProjectName + ` ` + SearchCmdLiteral + ` -e prod "doc:refund" --limit 10
+` + utils.ProjectName + ` ` + SearchCmdLiteral + ` -e prod orders --format "{{ jsonPretty . }}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// SearchCmd represents the search command
+var SearchCmd = &cobra.Command{
+	Use:     SearchCmdLiteral + " [query]",
+	Short:   searchCmdShortDesc,
+	Long:    searchCmdLongDesc,
+	Example: searchCmdExamples,
+	Args:    cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + SearchCmdLiteral + " called")
+		query := strings.Join(args, queryParamSeparator)
+		if err := impl.ValidateSearchQuery(query); err != nil {
+			utils.HandleErrorAndExit("Invalid search query", err)
+		}
+		accessToken := getPublisherAccessToken(searchCmdEnvironment)
+		results, err := impl.SearchFromEnv(accessToken, searchCmdEnvironment, query, searchCmdLimit)
+		if err != nil {
+			utils.HandleErrorAndExit("Error searching "+searchCmdEnvironment, err)
+		}
+		if len(results) == 0 && searchCmdFormat == "" {
+			fmt.Println("No results found for " + query)
+			return
+		}
+		impl.PrintSearchResults(results, query, searchCmdFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(SearchCmd)
+	SearchCmd.Flags().StringVarP(&searchCmdEnvironment, "environment", "e", "", "Environment to be searched")
+	SearchCmd.Flags().IntVarP(&searchCmdLimit, "limit", "l", 0,
+		"Maximum number of results to return (all the results by default)")
+	SearchCmd.Flags().StringVarP(&searchCmdFormat, "format", "", "", "Pretty-print search results "+
+		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	_ = SearchCmd.MarkFlagRequired("environment")
+}
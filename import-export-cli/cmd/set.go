@@ -30,6 +30,7 @@ import (
 var flagHttpRequestTimeout int
 var flagAIThreadCount int
 var flagAIToken string
+var flagRecycleBinMaxSize int
 var flagExportDirectory string
 var flagKubernetesMode string
 var flagTLSRenegotiationMode string
@@ -43,6 +44,7 @@ const flagVCSConfigPathName = "vcs-config-path"
 const flagVCSSourceRepoPathName = "vcs-source-repo-path"
 const flagVCSDeploymentRepoPathName = "vcs-deployment-repo-path"
 const flagAITokenName = "ai-token"
+const flagRecycleBinMaxSizeName = "recycle-bin-max-size"
 
 // Set command related Info
 const SetCmdLiteral = "set"
@@ -57,7 +59,8 @@ const setCmdLongDesc = `Set configuration parameters. You can use one of the fol
 * --vcs-deployment-repo-path <path-to-deployment-repo-for-vcs>
 * --vcs-source-repo-path <path-to-source-repo-for-vcs>
 * --ai-thread-count <number-of-threads>
-* --ai-token <on-prem-key-of-ai-features>`
+* --ai-token <on-prem-key-of-ai-features>
+* --recycle-bin-max-size <size-in-mb>`
 
 const setCmdExamples = utils.ProjectName + ` ` + SetCmdLiteral + ` --http-request-timeout 3600 --export-directory /home/user/exported-apis
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --http-request-timeout 5000 --export-directory C:\Documents\exported
@@ -70,7 +73,8 @@ const setCmdExamples = utils.ProjectName + ` ` + SetCmdLiteral + ` --http-reques
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` ` + SetApiLoggingCmdLiteral + ` --api-id bf36ca3a-0332-49ba-abce-e9992228ae06 --log-level full -e dev --tenant-domain carbon.super
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` ` + SetCorrelationLoggingCmdLiteral + ` --component-name http --enable true -e dev
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --ai-thread-count 5
-` + utils.ProjectName + ` ` + SetCmdLiteral + ` --ai-token ad232sda-asa2a-assdsd-sds43`
+` + utils.ProjectName + ` ` + SetCmdLiteral + ` --ai-token ad232sda-asa2a-assdsd-sds43
+` + utils.ProjectName + ` ` + SetCmdLiteral + ` --recycle-bin-max-size 500`
 
 // SetCmd represents the 'set' command
 var SetCmd = &cobra.Command{
@@ -172,6 +176,14 @@ func executeSetCmd(mainConfigFilePath string, cmd *cobra.Command) {
 		configVars.Config.AIToken = flagAIToken
 		fmt.Println("AI token is set to  : " + flagAIToken)
 	}
+	if cmd.Flags().Changed(flagRecycleBinMaxSizeName) {
+		if flagRecycleBinMaxSize > 0 {
+			configVars.Config.RecycleBinMaxSize = flagRecycleBinMaxSize
+			fmt.Println("Recycle bin max size is set to : ", flagRecycleBinMaxSize)
+		} else {
+			fmt.Println("Invalid input for flag --" + flagRecycleBinMaxSizeName)
+		}
+	}
 
 	utils.WriteConfigFile(configVars, mainConfigFilePath)
 }
@@ -221,4 +233,6 @@ func init() {
 		"No of threads to be used by Marketplace Assistant for parallel processing")
 	SetCmd.Flags().StringVar(&flagAIToken, flagAITokenName, "",
 		"Token (On prem key) of AI features")
+	SetCmd.Flags().IntVar(&flagRecycleBinMaxSize, flagRecycleBinMaxSizeName, utils.DefaultRecycleBinMaxSize,
+		"Maximum size in MB of the recycle bin where artifacts are backed up before destructive commands")
 }
@@ -0,0 +1,48 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Sync command related usage Info
+const SyncCmdLiteral = "sync"
+const syncCmdShortDesc = "Sync the resources of an artifact with a local directory"
+
+const syncCmdLongDesc = `Sync the resources of an API or API Product in an environment with the files of a local directory`
+
+const syncCmdExamples = utils.ProjectName + ` ` + SyncCmdLiteral + ` ` + SyncDocsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --dir docs/`
+
+// SyncCmd represents the sync command
+var SyncCmd = &cobra.Command{
+	Use:     SyncCmdLiteral,
+	Short:   syncCmdShortDesc,
+	Long:    syncCmdLongDesc,
+	Example: syncCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + SyncCmdLiteral + " called")
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(SyncCmd)
+}
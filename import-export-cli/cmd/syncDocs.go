@@ -0,0 +1,133 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var syncDocsDir string
+var syncDocsPrune bool
+var syncDocsDryRun bool
+var syncDocsConfirm bool
+
+// SyncDocs command related usage Info
+const SyncDocsCmdLiteral = "docs"
+const syncDocsCmdShortDesc = "Sync the documents of an API or API Product with a directory"
+
+const syncDocsCmdLongDesc = `Sync the documents of an API or API Product in the environment specified by the flag --environment, -e
+with the Markdown (.md), HTML (.html) and text (.txt) files of a directory. The front-matter of each file sets the name,
+type, visibility and summary of its document:
+
+---
+name: Getting Started
+type: HOWTO
+visibility: API_LEVEL
+summary: How to invoke the API
+---
+
+The name defaults to the name of the file and the type defaults to HOWTO. The documents which do not exist are added and
+the documents which differ from the files are updated. With --prune, the documents which are not in the directory are
+deleted. The plan is printed before any change is made. The documents are published as soon as they are synced, without
+updating the API or API Product or creating a new revision.`
+
+const syncDocsCmdExamples = utils.ProjectName + ` ` + SyncCmdLiteral + ` ` + SyncDocsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --dir docs/ --dry-run
+` + utils.ProjectName + ` ` + SyncCmdLiteral + ` ` + SyncDocsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --dir docs/
+` + utils.ProjectName + ` ` + SyncCmdLiteral + ` ` + SyncDocsCmdLiteral + ` -n LeasingProduct -v 1.0.0 --artifact-type api-product -e dev --dir docs/ --prune -y
+NOTE: The flags (--name (-n), --version (-v), --environment (-e) and --dir) are mandatory.`
+
+// SyncDocsCmd represents the sync docs command
+var SyncDocsCmd = &cobra.Command{
+	Use:     SyncDocsCmdLiteral,
+	Short:   syncDocsCmdShortDesc,
+	Long:    syncDocsCmdLongDesc,
+	Example: syncDocsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + SyncDocsCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(docEnvironment)
+		executeSyncDocsCmd(accessToken)
+	},
+}
+
+func executeSyncDocsCmd(accessToken string) {
+	artifact := getDocArtifact()
+	changes, err := impl.PlanDocSync(accessToken, docEnvironment, artifact, syncDocsDir, syncDocsPrune)
+	if err != nil {
+		utils.HandleErrorAndExit("Error planning the document sync of "+artifact.String(), err)
+	}
+	impl.PrintDocSyncPlan(changes)
+
+	pending := 0
+	for _, change := range changes {
+		if change.Action != impl.ApplyActionUnchanged {
+			pending++
+		}
+	}
+	if pending == 0 {
+		fmt.Println("Documents of " + artifact.String() + " are up to date")
+		return
+	}
+	if syncDocsDryRun {
+		fmt.Println("Dry run: " + strconv.Itoa(pending) + " change(s) were not applied")
+		return
+	}
+	if !syncDocsConfirm {
+		confirm, err := utils.ReadInputString("Apply "+strconv.Itoa(pending)+" change(s) to the documents of "+
+			artifact.String(), utils.Default{Value: "N", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+		confirm = strings.ToUpper(confirm)
+		if confirm != "Y" && confirm != "YES" {
+			fmt.Println("Document sync cancelled")
+			return
+		}
+	}
+
+	failed, err := impl.ApplyDocSync(accessToken, docEnvironment, artifact, changes)
+	if err != nil {
+		utils.HandleErrorAndExit("Error syncing the documents of "+artifact.String(), err)
+	}
+	impl.PrintDocSyncResults(changes)
+	if failed > 0 {
+		fmt.Println(strconv.Itoa(failed) + " of " + strconv.Itoa(pending) + " change(s) failed")
+		os.Exit(1)
+	}
+	fmt.Println("Documents of " + artifact.String() + " are in sync")
+}
+
+// init using Cobra
+func init() {
+	SyncCmd.AddCommand(SyncDocsCmd)
+	addDocArtifactFlags(SyncDocsCmd)
+	SyncDocsCmd.Flags().StringVarP(&syncDocsDir, "dir", "d", "", "Directory of the document files")
+	SyncDocsCmd.Flags().BoolVarP(&syncDocsPrune, "prune", "", false,
+		"Delete the documents which are not in the directory")
+	SyncDocsCmd.Flags().BoolVarP(&syncDocsDryRun, "dry-run", "", false, "Print the plan without applying it")
+	SyncDocsCmd.Flags().BoolVarP(&syncDocsConfirm, "yes", "y", false,
+		"Apply the changes without asking for confirmation")
+	_ = SyncDocsCmd.MarkFlagRequired("dir")
+}
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Test command related usage Info
+const TestCmdLiteral = "test"
+const testCmdShortDesc = "Run contract tests against a gateway"
+
+const testCmdLongDesc = `Run contract tests which invoke the operations of an API through a gateway and validate the responses against the definition of the API`
+
+const testCmdExamples = utils.ProjectName + ` ` + TestCmdLiteral + ` ` + TestAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e staging`
+
+// TestCmd represents the test command
+var TestCmd = &cobra.Command{
+	Use:     TestCmdLiteral,
+	Short:   testCmdShortDesc,
+	Long:    testCmdLongDesc,
+	Example: testCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + TestCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(TestCmd)
+}
@@ -0,0 +1,160 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var testAPIName string
+var testAPIVersion string
+var testAPIProvider string
+var testAPIEnv string
+var testAPITokenEndpoint string
+var testAPIAccessToken string
+var testAPIGatewayURL string
+var testAPIGatewayEnv string
+var testAPIDefinition string
+var testAPIJUnitReport string
+
+// TestAPI command related usage Info
+const TestAPICmdLiteral = "api"
+const testAPICmdShortDesc = "Run contract tests for an API"
+
+const testAPICmdLongDesc = `Invoke each operation of an API through a gateway with requests generated from the definition of the API, ` +
+	`validate the status codes and the bodies of the responses against the definition and write a JUnit report. ` +
+	`The definition and the gateway URL are retrieved from the environment unless --definition and --gateway-url are given.`
+
+const testAPICmdExamples = utils.ProjectName + ` ` + TestCmdLiteral + ` ` + TestAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e staging
+` + utils.ProjectName + ` ` + TestCmdLiteral + ` ` + TestAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e staging --gateway-env Default --junit reports/pizzashack.xml
+` + utils.ProjectName + ` ` + TestCmdLiteral + ` ` + TestAPICmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e staging --gateway-url http://localhost:8080
+` + utils.ProjectName + ` ` + TestCmdLiteral + ` ` + TestAPICmdLiteral + ` --definition ./PizzaShackAPI --gateway-url http://localhost:8080 --access-token abc123
+NOTE: Either the flag --environment (-e) or both the flags --definition and --gateway-url are mandatory.
+The flags --name (-n) and --version (-v) are mandatory with --environment (-e).`
+
+// TestAPICmd represents the test api command
+var TestAPICmd = &cobra.Command{
+	Use:     TestAPICmdLiteral,
+	Short:   testAPICmdShortDesc,
+	Long:    testAPICmdLongDesc,
+	Example: testAPICmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + TestAPICmdLiteral + " called")
+		executeTestAPICmd()
+	},
+}
+
+func executeTestAPICmd() {
+	var target *impl.ContractTestTarget
+	var err error
+	if testAPIEnv == "" {
+		if testAPIDefinition == "" || testAPIGatewayURL == "" {
+			utils.HandleErrorAndExit("Error testing API", errors.New("either --environment or both --definition "+
+				"and --gateway-url should be provided"))
+		}
+		target, err = impl.LoadLocalContractTestTarget(testAPIDefinition, testAPIGatewayURL)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the definition of the API", err)
+		}
+	} else {
+		if testAPIName == "" || testAPIVersion == "" {
+			utils.HandleErrorAndExit("Error testing API", errors.New("--name and --version should be provided "+
+				"with --environment"))
+		}
+		cred, err := GetCredentials(testAPIEnv)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		if testAPIDefinition != "" {
+			if testAPIGatewayURL == "" {
+				utils.HandleErrorAndExit("Error testing API", errors.New("--gateway-url should be provided "+
+					"with --definition"))
+			}
+			target, err = impl.LoadLocalContractTestTarget(testAPIDefinition, testAPIGatewayURL)
+			if err != nil {
+				utils.HandleErrorAndExit("Error reading the definition of the API", err)
+			}
+		} else {
+			accessToken, err := credentials.GetOAuthAccessToken(cred, testAPIEnv)
+			if err != nil {
+				utils.HandleErrorAndExit("Error getting access token", err)
+			}
+			target, err = impl.GetContractTestTarget(accessToken, testAPIEnv, testAPIName, testAPIVersion,
+				testAPIProvider, testAPIGatewayURL, testAPIGatewayEnv)
+			if err != nil {
+				utils.HandleErrorAndExit("Error retrieving the API "+testAPIName, err)
+			}
+		}
+		if testAPIAccessToken == "" {
+			// Obtain a token to invoke the API the same way as the get keys command
+			cred.ClientId, cred.ClientSecret, err = impl.CallDCREndpoint(cred, testAPIEnv)
+			if err != nil {
+				utils.HandleErrorAndExit("Internal error occurred", err)
+			}
+			testAPIAccessToken = impl.GenerateAccessTokenForAPI(cred, testAPIEnv, testAPIName, testAPIVersion,
+				testAPIProvider, testAPITokenEndpoint)
+			if testAPIAccessToken == "" {
+				utils.HandleErrorAndExit("Error testing API", errors.New("could not generate an access token to "+
+					"invoke the API "+testAPIName))
+			}
+		}
+	}
+	target.AccessToken = testAPIAccessToken
+
+	startTime := time.Now()
+	testCases := impl.RunContractTests(target)
+	failed := impl.PrintContractTestResults(testCases)
+	reportPath := testAPIJUnitReport
+	if reportPath == "" {
+		reportPath = "TEST-" + target.Name + "-" + target.Version + ".xml"
+	}
+	if err = impl.WriteContractTestJUnitReport(reportPath, target, testCases, startTime); err != nil {
+		utils.HandleErrorAndExit("Error writing the JUnit report", err)
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	TestCmd.AddCommand(TestAPICmd)
+	TestAPICmd.Flags().StringVarP(&testAPIName, "name", "n", "", "Name of the API to be tested")
+	TestAPICmd.Flags().StringVarP(&testAPIVersion, "version", "v", "", "Version of the API to be tested")
+	TestAPICmd.Flags().StringVarP(&testAPIProvider, "provider", "r", "", "Provider of the API")
+	TestAPICmd.Flags().StringVarP(&testAPIEnv, "environment", "e", "", "Environment of the API")
+	TestAPICmd.Flags().StringVarP(&testAPITokenEndpoint, "token", "t", "", "Token endpoint URL of Environment")
+	TestAPICmd.Flags().StringVarP(&testAPIAccessToken, "access-token", "", "",
+		"Access token to invoke the API instead of generating one")
+	TestAPICmd.Flags().StringVarP(&testAPIGatewayURL, "gateway-url", "", "",
+		"URL of the gateway or a local stand-in to invoke the API through")
+	TestAPICmd.Flags().StringVarP(&testAPIGatewayEnv, "gateway-env", "", "",
+		"Gateway environment to pick the URL of the API from")
+	TestAPICmd.Flags().StringVarP(&testAPIDefinition, "definition", "", "",
+		"API project or OpenAPI definition file to read the operations from instead of the environment")
+	TestAPICmd.Flags().StringVarP(&testAPIJUnitReport, "junit", "", "",
+		"Path of the JUnit report. Defaults to TEST-<name>-<version>.xml")
+}
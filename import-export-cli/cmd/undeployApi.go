@@ -76,6 +76,9 @@ var UndeployAPICmd = &cobra.Command{
 func executeUndeployAPICmd(credential credentials.Credential, deployments []utils.Deployment) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, undeployAPIEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, undeployAPIEnvironment, impl.RecycleBinCommandUndeploy, impl.RecycleBinArtifact{
+			Type: utils.ProjectTypeApi, Name: undeployAPIName, Version: undeployAPIVersion, Owner: undeployProvider,
+			Revision: undeployRevisionNum, Gateways: undeployAPICmdAPIGatewayEnvs})
 		resp, err := impl.UndeployRevisionFromGateways(accessToken,
 			undeployAPIEnvironment, undeployAPIName, undeployAPIVersion, undeployProvider, undeployRevisionNum,
 			deployments, undeployAllGatewayEnvs)
@@ -124,6 +127,7 @@ func init() {
 		"Revision number of the API to undeploy")
 	UndeployAPICmd.Flags().StringVarP(&undeployAPIEnvironment, "environment", "e",
 		"", "Environment of which the API should be undeployed")
+	addSkipBackupFlag(UndeployAPICmd)
 	_ = UndeployAPICmd.MarkFlagRequired("name")
 	_ = UndeployAPICmd.MarkFlagRequired("version")
 	_ = UndeployAPICmd.MarkFlagRequired("rev")
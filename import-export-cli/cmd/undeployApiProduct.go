@@ -78,6 +78,10 @@ var UndeployAPIProductCmd = &cobra.Command{
 func executeUndeployAPIProductCmd(credential credentials.Credential, deployments []utils.Deployment) {
 	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, undeployAPIProductEnvironment)
 	if preCommandErr == nil {
+		backupToRecycleBin(accessToken, undeployAPIProductEnvironment, impl.RecycleBinCommandUndeploy,
+			impl.RecycleBinArtifact{Type: utils.ProjectTypeApiProduct, Name: undeployAPIProductName,
+				Version: undeployAPIProductVersion, Owner: undeployAPIProductProvider,
+				Revision: undeployAPIProductRevisionNum, Gateways: undeployAPIProductGatewayEnvs})
 		resp, err := impl.UndeployAPIProductRevisionFromGateways(accessToken,
 			undeployAPIProductEnvironment, undeployAPIProductName, undeployAPIProductVersion, undeployAPIProductProvider,
 			undeployAPIProductRevisionNum, deployments, undeployAPIProductAllGatewayEnvs)
@@ -112,6 +116,7 @@ func init() {
 		"Revision number of the API Product to undeploy")
 	UndeployAPIProductCmd.Flags().StringVarP(&undeployAPIProductEnvironment, "environment", "e",
 		"", "Environment of which the API Product should be undeployed")
+	addSkipBackupFlag(UndeployAPIProductCmd)
 	_ = UndeployAPIProductCmd.MarkFlagRequired("name")
 	_ = UndeployAPIProductCmd.MarkFlagRequired("version")
 	_ = UndeployAPIProductCmd.MarkFlagRequired("rev")
@@ -0,0 +1,64 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Undo command related usage Info
+const UndoCmdLiteral = "undo"
+const undoCmdShortDesc = "Restore an entry of the recycle bin"
+
+const undoCmdLongDesc = `Revert the delete or undeploy command of an entry of the recycle bin. A deleted artifact is imported
+to the environment it was deleted from and a new revision of it is deployed to the gateways it was deployed to.
+An undeployed revision is deployed back to the gateways it was undeployed from. The entry is removed from the
+recycle bin once it is restored. Use "` + utils.ProjectName + ` ` + RecycleBinCmdLiteral + ` ` + RecycleBinListCmdLiteral + `" to find the id of an entry.`
+
+const undoCmdExamples = utils.ProjectName + ` ` + UndoCmdLiteral + ` 3`
+
+// UndoCmd represents the undo command
+var UndoCmd = &cobra.Command{
+	Use:     UndoCmdLiteral + " [id]",
+	Short:   undoCmdShortDesc,
+	Long:    undoCmdLongDesc,
+	Example: undoCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + UndoCmdLiteral + " called")
+		entry, err := impl.GetRecycleBinEntry(args[0])
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the recycle bin", err)
+		}
+		accessToken := getPublisherAccessToken(entry.Environment)
+		if err = impl.RestoreFromRecycleBin(accessToken, entry); err != nil {
+			utils.HandleErrorAndExit("Error restoring "+entry.Type+" "+entry.Name+" to "+entry.Environment, err)
+		}
+		fmt.Println("Restored " + entry.Type + " " + entry.Name + " to " + entry.Environment)
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(UndoCmd)
+}
@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateAppEnvironment string
+var updateAppName string
+var updateAppNewName string
+var updateAppThrottlingPolicy string
+var updateAppDescription string
+var updateAppTokenType string
+
+// UpdateApp command related usage Info
+const UpdateAppCmdLiteral = "app"
+const updateAppCmdShortDesc = "Update an Application"
+const updateAppCmdLongDesc = "Change the name, throttling policy, description or token type of an Application of the user in the environment specified by the flag --environment, -e"
+
+const updateAppCmdExamples = utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateAppCmdLiteral + ` -n SampleApp --throttling-policy Unlimited -e dev
+` + utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateAppCmdLiteral + ` -n SampleApp --new-name MobileApp --description "Mobile app" -e prod
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.
+At least one of the flags --new-name, --throttling-policy, --description and --token-type should be provided.`
+
+// UpdateAppCmd represents the update app command
+var UpdateAppCmd = &cobra.Command{
+	Use:     UpdateAppCmdLiteral,
+	Short:   updateAppCmdShortDesc,
+	Long:    updateAppCmdLongDesc,
+	Example: updateAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + UpdateAppCmdLiteral + " called")
+		update := impl.ApplicationUpdate{
+			Name:             updateAppNewName,
+			ThrottlingPolicy: updateAppThrottlingPolicy,
+			Description:      updateAppDescription,
+			TokenType:        updateAppTokenType,
+		}
+		if update == (impl.ApplicationUpdate{}) {
+			utils.HandleErrorAndExit("Error updating the Application "+updateAppName,
+				errors.New("nothing to update"))
+		}
+		accessToken := getDevPortalAccessToken(updateAppEnvironment)
+		appDetails, err := impl.UpdateApplication(accessToken, updateAppEnvironment, updateAppName, update)
+		if err != nil {
+			utils.HandleErrorAndExit("Error updating the Application "+updateAppName, err)
+		}
+		fmt.Println("Application " + appDetails.Name + " updated successfully. Throttling policy: " +
+			appDetails.ThrottlingPolicy + ", Token type: " + appDetails.TokenType)
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAppCmd)
+	UpdateAppCmd.Flags().StringVarP(&updateAppName, "name", "n", "", "Name of the Application to be updated")
+	UpdateAppCmd.Flags().StringVarP(&updateAppEnvironment, "environment", "e", "", "Environment of the Application")
+	UpdateAppCmd.Flags().StringVarP(&updateAppNewName, "new-name", "", "", "New name of the Application")
+	UpdateAppCmd.Flags().StringVarP(&updateAppThrottlingPolicy, "throttling-policy", "", "",
+		"Application throttling policy")
+	UpdateAppCmd.Flags().StringVarP(&updateAppDescription, "description", "", "", "Description of the Application")
+	UpdateAppCmd.Flags().StringVarP(&updateAppTokenType, "token-type", "", "",
+		"Token type of the Application (JWT or OAUTH)")
+	_ = UpdateAppCmd.MarkFlagRequired("name")
+	_ = UpdateAppCmd.MarkFlagRequired("environment")
+}
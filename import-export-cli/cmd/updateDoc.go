@@ -0,0 +1,69 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// UpdateDoc command related usage Info
+const UpdateDocCmdLiteral = "doc"
+const updateDocCmdShortDesc = "Update a document of an API or API Product"
+
+const updateDocCmdLongDesc = `Update a document of an API or API Product in the environment specified by the flag --environment, -e.
+Only the fields given by the flags or by the front-matter of the file given by --file are updated, and the content is
+replaced only if --file is given. The API or API Product is not updated and no new revision is created.`
+
+const updateDocCmdExamples = utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateDocCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name "Getting Started" --file guide.md
+` + utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateDocCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev --doc-name "Getting Started" --visibility PRIVATE --summary "How to invoke the API"
+` + utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateDocCmdLiteral + ` -n LeasingProduct -v 1.0.0 --artifact-type api-product -e dev --doc-name Forum --source-url https://community.example.com
+NOTE: The flags (--name (-n), --version (-v), --environment (-e) and --doc-name) are mandatory.`
+
+// UpdateDocCmd represents the update doc command
+var UpdateDocCmd = &cobra.Command{
+	Use:     UpdateDocCmdLiteral,
+	Short:   updateDocCmdShortDesc,
+	Long:    updateDocCmdLongDesc,
+	Example: updateDocCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + UpdateDocCmdLiteral + " called")
+		artifact := getDocArtifact()
+		document, err := getLocalDocument()
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading the document "+docFile, err)
+		}
+		accessToken := getPublisherAccessToken(docEnvironment)
+		if err = impl.UpdateDocument(accessToken, docEnvironment, artifact, document); err != nil {
+			utils.HandleErrorAndExit("Error updating the document "+docName+" of "+artifact.String(), err)
+		}
+		fmt.Println("Document " + docName + " of " + artifact.String() + " updated successfully")
+	},
+}
+
+// init using Cobra
+func init() {
+	UpdateCmd.AddCommand(UpdateDocCmd)
+	addDocArtifactFlags(UpdateDocCmd)
+	addDocFlags(UpdateDocCmd)
+	_ = UpdateDocCmd.MarkFlagRequired("doc-name")
+}
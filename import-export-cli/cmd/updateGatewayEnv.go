@@ -0,0 +1,91 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateGatewayEnvName string
+var updateGatewayEnvDisplayName string
+var updateGatewayEnvDescription string
+var updateGatewayEnvVhosts []string
+var updateGatewayEnvEnvironment string
+
+// UpdateGatewayEnv command related usage Info
+const UpdateGatewayEnvCmdLiteral = "gateway-env"
+const updateGatewayEnvCmdShortDesc = "Update a gateway environment in an environment"
+const updateGatewayEnvCmdLongDesc = `Update the display name, description or vhosts of a gateway environment in the environment specified by the flag --environment, -e.
+Only the given values are updated. When the flag --vhost is given, the vhosts of the gateway environment are replaced by the given ones.
+Gateway environments defined in the deployment.toml of the server are read only and cannot be updated.`
+
+const updateGatewayEnvCmdExamples = utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateGatewayEnvCmdLiteral + ` -n External --display-name "External Gateway" -e dev
+` + utils.ProjectName + ` ` + UpdateCmdLiteral + ` ` + UpdateGatewayEnvCmdLiteral + ` -n External --vhost api.example.com --vhost "host=api2.example.com,https-port=8243" -e dev
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// UpdateGatewayEnvCmd represents the update gateway-env command
+var UpdateGatewayEnvCmd = &cobra.Command{
+	Use:     UpdateGatewayEnvCmdLiteral,
+	Short:   updateGatewayEnvCmdShortDesc,
+	Long:    updateGatewayEnvCmdLongDesc,
+	Example: updateGatewayEnvCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + UpdateGatewayEnvCmdLiteral + " called")
+		accessToken := getPublisherAccessToken(updateGatewayEnvEnvironment)
+		gatewayEnv, err := impl.GetGatewayEnvironment(accessToken, updateGatewayEnvEnvironment, updateGatewayEnvName)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while getting gateway environment "+updateGatewayEnvName, err)
+		}
+		if cmd.Flags().Changed("display-name") {
+			gatewayEnv.DisplayName = updateGatewayEnvDisplayName
+		}
+		if cmd.Flags().Changed("description") {
+			gatewayEnv.Description = updateGatewayEnvDescription
+		}
+		if cmd.Flags().Changed("vhost") {
+			if gatewayEnv.Vhosts, err = impl.ParseVhostDefinitions(updateGatewayEnvVhosts); err != nil {
+				utils.HandleErrorAndExit("Invalid vhosts", err)
+			}
+		}
+		err = impl.UpdateGatewayEnvironment(accessToken, updateGatewayEnvEnvironment, *gatewayEnv)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while updating gateway environment "+updateGatewayEnvName, err)
+		}
+		fmt.Println("Gateway environment " + updateGatewayEnvName + " updated successfully")
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateGatewayEnvCmd)
+	UpdateGatewayEnvCmd.Flags().StringVarP(&updateGatewayEnvName, "name", "n", "", "Name of the gateway environment")
+	UpdateGatewayEnvCmd.Flags().StringVarP(&updateGatewayEnvDisplayName, "display-name", "", "",
+		"Display name of the gateway environment")
+	UpdateGatewayEnvCmd.Flags().StringVarP(&updateGatewayEnvDescription, "description", "d", "",
+		"Description of the gateway environment")
+	UpdateGatewayEnvCmd.Flags().StringArrayVarP(&updateGatewayEnvVhosts, "vhost", "", []string{},
+		"Vhost of the gateway environment")
+	UpdateGatewayEnvCmd.Flags().StringVarP(&updateGatewayEnvEnvironment, "environment", "e", "",
+		"Environment of the gateway environment")
+	_ = UpdateGatewayEnvCmd.MarkFlagRequired("name")
+	_ = UpdateGatewayEnvCmd.MarkFlagRequired("environment")
+}
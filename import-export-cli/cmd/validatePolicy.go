@@ -0,0 +1,68 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var validatePolicyFile string
+
+// ValidatePolicy command related usage Info
+const ValidatePolicyCmdLiteral = "policy"
+const validatePolicyCmdShortDesc = "Validate an operation policy"
+const validatePolicyCmdLongDesc = `Validate the specification of an operation policy (parameter types, applicable flows and supported gateways)
+and check that the template of each supported gateway exists and refers only to the declared parameters`
+
+const validatePolicyCmdExamples = utils.ProjectName + ` ` + ValidateCmdLiteral + ` ` + ValidatePolicyCmdLiteral + ` -f ~/AddHeader
+NOTE: The flag (--file (-f)) is mandatory.`
+
+// ValidatePolicyCmd represents the validate policy command
+var ValidatePolicyCmd = &cobra.Command{
+	Use:     ValidatePolicyCmdLiteral,
+	Short:   validatePolicyCmdShortDesc,
+	Long:    validatePolicyCmdLongDesc,
+	Example: validatePolicyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ValidatePolicyCmdLiteral + " called")
+		issues, err := impl.ValidateOperationPolicy(validatePolicyFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error validating policy", err)
+		}
+		if len(issues) > 0 {
+			fmt.Printf("Policy %s has %d issue(s):\n", validatePolicyFile, len(issues))
+			for _, issue := range issues {
+				fmt.Println("  - " + issue)
+			}
+			os.Exit(1)
+		}
+		fmt.Println("Policy " + validatePolicyFile + " is valid")
+	},
+}
+
+func init() {
+	ValidateCmd.AddCommand(ValidatePolicyCmd)
+	ValidatePolicyCmd.Flags().StringVarP(&validatePolicyFile, "file", "f", "", "Directory of the policy")
+	_ = ValidatePolicyCmd.MarkFlagRequired("file")
+}
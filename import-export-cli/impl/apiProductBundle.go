@@ -0,0 +1,407 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	apiProductDepsProductHeader        = "API PRODUCT"
+	apiProductDepsProductVersionHeader = "PRODUCT VERSION"
+	apiProductDepsAPIHeader            = "API"
+	apiProductDepsAPIVersionHeader     = "API VERSION"
+	apiProductDepsProviderHeader       = "PROVIDER"
+	apiProductDepsOperationsHeader     = "OPERATIONS"
+
+	defaultAPIProductDepsTableFormat = "table {{.Product}}\t{{.ProductVersion}}\t{{.API}}\t{{.APIVersion}}\t" +
+		"{{.Provider}}\t{{.Operations}}"
+)
+
+// APIProductDependency is an API used by an API Product along with the operations of the API used by the product
+type APIProductDependency struct {
+	Product        string
+	ProductVersion string
+	Name           string
+	Version        string
+	Provider       string
+	Operations     []string
+}
+
+// APIProductBundleEntry is an API embedded in an API Product bundle and where it was resolved from
+type APIProductBundleEntry struct {
+	Dependency APIProductDependency
+	Source     string
+	path       string
+}
+
+// BundleAPIProduct prepares a copy of an API Product project with its dependent APIs embedded in the APIs directory.
+// The APIs are resolved from the API projects in apisDir, or else exported from the environment. The operations
+// used by the product are validated against the definitions of the resolved APIs.
+// @param accessToken : Access token of the environment, used only when an environment is given
+// @param environment : Environment to export the APIs not found in apisDir from, or empty
+// @param productDir : Path of the API Product project
+// @param apisDir : Directory with the API projects, or empty
+// @return path of the prepared API Product project, embedded APIs, cleanup function, error
+func BundleAPIProduct(accessToken, environment, productDir, apisDir string) (string, []APIProductBundleEntry,
+	func(), error) {
+	publisherEndpoint := ""
+	if environment != "" {
+		publisherEndpoint = utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath)
+	}
+	return bundleAPIProduct(accessToken, publisherEndpoint, environment, productDir, apisDir)
+}
+
+// exportBundledAPI exports a dependent API of an API Product and returns the path of the extracted project
+// @param accessToken : Access token of the environment
+// @param publisherEndpoint : Publisher REST API endpoint of the environment
+// @param environment : Environment the API is exported from
+// @param dependency : API to export
+// @return path of the extracted API project, error
+func exportBundledAPI(accessToken, publisherEndpoint, environment string, dependency APIProductDependency) (string,
+	error) {
+	resp, err := exportAPI(dependency.Name, dependency.Version, "", dependency.Provider, utils.DefaultExportFormat,
+		publisherEndpoint, accessToken, true, false)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", getPublisherResponseError(resp, "exporting the API "+dependency.Name+" "+dependency.Version+
+			" from "+environment)
+	}
+	zipFile, err := utils.WriteResponseToTempZip(dependency.Name+"_"+dependency.Version+".zip", resp)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(filepath.Dir(zipFile))
+	return utils.GetTempCloneFromDirOrZip(zipFile)
+}
+
+func bundleAPIProduct(accessToken, publisherEndpoint, environment, productDir, apisDir string) (string,
+	[]APIProductBundleEntry, func(), error) {
+	dependencies, err := GetAPIProductDependenciesOfProject(productDir)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	var tempDirs []string
+	cleanup := func() {
+		for _, dir := range tempDirs {
+			_ = os.RemoveAll(dir)
+		}
+	}
+	var entries []APIProductBundleEntry
+	var problems []string
+	for _, dependency := range dependencies {
+		api := dependency.Name + " " + dependency.Version
+		entry := APIProductBundleEntry{Dependency: dependency}
+		var paths []string
+		if apisDir != "" {
+			if paths, err = findAPIProjects(apisDir, dependency.Name, dependency.Version); err != nil {
+				cleanup()
+				return "", nil, nil, err
+			}
+		}
+		switch {
+		case len(paths) > 1:
+			problems = append(problems, "API "+api+" is found in more than one project: "+strings.Join(paths, ", "))
+			continue
+		case len(paths) == 1:
+			entry.path = paths[0]
+			entry.Source = paths[0]
+		case publisherEndpoint != "":
+			path, err := exportBundledAPI(accessToken, publisherEndpoint, environment, dependency)
+			if err != nil {
+				problems = append(problems, "API "+api+" could not be exported: "+err.Error())
+				continue
+			}
+			tempDirs = append(tempDirs, filepath.Dir(path))
+			entry.path = path
+			entry.Source = environment
+		default:
+			problems = append(problems, "API "+api+" is not found in "+apisDir)
+			continue
+		}
+		missing, err := findMissingOperations(entry.path, dependency.Operations)
+		if err != nil {
+			problems = append(problems, "API "+api+" could not be read: "+err.Error())
+			continue
+		}
+		for _, operation := range missing {
+			problems = append(problems, "API "+api+" has no operation "+operation)
+		}
+		entries = append(entries, entry)
+	}
+	if len(problems) > 0 {
+		cleanup()
+		return "", nil, nil, errors.New("the API Product " + filepath.Base(productDir) +
+			" could not be bundled:\n  " + strings.Join(problems, "\n  "))
+	}
+
+	bundleDir, err := utils.GetTempCloneFromDirOrZip(productDir)
+	if err != nil {
+		cleanup()
+		return "", nil, nil, err
+	}
+	tempDirs = append(tempDirs, filepath.Dir(bundleDir))
+	apisBundleDir := filepath.Join(bundleDir, "APIs")
+	if err = os.RemoveAll(apisBundleDir); err != nil {
+		cleanup()
+		return "", nil, nil, err
+	}
+	for _, entry := range entries {
+		dest := filepath.Join(apisBundleDir, entry.Dependency.Name+"-"+entry.Dependency.Version)
+		if err = utils.CopyDir(entry.path, dest); err != nil {
+			cleanup()
+			return "", nil, nil, err
+		}
+	}
+	return bundleDir, entries, cleanup, nil
+}
+
+// findMissingOperations returns the operations which are not found in the definition of an API project. The
+// operations are looked up in the api.yaml of the project and, if it has no operations, in its OpenAPI definition.
+// @param dir : Path of the API project
+// @param operations : Operations to look up in the form of "VERB target"
+// @return operations which are not found, error
+func findMissingOperations(dir string, operations []string) ([]string, error) {
+	definition, _, err := resolveYamlOrJSON(filepath.Join(dir, "api"))
+	if err != nil {
+		return nil, err
+	}
+	file, err := readProjectFile(definition)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	available := make(map[string]bool)
+	apiOperations, _ := data["operations"].([]interface{})
+	for _, operation := range apiOperations {
+		if operationData, ok := operation.(map[string]interface{}); ok {
+			available[apiOperationKey(operationData["verb"], operationData["target"])] = true
+		}
+	}
+	if len(available) == 0 {
+		swagger, _, _ := resolveYamlOrJSON(filepath.Join(dir, "Definitions", "swagger"))
+		if swagger != "" {
+			if err = readOpenAPIOperations(swagger, available); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var missing []string
+	for _, operation := range operations {
+		if !available[operation] {
+			missing = append(missing, operation)
+		}
+	}
+	return missing, nil
+}
+
+func readOpenAPIOperations(path string, operations map[string]bool) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	definition := struct {
+		Paths map[string]map[string]interface{} `yaml:"paths"`
+	}{}
+	if err = yaml.Unmarshal(content, &definition); err != nil {
+		return err
+	}
+	for target, methods := range definition.Paths {
+		for verb := range methods {
+			operations[apiOperationKey(verb, target)] = true
+		}
+	}
+	return nil
+}
+
+func apiOperationKey(verb, target interface{}) string {
+	return strings.ToUpper(fmt.Sprint(verb)) + " " + fmt.Sprint(target)
+}
+
+// GetAPIProductDependenciesOfProject reads the APIs used by an API Product project
+// @param dir : Path of the API Product project
+// @return APIs used by the product, error
+func GetAPIProductDependenciesOfProject(dir string) ([]APIProductDependency, error) {
+	definition, _, err := resolveYamlOrJSON(filepath.Join(dir, "api_product"))
+	if err != nil {
+		return nil, err
+	}
+	file, err := readProjectFile(definition)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	if data == nil {
+		return nil, errors.New("definition " + definition + " has no data")
+	}
+	return getAPIProductDependencies(data), nil
+}
+
+// GetAPIProductDependenciesFromEnv reads the APIs used by the API Products of an environment
+// @param accessToken : Access token of the environment
+// @param environment : Environment to read the API Products from
+// @param query : Query to select the API Products
+// @return APIs used by the products, error
+func GetAPIProductDependenciesFromEnv(accessToken, environment, query string) ([]APIProductDependency, error) {
+	_, apiProducts, err := GetAPIProductListFromEnv(accessToken, environment, query, applyPruneListLimit)
+	if err != nil {
+		return nil, err
+	}
+	publisherEndpoint := utils.AppendSlashToString(utils.GetPublisherEndpointOfEnv(environment,
+		utils.MainConfigFilePath))
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	var dependencies []APIProductDependency
+	for _, apiProduct := range apiProducts {
+		resp, err := utils.InvokeGETRequest(publisherEndpoint+"api-products/"+apiProduct.ID, headers)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, getPublisherResponseError(resp, "retrieving the API Product "+apiProduct.Name)
+		}
+		data := make(map[string]interface{})
+		if err = json.Unmarshal(resp.Body(), &data); err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, getAPIProductDependencies(data)...)
+	}
+	return dependencies, nil
+}
+
+// getAPIProductDependencies reads the APIs listed in the data of an API Product
+func getAPIProductDependencies(data map[string]interface{}) []APIProductDependency {
+	product, _ := data["name"].(string)
+	productVersion, _ := data["version"].(string)
+	apis, _ := data["apis"].([]interface{})
+	var dependencies []APIProductDependency
+	for _, api := range apis {
+		apiData, ok := api.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dependency := APIProductDependency{Product: product, ProductVersion: productVersion}
+		dependency.Name, _ = apiData["name"].(string)
+		dependency.Version, _ = apiData["version"].(string)
+		dependency.Provider, _ = apiData["provider"].(string)
+		operations, _ := apiData["operations"].([]interface{})
+		for _, operation := range operations {
+			if operationData, ok := operation.(map[string]interface{}); ok {
+				dependency.Operations = append(dependency.Operations,
+					apiOperationKey(operationData["verb"], operationData["target"]))
+			}
+		}
+		sort.Strings(dependency.Operations)
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
+}
+
+// apiProductDependencyRow holds an API Product dependency for outputting
+type apiProductDependencyRow struct {
+	dependency APIProductDependency
+}
+
+// Product of the dependency
+func (r apiProductDependencyRow) Product() string {
+	return r.dependency.Product
+}
+
+// ProductVersion of the dependency
+func (r apiProductDependencyRow) ProductVersion() string {
+	return r.dependency.ProductVersion
+}
+
+// API of the dependency
+func (r apiProductDependencyRow) API() string {
+	return r.dependency.Name
+}
+
+// APIVersion of the dependency
+func (r apiProductDependencyRow) APIVersion() string {
+	return r.dependency.Version
+}
+
+// Provider of the API
+func (r apiProductDependencyRow) Provider() string {
+	return r.dependency.Provider
+}
+
+// Operations of the API used by the API Product
+func (r apiProductDependencyRow) Operations() string {
+	return strings.Join(r.dependency.Operations, ", ")
+}
+
+// MarshalJSON marshals apiProductDependencyRow using custom marshaller which uses methods instead of fields
+func (r *apiProductDependencyRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintAPIProductDependencies prints the APIs used by API Products
+// @param dependencies : APIs used by the API Products
+// @param format : Go template to format the output, or empty for a table
+func PrintAPIProductDependencies(dependencies []APIProductDependency, format string) {
+	if format == "" {
+		format = defaultAPIProductDepsTableFormat
+	}
+	// create API Product dependency context with standard output
+	dependencyContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, dependency := range dependencies {
+			if err := t.Execute(w, &apiProductDependencyRow{dependency}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	dependencyTableHeaders := map[string]string{
+		"Product":        apiProductDepsProductHeader,
+		"ProductVersion": apiProductDepsProductVersionHeader,
+		"API":            apiProductDepsAPIHeader,
+		"APIVersion":     apiProductDepsAPIVersionHeader,
+		"Provider":       apiProductDepsProviderHeader,
+		"Operations":     apiProductDepsOperationsHeader,
+	}
+
+	// execute context
+	if err := dependencyContext.Write(renderer, dependencyTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,168 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testBundleProduct = `type: api_product
+version: v4.1.0
+data:
+  name: LeasingProduct
+  context: /leasing
+  apis:
+    - name: PizzaAPI
+      version: 1.0.0
+      operations:
+        - target: /menu
+          verb: GET
+        - target: /order
+          verb: POST
+    - name: PetAPI
+      version: 2.0.0
+      provider: admin
+      operations:
+        - target: /pet/{petId}
+          verb: get
+`
+
+func writeTestBundleAPI(t *testing.T, dir, name, version string, targets ...string) {
+	content := "type: api\nversion: v4.1.0\ndata:\n  name: " + name + "\n  version: " + version + "\n  operations:\n"
+	for _, target := range targets {
+		content += "    - target: " + target + "\n      verb: GET\n"
+	}
+	writeTestFile(t, filepath.Join(dir, "api.yaml"), content)
+}
+
+func TestGetAPIProductDependenciesOfProject(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "api_product.yaml"), testBundleProduct)
+
+	dependencies, err := GetAPIProductDependenciesOfProject(dir)
+	require.Nil(t, err)
+	assert.Equal(t, []APIProductDependency{
+		{Product: "LeasingProduct", Name: "PizzaAPI", Version: "1.0.0",
+			Operations: []string{"GET /menu", "POST /order"}},
+		{Product: "LeasingProduct", Name: "PetAPI", Version: "2.0.0", Provider: "admin",
+			Operations: []string{"GET /pet/{petId}"}},
+	}, dependencies)
+}
+
+func TestBundleAPIProductEmbedsLocalAndExportedAPIs(t *testing.T) {
+	productDir := filepath.Join(t.TempDir(), "LeasingProduct")
+	writeTestFile(t, filepath.Join(productDir, "api_product.yaml"), testBundleProduct)
+	writeTestBundleAPI(t, filepath.Join(productDir, "APIs", "StaleAPI-0.1.0"), "StaleAPI", "0.1.0")
+	apisDir := t.TempDir()
+	writeTestBundleAPI(t, filepath.Join(apisDir, "pizza", "v1"), "PizzaAPI", "1.0.0", "/menu")
+	writeTestFile(t, filepath.Join(apisDir, "pizza", "v1", "Definitions", "swagger.yaml"), "paths:\n  /menu:\n    get: {}\n"+
+		"  /order:\n    post: {}\n")
+	writeTestBundleAPI(t, filepath.Join(apisDir, "pizza", "v2"), "PizzaAPI", "2.0.0", "/menu", "/order")
+	exportDir := t.TempDir()
+	writeTestFile(t, filepath.Join(exportDir, "PetAPI-2.0.0", "api.yaml"), "type: api\nversion: v4.1.0\ndata:\n"+
+		"  name: PetAPI\n  version: 2.0.0\n")
+	writeTestFile(t, filepath.Join(exportDir, "PetAPI-2.0.0", "Definitions", "swagger.yaml"),
+		"paths:\n  /pet/{petId}:\n    get: {}\n    delete: {}\n")
+	var exported []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		if call != "GET /apis/export" || r.URL.Query().Get("name") != "PetAPI" ||
+			r.URL.Query().Get("version") != "2.0.0" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		exported = append(exported, r.URL.Query().Get("name")+":"+r.URL.Query().Get("providerName"))
+		zipFile := filepath.Join(t.TempDir(), "api.zip")
+		if err := utils.Zip(filepath.Join(exportDir, "PetAPI-2.0.0"), zipFile); err != nil {
+			t.Error(err)
+		}
+		content, err := ioutil.ReadFile(zipFile)
+		if err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	_, _, _, err := bundleAPIProduct("access-token", server.URL, "dev", productDir, apisDir)
+	require.NotNil(t, err, "The API project has no POST /order in its api.yaml")
+	assert.Contains(t, err.Error(), "API PizzaAPI 1.0.0 has no operation POST /order")
+
+	// The OpenAPI definition is read only when the api.yaml has no operations
+	writeTestBundleAPI(t, filepath.Join(apisDir, "pizza", "v1"), "PizzaAPI", "1.0.0")
+	exported = nil
+	bundleDir, entries, cleanup, err := bundleAPIProduct("access-token", server.URL, "dev", productDir, apisDir)
+	require.Nil(t, err)
+	defer cleanup()
+	assert.Equal(t, []string{"PetAPI:admin"}, exported)
+	require.Len(t, entries, 2)
+	assert.Equal(t, filepath.Join(apisDir, "pizza", "v1"), entries[0].Source)
+	assert.Equal(t, "dev", entries[1].Source)
+	assert.Equal(t, "LeasingProduct", filepath.Base(bundleDir))
+	assert.True(t, utils.IsFileExist(filepath.Join(bundleDir, "api_product.yaml")))
+	assert.True(t, utils.IsFileExist(filepath.Join(bundleDir, "APIs", "PizzaAPI-1.0.0", "Definitions",
+		"swagger.yaml")))
+	assert.True(t, utils.IsFileExist(filepath.Join(bundleDir, "APIs", "PetAPI-2.0.0", "api.yaml")))
+	assert.False(t, utils.IsFileExist(filepath.Join(bundleDir, "APIs", "StaleAPI-0.1.0")),
+		"The APIs of the project should be replaced by the resolved APIs")
+	assert.True(t, utils.IsFileExist(filepath.Join(productDir, "APIs", "StaleAPI-0.1.0")),
+		"The product project should be left as it is")
+}
+
+func TestBundleAPIProductReportsUnresolvedAPIs(t *testing.T) {
+	productDir := t.TempDir()
+	writeTestFile(t, filepath.Join(productDir, "api_product.yaml"), testBundleProduct)
+	apisDir := t.TempDir()
+	writeTestBundleAPI(t, filepath.Join(apisDir, "a"), "PizzaAPI", "1.0.0", "/menu", "/order")
+	writeTestBundleAPI(t, filepath.Join(apisDir, "b"), "PizzaAPI", "1.0.0", "/menu", "/order")
+
+	_, _, _, err := BundleAPIProduct("", "", productDir, apisDir)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "API PizzaAPI 1.0.0 is found in more than one project")
+	assert.Contains(t, err.Error(), "API PetAPI 2.0.0 is not found in "+apisDir)
+
+	var exported []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		if call != "GET /apis/export" {
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		exported = append(exported, r.URL.Query().Get("name")+":"+r.URL.Query().Get("providerName"))
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 404, "description": "API not found"}`))
+	}))
+	defer server.Close()
+
+	_, _, _, err = bundleAPIProduct("access-token", server.URL, "dev", productDir, apisDir)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "API PetAPI 2.0.0 could not be exported: error exporting the API PetAPI 2.0.0 "+
+		"from dev. Status: 404 Not Found. API not found")
+	assert.Equal(t, []string{"PetAPI:admin"}, exported)
+}
@@ -40,7 +40,18 @@ import (
 func GetAPIProductId(accessToken, environment, apiProductName, apiProductVersion, apiProductProvider string) (string, error) {
 	// Unified Search endpoint from the config file to search API Products
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return getAPIProductId(accessToken, unifiedSearchEndpoint, apiProductName, apiProductVersion, apiProductProvider)
+}
 
+// getAPIProductId Get the ID of an API Product if available
+// @param accessToken : Token to call the Publisher Rest API
+// @param unifiedSearchEndpoint : Unified search endpoint of the environment
+// @param apiProductName : Name of the API Product
+// @param apiProductVersion : Version of the API Product
+// @param apiProductProvider : Provider of the API Product
+// @return apiId, error
+func getAPIProductId(accessToken, unifiedSearchEndpoint, apiProductName, apiProductVersion,
+	apiProductProvider string) (string, error) {
 	// Prepping headers
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
@@ -66,11 +77,11 @@ func GetAPIProductId(accessToken, environment, apiProductName, apiProductVersion
 		}
 		// TODO Print the version as well when the versioning support has been implemented for API Products
 		if apiProductProvider != "" {
-			return "", errors.New("Requested API Product is not available in the Publisher. API Product: " + apiProductName +
-				" Version: " + apiProductVersion + " Provider: " + apiProductProvider)
+			return "", &ArtifactNotFoundError{"Requested API Product is not available in the Publisher. API Product: " +
+				apiProductName + " Version: " + apiProductVersion + " Provider: " + apiProductProvider}
 		}
-		return "", errors.New("Requested API Product is not available in the Publisher. API Product: " + apiProductName +
-			" Version: " + apiProductVersion)
+		return "", &ArtifactNotFoundError{"Requested API Product is not available in the Publisher. API Product: " +
+			apiProductName + " Version: " + apiProductVersion}
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
 		utils.Logf("Body: %s\n", resp.Body())
@@ -30,6 +30,21 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
+// ArtifactNotFoundError is returned when an artifact being searched does not exist in the environment
+type ArtifactNotFoundError struct {
+	message string
+}
+
+func (e *ArtifactNotFoundError) Error() string {
+	return e.message
+}
+
+// IsArtifactNotFound returns whether an error is due to an artifact not existing in the environment
+func IsArtifactNotFound(err error) bool {
+	_, ok := err.(*ArtifactNotFoundError)
+	return ok
+}
+
 // GetAPIId Get the ID of an API if available
 // @param accessToken : Token to call the Publisher Rest API
 // @param environment : Environment where API needs to be located
@@ -40,7 +55,17 @@ import (
 func GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider string) (string, error) {
 	// Unified Search endpoint from the config file to search APIs
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return getAPIId(accessToken, unifiedSearchEndpoint, apiName, apiVersion, apiProvider)
+}
 
+// getAPIId Get the ID of an API if available
+// @param accessToken : Token to call the Publisher Rest API
+// @param unifiedSearchEndpoint : Unified search endpoint of the environment
+// @param apiName : Name of the API
+// @param apiVersion : Version of the API
+// @param apiProvider : Provider of API
+// @return apiId, error
+func getAPIId(accessToken, unifiedSearchEndpoint, apiName, apiVersion, apiProvider string) (string, error) {
 	// Prepping headers
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
@@ -63,11 +88,11 @@ func GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider string)
 			return apiId, err
 		}
 		if apiProvider != "" {
-			return "", errors.New("Requested API is not available in the Publisher. API: " + apiName +
-				" Version: " + apiVersion + " Provider: " + apiProvider)
+			return "", &ArtifactNotFoundError{"Requested API is not available in the Publisher. API: " + apiName +
+				" Version: " + apiVersion + " Provider: " + apiProvider}
 		}
-		return "", errors.New("Requested API is not available in the Publisher. API: " + apiName +
-			" Version: " + apiVersion)
+		return "", &ArtifactNotFoundError{"Requested API is not available in the Publisher. API: " + apiName +
+			" Version: " + apiVersion}
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
 		utils.Logf("Body: %s\n", resp.Body())
@@ -0,0 +1,285 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// residentKeyManagerName is the key manager of the keys exported without a key manager
+const residentKeyManagerName = "Resident Key Manager"
+
+// KeyManagerMap renames the key managers and the key types of the keys of an application being imported
+type KeyManagerMap struct {
+	KeyManagers map[string]string `yaml:"keyManagers"`
+	KeyTypes    map[string]string `yaml:"keyTypes"`
+}
+
+// AppKeyImportOptions holds how the keys of an application are moved to the environment it is imported to
+type AppKeyImportOptions struct {
+	// KeyManagerMap renames the key managers and the key types of the keys, or nil
+	KeyManagerMap *KeyManagerMap
+	// RegenerateKeys generates new keys for the imported application instead of importing the exported keys
+	RegenerateKeys bool
+	// ParamsPath is the path of the params file with the callback URLs and the grant types of the environments
+	ParamsPath string
+
+	// keys holds the values of the keys from the params file for the target environment
+	keys []params.ApplicationKeyParams
+	// keyManagers holds the names of the key managers of the target environment, or nil to skip the validation
+	keyManagers []string
+}
+
+// LoadKeyManagerMap reads a key manager map file
+// @param path : Path of the key manager map file
+// @return key manager map, error
+func LoadKeyManagerMap(path string) (*KeyManagerMap, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keyManagerMap := &KeyManagerMap{}
+	if err = yaml.UnmarshalStrict(content, keyManagerMap); err != nil {
+		return nil, errors.New("error reading the key manager map " + path + ": " + err.Error())
+	}
+	if len(keyManagerMap.KeyManagers) == 0 && len(keyManagerMap.KeyTypes) == 0 {
+		return nil, errors.New("the key manager map " + path + " has no mappings")
+	}
+	for from, to := range keyManagerMap.KeyTypes {
+		if !isApplicationKeyType(from) || !isApplicationKeyType(to) {
+			return nil, errors.New("the key manager map " + path + " maps the key type " + from + " to " + to +
+				". The key types should be " + utils.ProductionKeyType + " or " + utils.SandboxKeyType)
+		}
+	}
+	return keyManagerMap, nil
+}
+
+func isApplicationKeyType(keyType string) bool {
+	return keyType == utils.ProductionKeyType || keyType == utils.SandboxKeyType
+}
+
+// remapsKeys returns whether the keys of the application are moved to the target environment with the options
+func (options *AppKeyImportOptions) remapsKeys(skipKeys bool) bool {
+	return options != nil && (options.RegenerateKeys || !skipKeys)
+}
+
+// resolveAppKeyImportOptions reads the values of the keys and the key managers of the target environment
+func resolveAppKeyImportOptions(accessToken, environment string, options *AppKeyImportOptions) error {
+	if options.ParamsPath != "" {
+		applicationParams, err := params.LoadApplicationParamsFromFile(options.ParamsPath)
+		if err != nil {
+			return errors.New("error loading params file " + options.ParamsPath + ": " + err.Error())
+		}
+		if envParams := applicationParams.GetEnv(environment); envParams != nil {
+			options.keys = envParams.Configs.Keys
+		} else {
+			utils.Logln(utils.LogPrefixInfo + "No application params defined for the environment " + environment)
+		}
+	}
+	keyManagers, err := getDevPortalKeyManagerNames(accessToken, environment)
+	if err != nil {
+		return err
+	}
+	options.keyManagers = keyManagers
+	return nil
+}
+
+// getDevPortalKeyManagerNames returns the names of the key managers the applications of an environment can use
+func getDevPortalKeyManagerNames(accessToken, environment string) ([]string, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(utils.GetDevPortalKeyManagersEndpointOfEnv(environment,
+		utils.MainConfigFilePath), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the key managers of "+environment)
+	}
+	keyManagerList := &utils.KeyManagerListResponse{}
+	if err = json.Unmarshal(resp.Body(), keyManagerList); err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, keyManager := range keyManagerList.List {
+		if keyManager.Enabled {
+			names = append(names, keyManager.Name)
+		}
+	}
+	return names, nil
+}
+
+// remapApplicationKeys rewrites the key managers, the key types, the callback URLs and the grant types of the keys
+// of an application project, and validates that the key managers exist in the target environment
+// @param dir : Path of the application project
+// @param options : How the keys are moved to the target environment
+// @return requests to generate the keys in the target environment, error
+func remapApplicationKeys(dir string, options *AppKeyImportOptions) ([]utils.KeygenRequest, error) {
+	definition, _, err := resolveYamlOrJSON(filepath.Join(dir, "application"))
+	if err != nil {
+		return nil, err
+	}
+	file, err := readProjectFile(definition)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	info, _ := data["applicationInfo"].(map[string]interface{})
+	if info == nil {
+		return nil, errors.New("definition " + definition + " has no application information")
+	}
+	keys, _ := info["keys"].([]interface{})
+
+	var requests []utils.KeygenRequest
+	var problems []string
+	mapped := make(map[string]bool)
+	for _, key := range keys {
+		keyData, ok := key.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		keyManager, _ := keyData["keyManager"].(string)
+		if keyManager == "" {
+			keyManager = residentKeyManagerName
+		}
+		keyType, _ := keyData["keyType"].(string)
+		if options.KeyManagerMap != nil {
+			if target, ok := options.KeyManagerMap.KeyManagers[keyManager]; ok {
+				keyManager = target
+			}
+			if target, ok := options.KeyManagerMap.KeyTypes[keyType]; ok {
+				keyType = target
+			}
+		}
+		keyData["keyManager"] = keyManager
+		keyData["keyType"] = keyType
+		for _, keyParams := range options.keys {
+			if (keyParams.KeyManager != "" && keyParams.KeyManager != keyManager) ||
+				(keyParams.KeyType != "" && keyParams.KeyType != keyType) {
+				continue
+			}
+			if keyParams.CallbackURL != "" {
+				keyData["callbackUrl"] = keyParams.CallbackURL
+			}
+			if len(keyParams.GrantTypes) > 0 {
+				grantTypes := make([]interface{}, len(keyParams.GrantTypes))
+				for i, grantType := range keyParams.GrantTypes {
+					grantTypes[i] = grantType
+				}
+				keyData["supportedGrantTypes"] = grantTypes
+			}
+		}
+
+		if mapped[keyManager+":"+keyType] {
+			problems = append(problems, "more than one "+keyType+" key is mapped to the key manager "+keyManager)
+		}
+		mapped[keyManager+":"+keyType] = true
+		if options.keyManagers != nil && !containsString(options.keyManagers, keyManager) {
+			problems = append(problems, "key manager "+keyManager+" of the "+keyType+" keys is not found. "+
+				"Available key managers: "+strings.Join(options.keyManagers, ", "))
+		}
+		requests = append(requests, newApplicationKeygenRequest(keyData))
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, errors.New("the keys of the application cannot be imported:\n  " +
+			strings.Join(problems, "\n  "))
+	}
+	if len(keys) > 0 {
+		fileType, _ := file["type"].(string)
+		version, _ := file["version"].(string)
+		if err = writeProjectFile(definition, fileType, version, data); err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// newApplicationKeygenRequest creates the request to generate keys in place of the exported keys of an application
+func newApplicationKeygenRequest(keyData map[string]interface{}) utils.KeygenRequest {
+	request := utils.KeygenRequest{ValidityTime: utils.DefaultTokenValidityPeriod}
+	request.KeyManager, _ = keyData["keyManager"].(string)
+	request.KeyType, _ = keyData["keyType"].(string)
+	request.CallbackURL, _ = keyData["callbackUrl"].(string)
+	grantTypes, _ := keyData["supportedGrantTypes"].([]interface{})
+	for _, grantType := range grantTypes {
+		if value, ok := grantType.(string); ok {
+			request.GrantTypesToBeSupported = append(request.GrantTypesToBeSupported, value)
+		}
+	}
+	if len(request.GrantTypesToBeSupported) == 0 {
+		request.GrantTypesToBeSupported = utils.GrantTypesToBeSupported
+	}
+	return request
+}
+
+// regenerateApplicationKeys generates the keys of an imported application
+// @param accessToken : Access token of the environment
+// @param devportalApplicationsEndpoint : Dev Portal Applications Endpoint of the environment
+// @param importResponse : Body of the response of the application import
+// @param requests : Requests to generate the keys
+// @return error
+func regenerateApplicationKeys(accessToken, devportalApplicationsEndpoint string, importResponse []byte,
+	requests []utils.KeygenRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+	application := struct {
+		ApplicationId string `json:"applicationId"`
+	}{}
+	if err := json.Unmarshal(importResponse, &application); err != nil || application.ApplicationId == "" {
+		return errors.New("the id of the imported application is not found in the import response")
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	endpoint := utils.AppendSlashToString(devportalApplicationsEndpoint) + application.ApplicationId + "/generate-keys"
+	for _, request := range requests {
+		body, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		resp, err := utils.InvokePOSTRequest(endpoint, headers, string(body))
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+			return getPublisherResponseError(resp, "generating the "+request.KeyType+" keys of the key manager "+
+				request.KeyManager)
+		}
+		keygenResponse := &utils.KeygenResponse{}
+		if err = json.Unmarshal(resp.Body(), keygenResponse); err != nil {
+			return err
+		}
+		fmt.Println("Generated " + request.KeyType + " keys of the key manager " + request.KeyManager +
+			". Consumer key: " + keygenResponse.ConsumerKey)
+	}
+	return nil
+}
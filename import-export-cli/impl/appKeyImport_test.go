@@ -0,0 +1,175 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testKeyImportApplication = `type: application
+version: v4.1.0
+data:
+  applicationInfo:
+    name: SampleApp
+    owner: admin
+    keys:
+      - keyManager: okta-dev
+        keyType: PRODUCTION
+        consumerKey: prod-key
+        callbackUrl: https://dev.example.com/callback
+        supportedGrantTypes:
+          - password
+      - keyType: SANDBOX
+        consumerKey: sandbox-key
+`
+
+func readTestApplicationKeys(t *testing.T, dir string) []interface{} {
+	file, err := readProjectFile(filepath.Join(dir, "application.yaml"))
+	require.Nil(t, err)
+	info := file["data"].(map[string]interface{})["applicationInfo"].(map[string]interface{})
+	return info["keys"].([]interface{})
+}
+
+func TestLoadKeyManagerMap(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "key-manager-map.yaml")
+	writeTestFile(t, path, "keyManagers:\n  okta-dev: okta-prod\nkeyTypes:\n  SANDBOX: PRODUCTION\n")
+	keyManagerMap, err := LoadKeyManagerMap(path)
+	require.Nil(t, err)
+	assert.Equal(t, "okta-prod", keyManagerMap.KeyManagers["okta-dev"])
+	assert.Equal(t, utils.ProductionKeyType, keyManagerMap.KeyTypes[utils.SandboxKeyType])
+
+	writeTestFile(t, path, "keyTypes:\n  SANDBOX: STAGING\n")
+	_, err = LoadKeyManagerMap(path)
+	assert.NotNil(t, err, "Unknown key types should be rejected")
+
+	writeTestFile(t, path, "keyManager:\n  okta-dev: okta-prod\n")
+	_, err = LoadKeyManagerMap(path)
+	assert.NotNil(t, err, "Unknown sections should be rejected")
+
+	writeTestFile(t, path, "keyManagers: {}\n")
+	_, err = LoadKeyManagerMap(path)
+	assert.NotNil(t, err, "A map without mappings should be rejected")
+}
+
+func TestRemapApplicationKeys(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "application.yaml"), testKeyImportApplication)
+	options := &AppKeyImportOptions{
+		KeyManagerMap: &KeyManagerMap{KeyManagers: map[string]string{"okta-dev": "okta-prod"}},
+		keys: []params.ApplicationKeyParams{
+			{CallbackURL: "https://prod.example.com/callback"},
+			{KeyManager: "okta-prod", GrantTypes: []string{"client_credentials"}},
+			{KeyType: "SANDBOX", CallbackURL: "https://sandbox.example.com/callback"},
+		},
+		keyManagers: []string{"okta-prod", residentKeyManagerName},
+	}
+
+	requests, err := remapApplicationKeys(dir, options)
+	require.Nil(t, err)
+	assert.Equal(t, []utils.KeygenRequest{
+		{KeyType: "PRODUCTION", KeyManager: "okta-prod", GrantTypesToBeSupported: []string{"client_credentials"},
+			CallbackURL: "https://prod.example.com/callback", ValidityTime: utils.DefaultTokenValidityPeriod},
+		{KeyType: "SANDBOX", KeyManager: residentKeyManagerName, GrantTypesToBeSupported: utils.GrantTypesToBeSupported,
+			CallbackURL: "https://sandbox.example.com/callback", ValidityTime: utils.DefaultTokenValidityPeriod},
+	}, requests)
+
+	keys := readTestApplicationKeys(t, dir)
+	production := keys[0].(map[string]interface{})
+	assert.Equal(t, "okta-prod", production["keyManager"])
+	assert.Equal(t, "https://prod.example.com/callback", production["callbackUrl"])
+	assert.Equal(t, []interface{}{"client_credentials"}, production["supportedGrantTypes"])
+	assert.Equal(t, "prod-key", production["consumerKey"])
+	assert.Equal(t, residentKeyManagerName, keys[1].(map[string]interface{})["keyManager"])
+}
+
+func TestRemapApplicationKeysValidatesKeyManagers(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "application.yaml"), testKeyImportApplication)
+
+	_, err := remapApplicationKeys(dir, &AppKeyImportOptions{keyManagers: []string{residentKeyManagerName}})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "key manager okta-dev of the PRODUCTION keys is not found")
+
+	options := &AppKeyImportOptions{
+		KeyManagerMap: &KeyManagerMap{
+			KeyManagers: map[string]string{"okta-dev": residentKeyManagerName},
+			KeyTypes:    map[string]string{"SANDBOX": "PRODUCTION"},
+		},
+		keyManagers: []string{residentKeyManagerName},
+	}
+	_, err = remapApplicationKeys(dir, options)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "more than one PRODUCTION key is mapped to the key manager "+
+		residentKeyManagerName)
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "application.yaml"))
+	require.Nil(t, err)
+	assert.Equal(t, testKeyImportApplication, string(content), "The project should not be changed when invalid")
+}
+
+func TestImportApplicationRegeneratesKeys(t *testing.T) {
+	var importURL string
+	var keygenRequests []utils.KeygenRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/applications/import":
+			importURL = r.URL.String()
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"applicationId":"app-id","name":"SampleApp"}`))
+		case "/applications/app-id/generate-keys":
+			request := utils.KeygenRequest{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+			keygenRequests = append(keygenRequests, request)
+			_, _ = w.Write([]byte(`{"consumerKey":"new-key","keyType":"` + request.KeyType + `"}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "admin_SampleApp")
+	writeTestFile(t, filepath.Join(dir, "application.yaml"), testKeyImportApplication)
+	options := &AppKeyImportOptions{
+		KeyManagerMap:  &KeyManagerMap{KeyManagers: map[string]string{"okta-dev": "okta-prod"}},
+		RegenerateKeys: true,
+	}
+
+	_, err := ImportApplication("access-token", server.URL+"/applications", dir, "admin", false, true, false,
+		false, false, nil, options)
+	require.Nil(t, err)
+	assert.Contains(t, importURL, "skipApplicationKeys=true")
+	require.Len(t, keygenRequests, 2)
+	assert.Equal(t, "okta-prod", keygenRequests[0].KeyManager)
+	assert.Equal(t, "PRODUCTION", keygenRequests[0].KeyType)
+	assert.Equal(t, []string{"password"}, keygenRequests[0].GrantTypesToBeSupported)
+	assert.Equal(t, residentKeyManagerName, keygenRequests[1].KeyManager)
+	assert.Equal(t, "okta-dev", readTestApplicationKeys(t, dir)[0].(map[string]interface{})["keyManager"],
+		"The application project should be left as it is")
+}
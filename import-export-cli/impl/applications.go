@@ -0,0 +1,245 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ApplicationUpdate contains the details of an application to be changed. Empty values are left as they are.
+type ApplicationUpdate struct {
+	Name             string
+	ThrottlingPolicy string
+	Description      string
+	TokenType        string
+}
+
+// AddApplication creates an application in the devportal
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment to create the application in
+// @param name : Name of the application
+// @param throttlingPolicy : Application throttling policy. The first available policy is used if this is empty.
+// @param description : Description of the application
+// @param tokenType : Token type of the application. The token type of the configuration is used if this is empty.
+// @return appId, error
+func AddApplication(accessToken, environment, name, throttlingPolicy, description, tokenType string) (string,
+	error) {
+	keyGenEnv = environment
+	appId, err := searchApplication(name, accessToken)
+	if err != nil {
+		return "", err
+	}
+	if appId != "" {
+		return "", errors.New("an application with the name " + name + " already exists. ID: " + appId)
+	}
+	throttlingPolicy, err = resolveApplicationThrottlingPolicy(accessToken, throttlingPolicy)
+	if err != nil {
+		return "", err
+	}
+	if tokenType == "" {
+		tokenType = utils.GetMainConfigFromFile(utils.MainConfigFilePath).Config.TokenType
+	}
+	if tokenType == "" {
+		tokenType = utils.DefaultTokenType
+	}
+	appId, _, err = createApplicationWithRequest(accessToken, utils.AppCreateRequest{
+		Name:             name,
+		ThrottlingPolicy: throttlingPolicy,
+		Description:      description,
+		TokenType:        strings.ToUpper(tokenType),
+	})
+	return appId, err
+}
+
+// UpdateApplication changes the name, throttling policy, description or token type of an application
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment of the application
+// @param name : Name of the application
+// @param update : Details of the application to be changed
+// @return AppDetails, error
+func UpdateApplication(accessToken, environment, name string, update ApplicationUpdate) (*utils.AppDetails, error) {
+	keyGenEnv = environment
+	appId, err := getApplicationIdByName(name, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	appDetails, err := getApplicationDetails(appId, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	appUpdateReq := utils.AppCreateRequest{
+		Name:             appDetails.Name,
+		ThrottlingPolicy: appDetails.ThrottlingPolicy,
+		TokenType:        appDetails.TokenType,
+	}
+	if description, ok := appDetails.Description.(string); ok {
+		appUpdateReq.Description = description
+	}
+	if update.Name != "" {
+		appUpdateReq.Name = update.Name
+	}
+	if update.ThrottlingPolicy != "" {
+		if appUpdateReq.ThrottlingPolicy, err = resolveApplicationThrottlingPolicy(accessToken,
+			update.ThrottlingPolicy); err != nil {
+			return nil, err
+		}
+	}
+	if update.Description != "" {
+		appUpdateReq.Description = update.Description
+	}
+	if update.TokenType != "" {
+		appUpdateReq.TokenType = strings.ToUpper(update.TokenType)
+	}
+	body, err := json.Marshal(appUpdateReq)
+	if err != nil {
+		return nil, err
+	}
+	return updateApplicationDetails(appId, string(body), accessToken)
+}
+
+// GenerateKeys generates the consumer key, consumer secret and an access token of an application
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param generateKeyReq : Key type, key manager, grant types and callback URL of the keys
+// @return KeygenResponse, error
+func GenerateKeys(accessToken, environment, appName string, generateKeyReq utils.KeygenRequest) (
+	*utils.KeygenResponse, error) {
+	keyGenEnv = environment
+	generateKeyReq.KeyType = strings.ToUpper(generateKeyReq.KeyType)
+	if generateKeyReq.KeyType != utils.ProductionKeyType && generateKeyReq.KeyType != utils.SandboxKeyType {
+		return nil, errors.New("invalid key type " + generateKeyReq.KeyType + ". Key type should be either " +
+			utils.ProductionKeyType + " or " + utils.SandboxKeyType)
+	}
+	if len(generateKeyReq.GrantTypesToBeSupported) == 0 {
+		generateKeyReq.GrantTypesToBeSupported = utils.GrantTypesToBeSupported
+	}
+	if generateKeyReq.CallbackURL == "" && containsGrantType(generateKeyReq.GrantTypesToBeSupported,
+		"authorization_code", "implicit") {
+		return nil, errors.New("a callback URL is required for the authorization_code and implicit grant types")
+	}
+	if generateKeyReq.ValidityTime == 0 {
+		generateKeyReq.ValidityTime = utils.DefaultTokenValidityPeriod
+	}
+	appId, err := getApplicationIdByName(appName, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return generateApplicationKeysWithRequest(appId, accessToken, generateKeyReq)
+}
+
+// PrintGeneratedKeys prints the consumer key, consumer secret and the access token of generated keys
+func PrintGeneratedKeys(keygenResponse *utils.KeygenResponse) {
+	fmt.Println("Key Type:", keygenResponse.KeyType)
+	fmt.Println("Consumer Key:", keygenResponse.ConsumerKey)
+	fmt.Println("Consumer Secret:", keygenResponse.ConsumerSecret)
+	fmt.Println("Grant Types:", strings.Join(keygenResponse.SupportedGrantTypes, ","))
+	if keygenResponse.Token.AccessToken != "" {
+		fmt.Println("Access Token:", keygenResponse.Token.AccessToken)
+	}
+}
+
+// getApplicationIdByName returns the ID of the application of the user with the given name
+func getApplicationIdByName(appName, accessToken string) (string, error) {
+	appId, err := searchApplication(appName, accessToken)
+	if err != nil {
+		return "", err
+	}
+	if appId == "" {
+		return "", errors.New("cannot find the application: " + appName)
+	}
+	return appId, nil
+}
+
+// containsGrantType returns whether any of the expected grant types is in the grant types
+func containsGrantType(grantTypes []string, expected ...string) bool {
+	for _, grantType := range grantTypes {
+		for _, expectedGrantType := range expected {
+			if grantType == expectedGrantType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolveApplicationThrottlingPolicy checks the requested application throttling policy is available in the
+// environment, or picks the first available policy if none is requested
+func resolveApplicationThrottlingPolicy(accessToken, requestedPolicy string) (string, error) {
+	policies, err := getApplicationThrottlingPolicies(accessToken)
+	if err != nil {
+		return "", err
+	}
+	return selectThrottlingPolicy(policies, requestedPolicy, "application throttling policy")
+}
+
+// selectThrottlingPolicy returns the requested policy if it is one of the available policies, or the first
+// available policy if none is requested
+func selectThrottlingPolicy(available []string, requested, kind string) (string, error) {
+	if len(available) == 0 {
+		return "", errors.New("no " + kind + " is available")
+	}
+	if requested == "" {
+		return available[0], nil
+	}
+	for _, policy := range available {
+		if strings.EqualFold(policy, requested) {
+			return policy, nil
+		}
+	}
+	return "", errors.New("the " + kind + " " + requested + " is not available. Available: " +
+		strings.Join(available, ", "))
+}
+
+// getApplicationThrottlingPolicies retrieves the names of the application throttling policies of the environment
+func getApplicationThrottlingPolicies(accessToken string) ([]string, error) {
+	applicationThrottlingPoliciesEndpoint := utils.GetDevPortalThrottlingPoliciesEndpointOfEnv(keyGenEnv,
+		utils.MainConfigFilePath) + "/application"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(applicationThrottlingPoliciesEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		applicationThrottlingData := &utils.ThrottlingPoliciesList{}
+		if err = json.Unmarshal(resp.Body(), applicationThrottlingData); err != nil {
+			return nil, err
+		}
+		var policies []string
+		for _, policy := range applicationThrottlingData.List {
+			policies = append(policies, policy.Name)
+		}
+		return policies, nil
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return nil, fmt.Errorf("authorization failed while trying to retrieve application throttling policies")
+	}
+	return nil, errors.New("Request didn't respond 200 OK for retrieving application throttling policies. " +
+		"Status: " + resp.Status())
+}
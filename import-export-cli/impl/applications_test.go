@@ -0,0 +1,76 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestSelectThrottlingPolicy(t *testing.T) {
+	policy, err := selectThrottlingPolicy([]string{"Unlimited", "Gold"}, "", "subscription tier")
+	assert.Nil(t, err)
+	assert.Equal(t, "Unlimited", policy)
+
+	policy, err = selectThrottlingPolicy([]string{"Unlimited", "Gold"}, "gold", "subscription tier")
+	assert.Nil(t, err)
+	assert.Equal(t, "Gold", policy)
+
+	_, err = selectThrottlingPolicy([]string{"Unlimited", "Gold"}, "Bronze", "subscription tier")
+	assert.EqualError(t, err, "the subscription tier Bronze is not available. Available: Unlimited, Gold")
+
+	_, err = selectThrottlingPolicy(nil, "", "application throttling policy")
+	assert.NotNil(t, err)
+}
+
+func TestFindSubscription(t *testing.T) {
+	subscriptions := make([]utils.Subscription, 3)
+	for i, provider := range []string{"admin", "alice", "admin"} {
+		subscriptions[i].SubscriptionID = provider + string(rune('0'+i))
+		subscriptions[i].APIInfo.Name = "PizzaShackAPI"
+		subscriptions[i].APIInfo.Version = "1.0.0"
+		subscriptions[i].APIInfo.Provider = provider
+	}
+	subscriptions[2].APIInfo.Version = "2.0.0"
+
+	_, err := findSubscription(subscriptions, "PizzaShackAPI", "1.0.0", "")
+	assert.NotNil(t, err)
+
+	subscriptionId, err := findSubscription(subscriptions, "PizzaShackAPI", "1.0.0", "alice")
+	assert.Nil(t, err)
+	assert.Equal(t, "alice1", subscriptionId)
+
+	subscriptionId, err = findSubscription(subscriptions, "PizzaShackAPI", "2.0.0", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "admin2", subscriptionId)
+
+	_, err = findSubscription(subscriptions, "PizzaShackAPI", "3.0.0", "")
+	assert.EqualError(t, err, "cannot find a subscription to PizzaShackAPI 3.0.0")
+}
+
+func TestGenerateKeysValidatesRequest(t *testing.T) {
+	_, err := GenerateKeys("token", "dev", "SampleApp", utils.KeygenRequest{KeyType: "STAGING"})
+	assert.NotNil(t, err)
+
+	_, err = GenerateKeys("token", "dev", "SampleApp", utils.KeygenRequest{KeyType: "sandbox",
+		GrantTypesToBeSupported: []string{"authorization_code"}})
+	assert.EqualError(t, err, "a callback URL is required for the authorization_code and implicit grant types")
+}
@@ -0,0 +1,1032 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Order in which the projects are applied. Artifacts are deleted in the reverse order.
+var applyProjectOrder = []string{
+	utils.ProjectTypeThrottlingPolicy,
+	utils.ProjectTypeAPIPolicy,
+	utils.ProjectTypeApi,
+	utils.ProjectTypeApiProduct,
+	utils.ProjectTypeApplication,
+}
+
+// Types of the artifacts which are deleted when pruning
+var applyPrunableTypes = []string{
+	utils.ProjectTypeApplication,
+	utils.ProjectTypeApiProduct,
+	utils.ProjectTypeApi,
+}
+
+const applyPruneListLimit = "10000"
+
+// Actions of an apply plan
+const (
+	ApplyActionCreate    = "create"
+	ApplyActionUpdate    = "update"
+	ApplyActionDelete    = "delete"
+	ApplyActionUnchanged = "unchanged"
+)
+
+const (
+	applyActionHeader  = "ACTION"
+	applyTypeHeader    = "TYPE"
+	applyNameHeader    = "NAME"
+	applyVersionHeader = "VERSION"
+	applyPathHeader    = "PATH"
+	applyResultHeader  = "RESULT"
+
+	applyPlanTableFormat   = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Path}}"
+	applyResultTableFormat = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Result}}"
+)
+
+// ApplyProject is a project of an artifact found in the directory being applied
+type ApplyProject struct {
+	Type         string
+	Name         string
+	Version      string
+	Owner        string
+	Path         string
+	RelativePath string
+	ParamsPath   string
+	Labels       map[string]string
+	MetaData     *utils.MetaData
+
+	throttlingPolicy *desiredThrottlingPolicy
+}
+
+// ApplyChange is an action needed to converge an artifact of an environment to the projects of a directory
+type ApplyChange struct {
+	Action  string
+	Type    string
+	Name    string
+	Version string
+	Owner   string
+	Path    string
+	Id      string
+	Err     error
+
+	project *ApplyProject
+}
+
+// applyArtifact is an artifact of an environment carrying the labels of a selector
+type applyArtifact struct {
+	Type    string
+	Id      string
+	Name    string
+	Version string
+	Owner   string
+}
+
+// ParseSelector parses a label selector of the form key1=value1,key2=value2
+// @param selector : Label selector
+// @return labels of the selector, error
+func ParseSelector(selector string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, requirement := range strings.Split(selector, ",") {
+		requirement = strings.TrimSpace(requirement)
+		if requirement == "" {
+			continue
+		}
+		keyValue := strings.SplitN(requirement, "=", 2)
+		if len(keyValue) != 2 || strings.TrimSpace(keyValue[0]) == "" {
+			return nil, errors.New("invalid selector " + requirement + ", expected the form key=value")
+		}
+		labels[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
+	}
+	if len(labels) == 0 {
+		return nil, errors.New("selector is empty")
+	}
+	return labels, nil
+}
+
+// DiscoverApplyProjects finds the API, API Product, application, operation policy and throttling policy projects
+// of a directory tree
+// @param dir : Directory to search the projects in
+// @param paramsDir : Directory with the params of each project under the same relative path (optional)
+// @param environment : Environment the projects are applied to, used to pick the params
+// @return projects in the order they are applied, error
+func DiscoverApplyProjects(dir, paramsDir, environment string) ([]*ApplyProject, error) {
+	var projects []*ApplyProject
+	found := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relativePath, _ := filepath.Rel(dir, path)
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			project, err := detectApplyProject(path)
+			if err != nil {
+				return err
+			}
+			if project == nil {
+				return nil
+			}
+			project.RelativePath = relativePath
+			project.ParamsPath = resolveApplyProjectParams(project, paramsDir, environment)
+			if err = addApplyProject(&projects, found, project); err != nil {
+				return err
+			}
+			// Files of a project such as its operation policies are applied with the project
+			return filepath.SkipDir
+		}
+		extension := strings.ToLower(filepath.Ext(path))
+		if extension != ".yaml" && extension != ".yml" && extension != ".json" {
+			return nil
+		}
+		file, err := readProjectFile(path)
+		if err != nil {
+			utils.Logln(utils.LogPrefixWarning + "Skipping " + path + ": " + err.Error())
+			return nil
+		}
+		if fileType, _ := file["type"].(string); fileType != throttlingPolicyFileType {
+			return nil
+		}
+		policy, err := readThrottlingPolicyFile(path)
+		if err != nil {
+			return err
+		}
+		return addApplyProject(&projects, found, &ApplyProject{Type: utils.ProjectTypeThrottlingPolicy,
+			Name: policy.Name, Version: policy.Type, Path: path, RelativePath: relativePath, throttlingPolicy: policy})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, errors.New("no projects were found in " + dir)
+	}
+	sort.SliceStable(projects, func(i, j int) bool {
+		return applyProjectRank(projects[i].Type) < applyProjectRank(projects[j].Type)
+	})
+	return projects, nil
+}
+
+func addApplyProject(projects *[]*ApplyProject, found map[string]string, project *ApplyProject) error {
+	key := applyArtifactKey(project.Type, project.Name, project.Version)
+	if existing, ok := found[key]; ok {
+		return fmt.Errorf("%s %s is defined in both %s and %s", project.Type, project.Name, existing,
+			project.RelativePath)
+	}
+	found[key] = project.RelativePath
+	*projects = append(*projects, project)
+	return nil
+}
+
+// detectApplyProject identifies the project of a directory from its definition file
+// @return project, or nil if the directory is not a project
+func detectApplyProject(dir string) (*ApplyProject, error) {
+	if path := findProjectFile(dir, "api"); path != "" {
+		return readApplyProject(dir, path, utils.ProjectTypeApi, utils.MetaFileAPI)
+	}
+	if path := findProjectFile(dir, "api_product"); path != "" {
+		return readApplyProject(dir, path, utils.ProjectTypeApiProduct, utils.MetaFileAPIProduct)
+	}
+	if path := findProjectFile(dir, "application"); path != "" {
+		return readApplyProject(dir, path, utils.ProjectTypeApplication, utils.MetaFileApplication)
+	}
+	if path := findProjectFile(dir, filepath.Base(dir)); path != "" {
+		file, err := readProjectFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if fileType, _ := file["type"].(string); fileType != utils.SchemaTypeOperationPolicySpecification {
+			return nil, nil
+		}
+		policy, err := LoadOperationPolicy(dir)
+		if err != nil {
+			return nil, err
+		}
+		name := policy.Spec.Name
+		if name == "" {
+			name = policy.Name
+		}
+		return &ApplyProject{Type: utils.ProjectTypeAPIPolicy, Name: name, Version: policy.Spec.Version,
+			Path: dir}, nil
+	}
+	return nil, nil
+}
+
+// findProjectFile returns the path of the YAML or JSON file of a directory with the given name, if it exists
+func findProjectFile(dir, name string) string {
+	for _, extension := range []string{".yaml", ".yml", ".json"} {
+		path := filepath.Join(dir, name+extension)
+		if utils.IsFileExist(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+func readApplyProject(dir, definitionPath, projectType, metaFileName string) (*ApplyProject, error) {
+	file, err := readProjectFile(definitionPath)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	project := &ApplyProject{Type: projectType, Path: dir}
+	if projectType == utils.ProjectTypeApplication {
+		data, _ = data["applicationInfo"].(map[string]interface{})
+		project.Owner, _ = data["owner"].(string)
+	} else {
+		project.Version, _ = data["version"].(string)
+		project.Owner, _ = data["provider"].(string)
+	}
+	project.Name, _ = data["name"].(string)
+	if project.Name == "" {
+		return nil, errors.New("name is not defined in " + definitionPath)
+	}
+	project.Labels = artifactLabels(data)
+
+	metaFilePath := filepath.Join(dir, metaFileName)
+	if utils.IsFileExist(metaFilePath) {
+		content, err := ioutil.ReadFile(metaFilePath)
+		if err != nil {
+			return nil, err
+		}
+		project.MetaData = &utils.MetaData{}
+		if err = yaml.Unmarshal(content, project.MetaData); err != nil {
+			return nil, errors.New("error reading " + metaFilePath + ": " + err.Error())
+		}
+	}
+	return project, nil
+}
+
+// resolveApplyProjectParams returns the params of a project for an environment. The params of the params directory
+// are preferred over the params.yaml of the project. Params which do not include the environment are not used.
+func resolveApplyProjectParams(project *ApplyProject, paramsDir, environment string) string {
+	if project.Type != utils.ProjectTypeApi && project.Type != utils.ProjectTypeApiProduct {
+		return ""
+	}
+	if paramsDir != "" {
+		path := filepath.Join(paramsDir, project.RelativePath)
+		if exists, _ := utils.IsDirExists(path); exists {
+			if apiParams, err := params.LoadApiParamsFromDirectory(path); err == nil &&
+				apiParams.GetEnv(environment) != nil {
+				return path
+			}
+		}
+	}
+	path := filepath.Join(project.Path, utils.ParamFile)
+	if utils.IsFileExist(path) {
+		if apiParams, err := params.LoadApiParamsFromFile(path); err == nil && apiParams.GetEnv(environment) != nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// artifactLabels reads the labels of an artifact from its additional properties (APIs and API Products) or
+// attributes (applications)
+func artifactLabels(data map[string]interface{}) map[string]string {
+	labels := make(map[string]string)
+	addProperty := func(property interface{}) {
+		if entry, ok := property.(map[string]interface{}); ok {
+			if name, ok := entry["name"].(string); ok && entry["value"] != nil {
+				labels[name] = fmt.Sprint(entry["value"])
+			}
+		}
+	}
+	switch properties := data["additionalProperties"].(type) {
+	case []interface{}:
+		for _, property := range properties {
+			addProperty(property)
+		}
+	case map[string]interface{}:
+		for name, value := range properties {
+			labels[name] = fmt.Sprint(value)
+		}
+	}
+	if properties, ok := data["additionalPropertiesMap"].(map[string]interface{}); ok {
+		for _, property := range properties {
+			addProperty(property)
+		}
+	}
+	if attributes, ok := data["attributes"].(map[string]interface{}); ok {
+		for name, value := range attributes {
+			labels[name] = fmt.Sprint(value)
+		}
+	}
+	return labels
+}
+
+func matchesSelector(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func applyProjectRank(projectType string) int {
+	for rank, orderedType := range applyProjectOrder {
+		if orderedType == projectType {
+			return rank
+		}
+	}
+	return len(applyProjectOrder)
+}
+
+func applyArtifactKey(projectType, name, version string) string {
+	return projectType + "/" + name + "/" + version
+}
+
+// PlanProjectSync compares the projects of a directory with the artifacts of an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to apply the projects to
+// @param projects : Projects of the directory
+// @param prune : Delete the artifacts carrying the labels of the selector which are not in the directory
+// @param selector : Labels of the artifacts managed by the directory
+// @return changes in the order they are applied, error
+func PlanProjectSync(accessToken, environment string, projects []*ApplyProject, prune bool,
+	selector map[string]string) ([]ApplyChange, error) {
+	publisherEndpoint := utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath)
+	devPortalApplicationsEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment,
+		utils.MainConfigFilePath)
+	adminEndpoint := utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return planProjectSync(accessToken, publisherEndpoint, devPortalApplicationsEndpoint, adminEndpoint, projects,
+		prune, selector)
+}
+
+// ApplyProjectChanges executes the changes of a project sync plan. The changes of an artifact type are skipped
+// if the changes of the types it depends on have failed.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to apply the projects to
+// @param changes : Changes of the plan. The error of each failed change is set.
+// @return no. of failed changes
+func ApplyProjectChanges(accessToken, environment string, changes []ApplyChange) int {
+	publisherEndpoint := utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath)
+	devPortalApplicationsEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment,
+		utils.MainConfigFilePath)
+	adminEndpoint := utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return applyProjectChanges(accessToken, environment, publisherEndpoint, devPortalApplicationsEndpoint,
+		adminEndpoint, changes)
+}
+
+func planProjectSync(accessToken, publisherEndpoint, devPortalApplicationsEndpoint, adminEndpoint string,
+	projects []*ApplyProject, prune bool, selector map[string]string) ([]ApplyChange, error) {
+	var changes []ApplyChange
+	var throttlingPolicies []desiredThrottlingPolicy
+	throttlingProjects := make(map[string]*ApplyProject)
+	for _, project := range projects {
+		if project.Type == utils.ProjectTypeThrottlingPolicy {
+			throttlingPolicies = append(throttlingPolicies, *project.throttlingPolicy)
+			throttlingProjects[project.throttlingPolicy.Type+"/"+project.Name] = project
+		}
+	}
+	if len(throttlingPolicies) > 0 {
+		policyChanges, err := planThrottlingPolicySync(accessToken, utils.AppendSlashToString(adminEndpoint),
+			throttlingPolicies, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, policyChange := range policyChanges {
+			project := throttlingProjects[policyChange.Type+"/"+policyChange.Name]
+			changes = append(changes, ApplyChange{Action: policyChange.Action, Type: project.Type,
+				Name: project.Name, Version: project.Version, Path: project.RelativePath, Id: policyChange.Uuid,
+				project: project})
+		}
+	}
+
+	present := make(map[string]bool)
+	var operationPolicies []utils.APIPolicy
+	for _, project := range projects {
+		present[applyArtifactKey(project.Type, project.Name, project.Version)] = true
+		if project.Type == utils.ProjectTypeThrottlingPolicy {
+			continue
+		}
+		change := ApplyChange{Type: project.Type, Name: project.Name, Version: project.Version,
+			Owner: project.Owner, Path: project.RelativePath, project: project}
+		if project.Type == utils.ProjectTypeAPIPolicy && operationPolicies == nil {
+			var err error
+			if operationPolicies, err = listOperationPolicies(accessToken, publisherEndpoint); err != nil {
+				return nil, err
+			}
+		}
+		id, err := findApplyArtifactId(accessToken, publisherEndpoint, adminEndpoint, project, operationPolicies)
+		if err != nil {
+			return nil, err
+		}
+		change.Id = id
+		switch {
+		case id == "":
+			change.Action = ApplyActionCreate
+		case project.Type == utils.ProjectTypeAPIPolicy:
+			// Operation policies cannot be updated, a new version of the policy should be created instead
+			change.Action = ApplyActionUnchanged
+		default:
+			unchanged, err := isApplyArtifactUnchanged(accessToken, publisherEndpoint, devPortalApplicationsEndpoint,
+				project, id)
+			if err != nil {
+				return nil, err
+			}
+			if unchanged {
+				change.Action = ApplyActionUnchanged
+			} else {
+				change.Action = ApplyActionUpdate
+			}
+		}
+		if prune && !matchesSelector(project.Labels, selector) && project.Type != utils.ProjectTypeAPIPolicy {
+			utils.Logln(utils.LogPrefixWarning + project.Type + " " + project.Name + " does not carry the labels " +
+				"of the selector and will not be pruned when removed from the directory")
+		}
+		changes = append(changes, change)
+	}
+
+	if prune {
+		for _, projectType := range applyPrunableTypes {
+			artifacts, err := listApplyArtifacts(accessToken, publisherEndpoint, devPortalApplicationsEndpoint,
+				projectType, selector)
+			if err != nil {
+				return nil, err
+			}
+			for _, artifact := range artifacts {
+				if present[applyArtifactKey(artifact.Type, artifact.Name, artifact.Version)] {
+					continue
+				}
+				changes = append(changes, ApplyChange{Action: ApplyActionDelete, Type: artifact.Type,
+					Name: artifact.Name, Version: artifact.Version, Owner: artifact.Owner, Id: artifact.Id})
+			}
+		}
+	}
+	return changes, nil
+}
+
+func applyProjectChanges(accessToken, environment, publisherEndpoint, devPortalApplicationsEndpoint,
+	adminEndpoint string, changes []ApplyChange) int {
+	failed := 0
+	failedTypes := make(map[string]bool)
+	for i := range changes {
+		change := &changes[i]
+		if change.Action != ApplyActionDelete && change.Action != ApplyActionUnchanged {
+			for _, dependency := range applyProjectOrder[:applyProjectRank(change.Type)] {
+				if failedTypes[dependency] {
+					change.Err = errors.New("skipped as applying a " + dependency + " failed")
+					break
+				}
+			}
+		}
+		if change.Err == nil {
+			switch change.Action {
+			case ApplyActionCreate, ApplyActionUpdate:
+				if change.Type == utils.ProjectTypeThrottlingPolicy {
+					change.Err = uploadThrottlingPolicy(accessToken, utils.AppendSlashToString(adminEndpoint),
+						change.project.Path, change.Action == ApplyActionUpdate)
+				} else {
+					change.Err = applyProject(accessToken, environment, publisherEndpoint,
+						devPortalApplicationsEndpoint, change.project)
+				}
+			case ApplyActionDelete:
+				change.Err = deleteApplyArtifact(accessToken, publisherEndpoint, adminEndpoint, *change)
+			}
+		}
+		if change.Err != nil {
+			failedTypes[change.Type] = true
+			failed++
+		}
+	}
+	return failed
+}
+
+// getApplyResource returns a resource of the REST APIs as JSON
+func getApplyResource(accessToken, url, action string) (map[string]interface{}, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(url, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, action)
+	}
+	body := make(map[string]interface{})
+	err = json.Unmarshal(resp.Body(), &body)
+	return body, err
+}
+
+// listOperationPolicies returns the common operation policies of the environment
+func listOperationPolicies(accessToken, publisherEndpoint string) ([]utils.APIPolicy, error) {
+	resp, err := getAPIPolicyList(accessToken, publisherEndpoint, applyPruneListLimit)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the operation policies")
+	}
+	var policyList utils.APIPoliciesList
+	if err = json.Unmarshal(resp.Body(), &policyList); err != nil {
+		return nil, err
+	}
+	return policyList.List, nil
+}
+
+// findApplyArtifactId returns the id of the artifact of a project in the environment
+// @return id, or an empty string if the artifact does not exist
+func findApplyArtifactId(accessToken, publisherEndpoint, adminEndpoint string, project *ApplyProject,
+	operationPolicies []utils.APIPolicy) (string, error) {
+	var id string
+	var err error
+	switch project.Type {
+	case utils.ProjectTypeApi:
+		id, err = getAPIId(accessToken, publisherEndpoint+"/search", project.Name, project.Version, "")
+	case utils.ProjectTypeApiProduct:
+		id, err = getAPIProductId(accessToken, publisherEndpoint+"/search", project.Name, project.Version, "")
+	case utils.ProjectTypeApplication:
+		owner := ""
+		if project.MetaData != nil && project.MetaData.DeployConfig.Import.PreserveOwner {
+			owner = project.Owner
+		}
+		id, err = getAppId(accessToken, adminEndpoint+"/applications", project.Name, owner)
+	case utils.ProjectTypeAPIPolicy:
+		for _, policy := range operationPolicies {
+			if policy.Name == project.Name && policy.Version == project.Version {
+				return policy.Id, nil
+			}
+		}
+	}
+	if IsArtifactNotFound(err) {
+		return "", nil
+	}
+	return id, err
+}
+
+// isApplyArtifactUnchanged returns whether the artifact with the given id in the environment matches the project
+func isApplyArtifactUnchanged(accessToken, publisherEndpoint, devPortalApplicationsEndpoint string,
+	project *ApplyProject, id string) (bool, error) {
+	switch project.Type {
+	case utils.ProjectTypeApi, utils.ProjectTypeApiProduct:
+		if project.ParamsPath != "" {
+			// The params are applied by the server when importing, hence the project cannot be compared
+			return false, nil
+		}
+		resource := "/apis/"
+		if project.Type == utils.ProjectTypeApiProduct {
+			resource = "/api-products/"
+		}
+		local, deployments, err := readApplyProjectData(project)
+		if err != nil {
+			return false, err
+		}
+		remote, err := getApplyResource(accessToken, publisherEndpoint+resource+id, "retrieving the "+
+			project.Type+" "+project.Name)
+		if err != nil {
+			return false, err
+		}
+		if !artifactDataMatches(local, remote) {
+			return false, nil
+		}
+		if deployments == nil {
+			return true, nil
+		}
+		_, revisions, err := GetRevisionsList(accessToken,
+			publisherEndpoint+resource+id+"/revisions?query=deployed:true")
+		if err != nil {
+			return false, err
+		}
+		var deployed []string
+		for _, revision := range revisions {
+			for _, deployment := range revision.Deployments {
+				deployed = append(deployed, deployment.Name)
+			}
+		}
+		return sameStringSet(deployments, deployed), nil
+	case utils.ProjectTypeApplication:
+		file, err := readProjectFile(findProjectFile(project.Path, "application"))
+		if err != nil {
+			return false, err
+		}
+		local, _ := file["data"].(map[string]interface{})
+		remote, err := getApplyResource(accessToken, devPortalApplicationsEndpoint+"/"+id,
+			"retrieving the application "+project.Name)
+		if err != nil {
+			return false, err
+		}
+		info, _ := local["applicationInfo"].(map[string]interface{})
+		if !applicationInfoMatches(info, remote) {
+			return false, nil
+		}
+		if project.MetaData != nil && project.MetaData.DeployConfig.Import.SkipSubscriptions {
+			return true, nil
+		}
+		subscriptions, err := getApplyResource(accessToken, strings.Replace(devPortalApplicationsEndpoint,
+			"applications", "subscriptions", 1)+"?applicationId="+id+"&limit="+applyPruneListLimit,
+			"retrieving the subscriptions of the application "+project.Name)
+		if err != nil {
+			return false, err
+		}
+		return sameStringSet(localSubscriptions(local), remoteSubscriptions(subscriptions)), nil
+	}
+	return false, nil
+}
+
+// applyProject imports the project to the environment, updating the artifact if it exists
+func applyProject(accessToken, environment, publisherEndpoint, devPortalApplicationsEndpoint string,
+	project *ApplyProject) error {
+	importConfig := utils.ImportConfig{PreserveProvider: true}
+	if project.MetaData != nil {
+		importConfig = project.MetaData.DeployConfig.Import
+	}
+	switch project.Type {
+	case utils.ProjectTypeAPIPolicy:
+		return importAPIPolicy(publisherEndpoint+"/operation-policies/import", project.Path, accessToken, true)
+	case utils.ProjectTypeApi:
+		return ImportAPI(accessToken, publisherEndpoint, environment, project.Path, project.ParamsPath, true,
+			importConfig.PreserveProvider, false, importConfig.RotateRevision, false, false, "", nil)
+	case utils.ProjectTypeApiProduct:
+		return ImportAPIProduct(accessToken, publisherEndpoint, environment, project.Path, project.ParamsPath, false,
+			false, true, importConfig.PreserveProvider, false, importConfig.RotateRevision, false, nil)
+	case utils.ProjectTypeApplication:
+		_, err := ImportApplication(accessToken, devPortalApplicationsEndpoint, project.Path, "", true,
+			importConfig.PreserveOwner, importConfig.SkipSubscriptions, importConfig.SkipKeys, false, nil, nil)
+		return err
+	}
+	return errors.New("unknown project type " + project.Type)
+}
+
+// listApplyArtifacts returns the artifacts of a type in the environment carrying the labels of the selector
+func listApplyArtifacts(accessToken, publisherEndpoint, devPortalApplicationsEndpoint, projectType string,
+	selector map[string]string) ([]applyArtifact, error) {
+	var artifacts []applyArtifact
+	switch projectType {
+	case utils.ProjectTypeApi:
+		_, apis, err := GetAPIList(accessToken, publisherEndpoint+"/apis", "", applyPruneListLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, api := range apis {
+			data, err := getApplyResource(accessToken, publisherEndpoint+"/apis/"+api.ID,
+				"retrieving the API "+api.Name)
+			if err != nil {
+				return nil, err
+			}
+			if matchesSelector(artifactLabels(data), selector) {
+				artifacts = append(artifacts, applyArtifact{Type: projectType, Id: api.ID, Name: api.Name,
+					Version: api.Version, Owner: api.Provider})
+			}
+		}
+	case utils.ProjectTypeApiProduct:
+		_, apiProducts, err := GetAPIProductList(accessToken, publisherEndpoint+"/search", "", applyPruneListLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, apiProduct := range apiProducts {
+			data, err := getApplyResource(accessToken, publisherEndpoint+"/api-products/"+apiProduct.ID,
+				"retrieving the API Product "+apiProduct.Name)
+			if err != nil {
+				return nil, err
+			}
+			if matchesSelector(artifactLabels(data), selector) {
+				artifacts = append(artifacts, applyArtifact{Type: projectType, Id: apiProduct.ID,
+					Name: apiProduct.Name, Version: apiProduct.Version, Owner: apiProduct.Provider})
+			}
+		}
+	case utils.ProjectTypeApplication:
+		// Only the applications of the user are listed by the DevPortal along with their attributes
+		body, err := getApplyResource(accessToken, devPortalApplicationsEndpoint+"?limit="+applyPruneListLimit,
+			"retrieving the applications")
+		if err != nil {
+			return nil, err
+		}
+		list, _ := body["list"].([]interface{})
+		for _, item := range list {
+			app, _ := item.(map[string]interface{})
+			if app == nil || !matchesSelector(artifactLabels(app), selector) {
+				continue
+			}
+			artifact := applyArtifact{Type: projectType}
+			artifact.Id, _ = app["applicationId"].(string)
+			artifact.Name, _ = app["name"].(string)
+			artifact.Owner, _ = app["owner"].(string)
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts, nil
+}
+
+// deleteApplyArtifact deletes the artifact of a change from the environment
+func deleteApplyArtifact(accessToken, publisherEndpoint, adminEndpoint string, change ApplyChange) error {
+	var url string
+	switch change.Type {
+	case utils.ProjectTypeApi:
+		url = publisherEndpoint + "/apis/" + change.Id
+	case utils.ProjectTypeApiProduct:
+		url = publisherEndpoint + "/api-products/" + change.Id
+	case utils.ProjectTypeApplication:
+		url = adminEndpoint + "/applications/" + change.Id
+	default:
+		return errors.New(change.Type + " cannot be deleted")
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(url, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting "+change.Type+" "+change.Name)
+	}
+	return nil
+}
+
+// applyServerFields are the fields of an artifact which are set by the server and are not compared when planning
+var applyServerFields = map[string]bool{
+	"id":                     true,
+	"createdTime":            true,
+	"lastUpdatedTime":        true,
+	"lastUpdatedTimestamp":   true,
+	"isRevision":             true,
+	"revisionId":             true,
+	"revisionedApiId":        true,
+	"revisionedApiProductId": true,
+	"workflowStatus":         true,
+	"hasThumbnail":           true,
+}
+
+// readApplyProjectData reads the definition of an API or API Product project as it would be imported to the
+// environment, i.e. with the environment variables substituted. The names of the gateway environments of the project
+// are returned as well, or nil if the project does not define them.
+func readApplyProjectData(project *ApplyProject) (map[string]interface{}, []string, error) {
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(project.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(filepath.Dir(tmpPath))
+	if err = replaceEnvVariables(tmpPath); err != nil {
+		return nil, nil, err
+	}
+	definitionName := "api"
+	if project.Type == utils.ProjectTypeApiProduct {
+		definitionName = "api_product"
+	}
+	file, err := readProjectFile(findProjectFile(tmpPath, definitionName))
+	if err != nil {
+		return nil, nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+
+	deploymentEnvPath := filepath.Join(tmpPath, utils.DeploymentEnvFile)
+	if !utils.IsFileExist(deploymentEnvPath) {
+		return data, nil, nil
+	}
+	deploymentEnvFile, err := readProjectFile(deploymentEnvPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	deployments := []string{}
+	deploymentEnvs, _ := deploymentEnvFile["data"].([]interface{})
+	for _, deploymentEnv := range deploymentEnvs {
+		if entry, ok := deploymentEnv.(map[string]interface{}); ok {
+			if name, ok := entry["deploymentEnvironment"].(string); ok {
+				deployments = append(deployments, name)
+			}
+		}
+	}
+	return data, deployments, nil
+}
+
+// artifactDataMatches returns whether the fields given in the local definition of an artifact have the same values
+// in the artifact of the environment. The fields which are not given locally take the values of the server, hence
+// they are not compared, and neither are the fields set by the server.
+func artifactDataMatches(local, remote interface{}) bool {
+	switch localValue := local.(type) {
+	case map[string]interface{}:
+		remoteMap, _ := remote.(map[string]interface{})
+		for key, value := range localValue {
+			if applyServerFields[key] {
+				continue
+			}
+			remoteValue, exists := remoteMap[key]
+			if !exists && isEmptyApplyValue(value) {
+				continue
+			}
+			if !artifactDataMatches(value, remoteValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		remoteList, _ := remote.([]interface{})
+		if len(localValue) != len(remoteList) {
+			return false
+		}
+		for i := range localValue {
+			if !artifactDataMatches(localValue[i], remoteList[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if isEmptyApplyValue(local) && isEmptyApplyValue(remote) {
+		return true
+	}
+	return normalizeApplyValue(local) == normalizeApplyValue(remote)
+}
+
+// isEmptyApplyValue returns whether a value of a definition is empty, such as an empty list or string
+func isEmptyApplyValue(value interface{}) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typedValue == ""
+	case []interface{}:
+		return len(typedValue) == 0
+	case map[string]interface{}:
+		return len(typedValue) == 0
+	}
+	return false
+}
+
+// normalizeApplyValue formats a scalar value of a definition so that the numbers read from the project files and
+// from the REST APIs are compared by their values
+func normalizeApplyValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if number, err := typedValue.Float64(); err == nil {
+			return strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		return typedValue.String()
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// applicationInfoMatches compares the application information of an application project with the application of the
+// environment. The exported application information names the throttling policy as the tier.
+func applicationInfoMatches(local, remote map[string]interface{}) bool {
+	policy := local["throttlingPolicy"]
+	if policy == nil {
+		policy = local["tier"]
+	}
+	return artifactDataMatches(map[string]interface{}{
+		"name":             local["name"],
+		"description":      local["description"],
+		"tokenType":        local["tokenType"],
+		"throttlingPolicy": policy,
+		"attributes":       local["attributes"],
+	}, remote)
+}
+
+// localSubscriptions returns the subscriptions of an application project as name:version of the APIs
+func localSubscriptions(data map[string]interface{}) []string {
+	subscriptions := []string{}
+	subscribedAPIs, _ := data["subscribedAPIs"].([]interface{})
+	for _, subscribedAPI := range subscribedAPIs {
+		subscription, _ := subscribedAPI.(map[string]interface{})
+		apiId, _ := subscription["apiId"].(map[string]interface{})
+		name, _ := apiId["apiName"].(string)
+		version, _ := apiId["version"].(string)
+		subscriptions = append(subscriptions, name+":"+version)
+	}
+	return subscriptions
+}
+
+// remoteSubscriptions returns the subscriptions of a subscription list of the devportal as name:version of the APIs
+func remoteSubscriptions(body map[string]interface{}) []string {
+	subscriptions := []string{}
+	list, _ := body["list"].([]interface{})
+	for _, item := range list {
+		subscription, _ := item.(map[string]interface{})
+		apiInfo, _ := subscription["apiInfo"].(map[string]interface{})
+		name, _ := apiInfo["name"].(string)
+		version, _ := apiInfo["version"].(string)
+		subscriptions = append(subscriptions, name+":"+version)
+	}
+	return subscriptions
+}
+
+// sameStringSet returns whether two lists have the same distinct values
+func sameStringSet(first, second []string) bool {
+	firstSet := make(map[string]bool)
+	for _, value := range first {
+		firstSet[value] = true
+	}
+	secondSet := make(map[string]bool)
+	for _, value := range second {
+		if !firstSet[value] {
+			return false
+		}
+		secondSet[value] = true
+	}
+	return len(firstSet) == len(secondSet)
+}
+
+// applyChangeRow holds a change of a project sync for outputting
+type applyChangeRow struct {
+	change ApplyChange
+}
+
+// Action of the change
+func (r applyChangeRow) Action() string {
+	return r.change.Action
+}
+
+// Type of the artifact
+func (r applyChangeRow) Type() string {
+	return r.change.Type
+}
+
+// Name of the artifact
+func (r applyChangeRow) Name() string {
+	return r.change.Name
+}
+
+// Version of the artifact, or the type of a throttling policy
+func (r applyChangeRow) Version() string {
+	return r.change.Version
+}
+
+// Path of the project
+func (r applyChangeRow) Path() string {
+	return r.change.Path
+}
+
+// Result of the change
+func (r applyChangeRow) Result() string {
+	if r.change.Err != nil {
+		return "FAILED: " + r.change.Err.Error()
+	}
+	if r.change.Action == ApplyActionUnchanged {
+		return "-"
+	}
+	return "OK"
+}
+
+// MarshalJSON marshals the change using custom marshaller which uses methods instead of fields
+func (r *applyChangeRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintApplyPlan prints the changes of a project sync plan
+func PrintApplyPlan(changes []ApplyChange) {
+	printApplyChanges(changes, applyPlanTableFormat)
+}
+
+// PrintApplyResults prints the outcome of the changes of a project sync
+func PrintApplyResults(changes []ApplyChange) {
+	printApplyChanges(changes, applyResultTableFormat)
+}
+
+func printApplyChanges(changes []ApplyChange, format string) {
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, change := range changes {
+			if err := t.Execute(w, &applyChangeRow{change}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Action":  applyActionHeader,
+		"Type":    applyTypeHeader,
+		"Name":    applyNameHeader,
+		"Version": applyVersionHeader,
+		"Path":    applyPathHeader,
+		"Result":  applyResultHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,360 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func writeApplyTestProjects(t *testing.T, dir string) {
+	writeTestFile(t, filepath.Join(dir, "apis", "PizzaAPI", "api.yaml"), `type: api
+version: v4.1.0
+data:
+  name: PizzaAPI
+  version: 1.0.0
+  provider: admin
+  additionalProperties:
+    - name: team
+      value: payments
+      display: false
+`)
+	writeTestFile(t, filepath.Join(dir, "apis", "PizzaAPI", utils.ParamFile), `environments:
+  - name: production
+    configs:
+      endpoints:
+        production:
+          url: https://pizza.example.com
+`)
+	// Operation policies of an API project are applied with the API
+	assert.Nil(t, InitOperationPolicy(filepath.Join(dir, "apis", "PizzaAPI", "Policies", "AddHeader"),
+		[]string{PolicyGatewaySynapse}, []string{"request"}, false))
+	writeTestFile(t, filepath.Join(dir, "products", "FoodProduct", "api_product.json"),
+		`{"type": "api_product", "data": {"name": "FoodProduct", "version": "1.0.0", "provider": "admin"}}`)
+	writeTestFile(t, filepath.Join(dir, "apps", "PizzaApp", "application.yaml"), `type: application
+data:
+  applicationInfo:
+    name: PizzaApp
+    owner: devops
+    attributes:
+      team: payments
+`)
+	writeTestFile(t, filepath.Join(dir, "apps", "PizzaApp", utils.MetaFileApplication), `deploy:
+  import:
+    preserveOwner: true
+`)
+	assert.Nil(t, InitOperationPolicy(filepath.Join(dir, "policies", "RemoveHeader"), []string{PolicyGatewaySynapse},
+		[]string{"request"}, false))
+	writeTestFile(t, filepath.Join(dir, "throttling", "Gold.yaml"), testSubscriptionPolicyFile)
+	writeTestFile(t, filepath.Join(dir, ".git", "api.yaml"), "type: api\ndata:\n  name: Ignored\n")
+	writeTestFile(t, filepath.Join(dir, "README.md"), "artifacts")
+}
+
+func TestDiscoverApplyProjects(t *testing.T) {
+	dir := t.TempDir()
+	writeApplyTestProjects(t, dir)
+
+	projects, err := DiscoverApplyProjects(dir, "", "production")
+	assert.Nil(t, err)
+	assert.Len(t, projects, 5)
+
+	assert.Equal(t, utils.ProjectTypeThrottlingPolicy, projects[0].Type)
+	assert.Equal(t, "Gold", projects[0].Name)
+	assert.Equal(t, CmdPolicyTypeSubscription, projects[0].Version)
+
+	assert.Equal(t, utils.ProjectTypeAPIPolicy, projects[1].Type)
+	assert.Equal(t, "RemoveHeader", projects[1].Name)
+	assert.Equal(t, filepath.Join("policies", "RemoveHeader"), projects[1].RelativePath)
+
+	assert.Equal(t, utils.ProjectTypeApi, projects[2].Type)
+	assert.Equal(t, "PizzaAPI", projects[2].Name)
+	assert.Equal(t, "1.0.0", projects[2].Version)
+	assert.Equal(t, "admin", projects[2].Owner)
+	assert.Equal(t, map[string]string{"team": "payments"}, projects[2].Labels)
+	assert.Equal(t, filepath.Join(dir, "apis", "PizzaAPI", utils.ParamFile), projects[2].ParamsPath)
+
+	assert.Equal(t, utils.ProjectTypeApiProduct, projects[3].Type)
+	assert.Equal(t, "FoodProduct", projects[3].Name)
+	assert.Equal(t, "", projects[3].ParamsPath)
+
+	assert.Equal(t, utils.ProjectTypeApplication, projects[4].Type)
+	assert.Equal(t, "PizzaApp", projects[4].Name)
+	assert.Equal(t, "devops", projects[4].Owner)
+	assert.Equal(t, map[string]string{"team": "payments"}, projects[4].Labels)
+	assert.True(t, projects[4].MetaData.DeployConfig.Import.PreserveOwner)
+}
+
+func TestDiscoverApplyProjectsParams(t *testing.T) {
+	dir := t.TempDir()
+	writeApplyTestProjects(t, dir)
+	paramsDir := t.TempDir()
+	writeTestFile(t, filepath.Join(paramsDir, "apis", "PizzaAPI", utils.ParamFile), `environments:
+  - name: production
+    configs:
+      endpoints:
+        production:
+          url: https://pizza.internal.example.com
+`)
+
+	projects, err := DiscoverApplyProjects(dir, paramsDir, "production")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(paramsDir, "apis", "PizzaAPI"), projects[2].ParamsPath)
+
+	// Params which do not include the environment are not used
+	projects, err = DiscoverApplyProjects(dir, paramsDir, "dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "", projects[2].ParamsPath)
+}
+
+func TestDiscoverApplyProjectsDuplicate(t *testing.T) {
+	dir := t.TempDir()
+	content := "type: api\ndata:\n  name: PizzaAPI\n  version: 1.0.0\n"
+	writeTestFile(t, filepath.Join(dir, "PizzaAPI", "api.yaml"), content)
+	writeTestFile(t, filepath.Join(dir, "copy", "PizzaAPI", "api.yaml"), content)
+
+	_, err := DiscoverApplyProjects(dir, "", "production")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is defined in both")
+}
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelector("team=payments, tier = gold")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "tier": "gold"}, selector)
+
+	_, err = ParseSelector("team")
+	assert.NotNil(t, err)
+	_, err = ParseSelector(" , ")
+	assert.NotNil(t, err)
+}
+
+func TestArtifactLabels(t *testing.T) {
+	assert.Equal(t, map[string]string{"team": "payments", "cost": "12"}, artifactLabels(map[string]interface{}{
+		"additionalPropertiesMap": map[string]interface{}{
+			"team": map[string]interface{}{"name": "team", "value": "payments"},
+		},
+		"attributes": map[string]interface{}{"cost": 12},
+	}))
+	assert.Equal(t, map[string]string{"team": "payments"}, artifactLabels(map[string]interface{}{
+		"additionalProperties": map[string]interface{}{"team": "payments"},
+	}))
+	assert.True(t, matchesSelector(map[string]string{"team": "payments", "tier": "gold"},
+		map[string]string{"team": "payments"}))
+	assert.False(t, matchesSelector(map[string]string{"team": "orders"}, map[string]string{"team": "payments"}))
+}
+
+func TestPlanAndApplyProjectSync(t *testing.T) {
+	dir := t.TempDir()
+	writeApplyTestProjects(t, dir)
+	projects, err := DiscoverApplyProjects(dir, "", "production")
+	assert.Nil(t, err)
+
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /api/am/admin/v4/throttling/policies/search":
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		case "GET /api/am/publisher/v4/operation-policies":
+			_, _ = w.Write([]byte(`{"count": 1, "list": [{"id": "remove-header", "name": "RemoveHeader", "version": "` +
+				utils.MigrateProjectPolicyVersion + `"}]}`))
+		case "GET /api/am/publisher/v4/search":
+			if strings.Contains(r.URL.Query().Get("query"), `name:"PizzaAPI"`) {
+				_, _ = w.Write([]byte(`{"count": 1, "list": [{"id": "pizza-api", "name": "PizzaAPI"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+			}
+		case "GET /api/am/admin/v4/applications":
+			assert.Equal(t, "PizzaApp", r.URL.Query().Get("name"))
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		case "GET /api/am/publisher/v4/apis":
+			_, _ = w.Write([]byte(`{"count": 2, "list": [
+				{"id": "pizza-api", "name": "PizzaAPI", "version": "1.0.0", "provider": "admin"},
+				{"id": "pasta-api", "name": "PastaAPI", "version": "1.0.0", "provider": "admin"}]}`))
+		case "GET /api/am/publisher/v4/apis/pizza-api":
+			// The PizzaAPI of the environment does not have the team label of the project
+			_, _ = w.Write([]byte(`{"id": "pizza-api", "name": "PizzaAPI", "version": "1.0.0", "provider": "admin"}`))
+		case "GET /api/am/publisher/v4/apis/pasta-api":
+			_, _ = w.Write([]byte(`{"id": "pasta-api", "name": "PastaAPI", "version": "1.0.0",
+				"additionalPropertiesMap": {"team": {"name": "team", "value": "payments"}}}`))
+		case "GET /api/am/devportal/v3/applications":
+			_, _ = w.Write([]byte(`{"count": 1, "list": [{"applicationId": "pasta-app", "name": "PastaApp",
+				"owner": "devops", "attributes": {"team": "payments"}}]}`))
+		case "POST /api/am/admin/v4/throttling/policies/import",
+			"POST /api/am/publisher/v4/apis/import",
+			"POST /api/am/publisher/v4/api-products/import",
+			"POST /api/am/devportal/v3/applications/import",
+			"DELETE /api/am/admin/v4/applications/pasta-app",
+			"DELETE /api/am/publisher/v4/apis/pasta-api":
+			calls = append(calls, call)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	publisherEndpoint := server.URL + "/api/am/publisher/v4"
+	devPortalApplicationsEndpoint := server.URL + "/api/am/devportal/v3/applications"
+	adminEndpoint := server.URL + "/api/am/admin/v4"
+
+	changes, err := planProjectSync("access-token", publisherEndpoint, devPortalApplicationsEndpoint, adminEndpoint,
+		projects, false, nil)
+	assert.Nil(t, err)
+	var actions []string
+	for _, change := range changes {
+		actions = append(actions, change.Action+" "+change.Name)
+	}
+	assert.Equal(t, []string{"create Gold", "unchanged RemoveHeader", "update PizzaAPI", "create FoodProduct",
+		"create PizzaApp"}, actions)
+
+	changes, err = planProjectSync("access-token", publisherEndpoint, devPortalApplicationsEndpoint, adminEndpoint,
+		projects, true, map[string]string{"team": "payments"})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 7)
+	assert.Equal(t, ApplyActionDelete, changes[5].Action)
+	assert.Equal(t, "PastaApp", changes[5].Name)
+	assert.Equal(t, ApplyActionDelete, changes[6].Action)
+	assert.Equal(t, "PastaAPI", changes[6].Name)
+
+	failed := applyProjectChanges("access-token", "production", publisherEndpoint, devPortalApplicationsEndpoint,
+		adminEndpoint, changes)
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, []string{
+		"POST /api/am/admin/v4/throttling/policies/import",
+		"POST /api/am/publisher/v4/apis/import",
+		"POST /api/am/publisher/v4/api-products/import",
+		"POST /api/am/devportal/v3/applications/import",
+		"DELETE /api/am/admin/v4/applications/pasta-app",
+		"DELETE /api/am/publisher/v4/apis/pasta-api",
+	}, calls)
+}
+
+func TestPlanProjectSyncUnchangedAPI(t *testing.T) {
+	dir := t.TempDir()
+	writeApplyTestProjects(t, dir)
+	// The params of the PizzaAPI are applied by the server, hence the API is compared only without them
+	projects, err := DiscoverApplyProjects(dir, "", "dev")
+	assert.Nil(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/am/admin/v4/throttling/policies/search", "GET /api/am/publisher/v4/operation-policies",
+			"GET /api/am/admin/v4/applications":
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		case "GET /api/am/publisher/v4/search":
+			if strings.Contains(r.URL.Query().Get("query"), `name:"PizzaAPI"`) {
+				_, _ = w.Write([]byte(`{"count": 1, "list": [{"id": "pizza-api", "name": "PizzaAPI"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+			}
+		case "GET /api/am/publisher/v4/apis/pizza-api":
+			_, _ = w.Write([]byte(`{"id": "pizza-api", "name": "PizzaAPI", "version": "1.0.0", "provider": "admin",
+				"lastUpdatedTime": "1700000000000", "additionalProperties": [
+					{"name": "team", "value": "payments", "display": false}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	changes, err := planProjectSync("access-token", server.URL+"/api/am/publisher/v4",
+		server.URL+"/api/am/devportal/v3/applications", server.URL+"/api/am/admin/v4", projects, false, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "PizzaAPI", changes[2].Name)
+	assert.Equal(t, ApplyActionUnchanged, changes[2].Action)
+}
+
+func TestArtifactDataMatches(t *testing.T) {
+	local := map[string]interface{}{
+		"id":          "local-id",
+		"name":        "PizzaAPI",
+		"description": "",
+		"policies":    []interface{}{"Unlimited"},
+		"maxTps":      map[string]interface{}{"production": json.Number("1000")},
+	}
+	remote := map[string]interface{}{
+		"id":              "remote-id",
+		"name":            "PizzaAPI",
+		"policies":        []interface{}{"Unlimited"},
+		"maxTps":          map[string]interface{}{"production": float64(1000)},
+		"lastUpdatedTime": "1700000000000",
+	}
+	assert.True(t, artifactDataMatches(local, remote))
+
+	remote["policies"] = []interface{}{"Unlimited", "Gold"}
+	assert.False(t, artifactDataMatches(local, remote))
+
+	remote["policies"] = []interface{}{"Unlimited"}
+	remote["maxTps"] = map[string]interface{}{"production": float64(500)}
+	assert.False(t, artifactDataMatches(local, remote))
+}
+
+func TestApplyProjectChangesSkipsDependents(t *testing.T) {
+	dir := t.TempDir()
+	writeApplyTestProjects(t, dir)
+	projects, err := DiscoverApplyProjects(dir, "", "production")
+	assert.Nil(t, err)
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /api/am/admin/v4/throttling/policies/search", "GET /api/am/publisher/v4/operation-policies",
+			"GET /api/am/publisher/v4/search", "GET /api/am/admin/v4/applications":
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		case "POST /api/am/admin/v4/throttling/policies/import",
+			"POST /api/am/publisher/v4/operation-policies/import":
+			calls = append(calls, call)
+			_, _ = w.Write([]byte(`{}`))
+		case "POST /api/am/publisher/v4/apis/import":
+			calls = append(calls, call)
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	publisherEndpoint := server.URL + "/api/am/publisher/v4"
+	devPortalApplicationsEndpoint := server.URL + "/api/am/devportal/v3/applications"
+	adminEndpoint := server.URL + "/api/am/admin/v4"
+
+	changes, err := planProjectSync("access-token", publisherEndpoint, devPortalApplicationsEndpoint, adminEndpoint,
+		projects, false, nil)
+	assert.Nil(t, err)
+	failed := applyProjectChanges("access-token", "production", publisherEndpoint, devPortalApplicationsEndpoint,
+		adminEndpoint, changes)
+	assert.Equal(t, 3, failed)
+	assert.Equal(t, []string{
+		"POST /api/am/admin/v4/throttling/policies/import",
+		"POST /api/am/publisher/v4/operation-policies/import",
+		"POST /api/am/publisher/v4/apis/import",
+	}, calls)
+	assert.NotNil(t, changes[2].Err)
+	assert.Contains(t, changes[3].Err.Error(), "skipped as applying a "+utils.ProjectTypeApi+" failed")
+	assert.Contains(t, changes[4].Err.Error(), "skipped")
+}
@@ -0,0 +1,442 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Actions of a throttling policy sync plan
+const (
+	ThrottlingPolicyActionCreate    = "create"
+	ThrottlingPolicyActionUpdate    = "update"
+	ThrottlingPolicyActionDelete    = "delete"
+	ThrottlingPolicyActionUnchanged = "unchanged"
+)
+
+const throttlingPolicyFileType = "throttling policy"
+
+const (
+	throttlingPolicyActionHeader = "ACTION"
+	throttlingPolicyTypeHeader   = "TYPE"
+	throttlingPolicyNameHeader   = "NAME"
+	throttlingPolicyFileHeader   = "FILE"
+	throttlingPolicyResultHeader = "RESULT"
+
+	throttlingPolicyPlanTableFormat   = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.File}}"
+	throttlingPolicyResultTableFormat = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.Result}}"
+)
+
+// Fields of a throttling policy generated by the server, which are ignored when comparing policies
+var generatedThrottlingPolicyFields = []string{"policyId", "uuid", "isDeployed", "type"}
+
+// Policies which always exist in an environment and cannot be deleted
+var protectedThrottlingPolicies = map[string]bool{"Unlimited": true}
+
+// ThrottlingPolicyChange is an action needed to converge a throttling policy to the desired state
+type ThrottlingPolicyChange struct {
+	Action string
+	Type   string
+	Name   string
+	File   string
+	Uuid   string
+	Err    error
+}
+
+// desiredThrottlingPolicy is a throttling policy read from a file of the desired state directory
+type desiredThrottlingPolicy struct {
+	Type string
+	Name string
+	File string
+	Data map[string]interface{}
+}
+
+// PlanThrottlingPolicySync compares the throttling policies of a directory with the ones in an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to sync the throttling policies to
+// @param dir : Directory of the exported throttling policy files
+// @param prune : Delete the throttling policies of the environment which are not in the directory
+// @return changes, error
+func PlanThrottlingPolicySync(accessToken, environment, dir string, prune bool) ([]ThrottlingPolicyChange, error) {
+	desired, err := readDesiredThrottlingPolicies(dir)
+	if err != nil {
+		return nil, err
+	}
+	adminEndpoint := utils.AppendSlashToString(utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath))
+	return planThrottlingPolicySync(accessToken, adminEndpoint, desired, prune)
+}
+
+// ApplyThrottlingPolicyChanges executes the changes of a throttling policy sync plan
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to sync the throttling policies to
+// @param changes : Changes of the plan. The error of each failed change is set.
+// @return no. of failed changes
+func ApplyThrottlingPolicyChanges(accessToken, environment string, changes []ThrottlingPolicyChange) int {
+	adminEndpoint := utils.AppendSlashToString(utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath))
+	return applyThrottlingPolicyChanges(accessToken, adminEndpoint, changes)
+}
+
+// listThrottlingPolicies retrieves the throttling policies of all the types of an environment
+func listThrottlingPolicies(accessToken, adminEndpoint string) ([]utils.ThrottlingPolicyDetails, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(adminEndpoint+"throttling/policies/search", headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the throttling policies")
+	}
+	var policyList utils.ThrottlingPoliciesDetailsList
+	if err = json.Unmarshal(resp.Body(), &policyList); err != nil {
+		return nil, err
+	}
+	return policyList.List, nil
+}
+
+// exportThrottlingPolicyData exports a throttling policy of an environment and returns the data of the policy file
+func exportThrottlingPolicyData(accessToken, adminEndpoint, policyType, name string) (map[string]interface{},
+	error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	queryParams := map[string]string{"name": name, "type": throttlingPolicyQueryTypes[policyType], "format": "JSON"}
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, adminEndpoint+"throttling/policies/export",
+		headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "exporting the throttling policy "+name)
+	}
+	file := make(map[string]interface{})
+	if err = json.Unmarshal(resp.Body(), &file); err != nil {
+		return nil, err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	return data, nil
+}
+
+// uploadThrottlingPolicy imports a throttling policy file to an environment, updating the existing policy if update
+// is set
+func uploadThrottlingPolicy(accessToken, adminEndpoint, path string, update bool) error {
+	resp, err := executeThrottlingPolicyUploadRequest(adminEndpoint+"throttling/policies/import", path, update,
+		accessToken, true)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return getPublisherResponseError(resp, "importing "+filepath.Base(path))
+	}
+	return nil
+}
+
+// deleteThrottlingPolicy deletes a throttling policy of an environment
+func deleteThrottlingPolicy(accessToken, adminEndpoint, policyType, uuid string) error {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(adminEndpoint+"throttling/policies/"+
+		throttlingPolicyResourceTypes[policyType]+"/"+uuid, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting the throttling policy")
+	}
+	return nil
+}
+
+// Types of the throttling policies in the export query of the admin REST API
+var throttlingPolicyQueryTypes = map[string]string{
+	CmdPolicyTypeSubscription: QueryPolicyTypeSubscription,
+	CmdPolicyTypeApplication:  QueryPolicyTypeApplication,
+	CmdPolicyTypeAdvanced:     QueryPolicyTypeAdvanced,
+	CmdPolicyTypeCustom:       QueryCmdPolicyTypeCustom,
+}
+
+// Resources of the admin REST API by the type of the throttling policy
+var throttlingPolicyResourceTypes = map[string]string{
+	CmdPolicyTypeSubscription: utils.ThrottlingPolicyTypeSub,
+	CmdPolicyTypeApplication:  utils.ThrottlingPolicyTypeApp,
+	CmdPolicyTypeAdvanced:     utils.ThrottlingPolicyTypeAdv,
+	CmdPolicyTypeCustom:       utils.ThrottlingPolicyTypeCus,
+}
+
+func planThrottlingPolicySync(accessToken, adminEndpoint string, desired []desiredThrottlingPolicy,
+	prune bool) ([]ThrottlingPolicyChange, error) {
+	current, err := listThrottlingPolicies(accessToken, adminEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	currentByKey := make(map[string]utils.ThrottlingPolicyDetails)
+	for _, policy := range current {
+		policyType := normalizeThrottlingPolicyType(policy.Type)
+		if policyType == "" {
+			utils.Logln(utils.LogPrefixWarning + "Ignoring the throttling policy " + policy.PolicyName +
+				" of the unknown type " + policy.Type)
+			continue
+		}
+		currentByKey[policyType+"/"+policy.PolicyName] = policy
+	}
+
+	var changes []ThrottlingPolicyChange
+	desiredKeys := make(map[string]bool)
+	for _, policy := range desired {
+		key := policy.Type + "/" + policy.Name
+		desiredKeys[key] = true
+		change := ThrottlingPolicyChange{Type: policy.Type, Name: policy.Name, File: policy.File}
+		existing, exists := currentByKey[key]
+		if !exists {
+			change.Action = ThrottlingPolicyActionCreate
+			changes = append(changes, change)
+			continue
+		}
+		change.Uuid = existing.Uuid
+		data, err := exportThrottlingPolicyData(accessToken, adminEndpoint, policy.Type, policy.Name)
+		if err != nil {
+			return nil, err
+		}
+		if throttlingPoliciesEqual(policy.Data, data) {
+			change.Action = ThrottlingPolicyActionUnchanged
+		} else {
+			change.Action = ThrottlingPolicyActionUpdate
+		}
+		changes = append(changes, change)
+	}
+	if prune {
+		var keys []string
+		for key := range currentByKey {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			policy := currentByKey[key]
+			if desiredKeys[key] || protectedThrottlingPolicies[policy.PolicyName] {
+				continue
+			}
+			changes = append(changes, ThrottlingPolicyChange{Action: ThrottlingPolicyActionDelete,
+				Type: normalizeThrottlingPolicyType(policy.Type), Name: policy.PolicyName, Uuid: policy.Uuid})
+		}
+	}
+	return changes, nil
+}
+
+func applyThrottlingPolicyChanges(accessToken, adminEndpoint string, changes []ThrottlingPolicyChange) int {
+	failed := 0
+	for i := range changes {
+		change := &changes[i]
+		switch change.Action {
+		case ThrottlingPolicyActionCreate:
+			change.Err = uploadThrottlingPolicy(accessToken, adminEndpoint, change.File, false)
+		case ThrottlingPolicyActionUpdate:
+			change.Err = uploadThrottlingPolicy(accessToken, adminEndpoint, change.File, true)
+		case ThrottlingPolicyActionDelete:
+			change.Err = deleteThrottlingPolicy(accessToken, adminEndpoint, change.Type, change.Uuid)
+		}
+		if change.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// readDesiredThrottlingPolicies reads the exported throttling policy files of a directory and its subdirectories
+func readDesiredThrottlingPolicies(dir string) ([]desiredThrottlingPolicy, error) {
+	var policies []desiredThrottlingPolicy
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		extension := strings.ToLower(filepath.Ext(path))
+		if info.IsDir() || (extension != ".yaml" && extension != ".yml" && extension != ".json") {
+			return nil
+		}
+		policy, err := readThrottlingPolicyFile(path)
+		if err != nil {
+			return err
+		}
+		key := policy.Type + "/" + policy.Name
+		if existing, ok := files[key]; ok {
+			return fmt.Errorf("%s policy %s is defined in both %s and %s", policy.Type, policy.Name, existing, path)
+		}
+		files[key] = path
+		policies = append(policies, *policy)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, errors.New("no throttling policy files were found in " + dir)
+	}
+	return policies, nil
+}
+
+func readThrottlingPolicyFile(path string) (*desiredThrottlingPolicy, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		if content, err = utils.YamlToJson(content); err != nil {
+			return nil, errors.New("error reading " + path + ": " + err.Error())
+		}
+	}
+	file := make(map[string]interface{})
+	if err = json.Unmarshal(content, &file); err != nil {
+		return nil, errors.New("error reading " + path + ": " + err.Error())
+	}
+	if fileType, _ := file["type"].(string); fileType != throttlingPolicyFileType {
+		return nil, errors.New(path + " is not a throttling policy file")
+	}
+	subtype, _ := file["subtype"].(string)
+	policyType := normalizeThrottlingPolicyType(subtype)
+	if policyType == "" {
+		return nil, errors.New("unknown subtype " + subtype + " of the throttling policy in " + path)
+	}
+	data, _ := file["data"].(map[string]interface{})
+	name, _ := data["policyName"].(string)
+	if name == "" {
+		return nil, errors.New("policyName is not defined in " + path)
+	}
+	return &desiredThrottlingPolicy{Type: policyType, Name: name, File: path, Data: data}, nil
+}
+
+// normalizeThrottlingPolicyType maps the types and subtypes used by the admin REST API to the policy types of the
+// commands (sub, app, advanced and custom)
+func normalizeThrottlingPolicyType(policyType string) string {
+	policyType = strings.ToLower(policyType)
+	switch {
+	case strings.HasPrefix(policyType, "sub"):
+		return CmdPolicyTypeSubscription
+	case strings.HasPrefix(policyType, "app"):
+		return CmdPolicyTypeApplication
+	case strings.HasPrefix(policyType, "adv"), strings.HasPrefix(policyType, "api"):
+		return CmdPolicyTypeAdvanced
+	case strings.HasPrefix(policyType, "custom"), strings.HasPrefix(policyType, "global"):
+		return CmdPolicyTypeCustom
+	}
+	return ""
+}
+
+// throttlingPoliciesEqual compares two throttling policies ignoring the fields generated by the server
+func throttlingPoliciesEqual(desired, current map[string]interface{}) bool {
+	normalize := func(data map[string]interface{}) interface{} {
+		copied := make(map[string]interface{}, len(data))
+		for key, value := range data {
+			copied[key] = value
+		}
+		for _, field := range generatedThrottlingPolicyFields {
+			delete(copied, field)
+		}
+		content, _ := json.Marshal(copied)
+		var normalized interface{}
+		_ = json.Unmarshal(content, &normalized)
+		return normalized
+	}
+	return reflect.DeepEqual(normalize(desired), normalize(current))
+}
+
+// throttlingPolicyChangeRow holds a change of a throttling policy sync for outputting
+type throttlingPolicyChangeRow struct {
+	change ThrottlingPolicyChange
+}
+
+// Action of the change
+func (r throttlingPolicyChangeRow) Action() string {
+	return r.change.Action
+}
+
+// Type of the throttling policy
+func (r throttlingPolicyChangeRow) Type() string {
+	return r.change.Type
+}
+
+// Name of the throttling policy
+func (r throttlingPolicyChangeRow) Name() string {
+	return r.change.Name
+}
+
+// File of the throttling policy
+func (r throttlingPolicyChangeRow) File() string {
+	return r.change.File
+}
+
+// Result of the change
+func (r throttlingPolicyChangeRow) Result() string {
+	if r.change.Err != nil {
+		return "FAILED: " + r.change.Err.Error()
+	}
+	if r.change.Action == ThrottlingPolicyActionUnchanged {
+		return "-"
+	}
+	return "OK"
+}
+
+// MarshalJSON marshals the change using custom marshaller which uses methods instead of fields
+func (r *throttlingPolicyChangeRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintThrottlingPolicyPlan prints the changes of a throttling policy sync plan
+func PrintThrottlingPolicyPlan(changes []ThrottlingPolicyChange) {
+	printThrottlingPolicyChanges(changes, throttlingPolicyPlanTableFormat)
+}
+
+// PrintThrottlingPolicyResults prints the outcome of the changes of a throttling policy sync
+func PrintThrottlingPolicyResults(changes []ThrottlingPolicyChange) {
+	printThrottlingPolicyChanges(changes, throttlingPolicyResultTableFormat)
+}
+
+func printThrottlingPolicyChanges(changes []ThrottlingPolicyChange, format string) {
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, change := range changes {
+			if err := t.Execute(w, &throttlingPolicyChangeRow{change}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Action": throttlingPolicyActionHeader,
+		"Type":   throttlingPolicyTypeHeader,
+		"Name":   throttlingPolicyNameHeader,
+		"File":   throttlingPolicyFileHeader,
+		"Result": throttlingPolicyResultHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,200 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSubscriptionPolicyFile = `type: throttling policy
+subtype: subscription policy
+version: v4.1.0
+data:
+  policyId: 7
+  uuid: 1a2b
+  policyName: Gold
+  displayName: Gold
+  defaultLimit:
+    type: REQUESTCOUNTLIMIT
+    requestCount:
+      timeUnit: min
+      unitTime: 1
+      requestCount: 5000
+`
+
+const testAdvancedPolicyFile = `{
+  "type": "throttling policy",
+  "subtype": "advanced policy",
+  "version": "v4.1.0",
+  "data": {"policyName": "10KPerMin", "displayName": "10KPerMin"}
+}`
+
+func TestReadDesiredThrottlingPolicies(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "sub", "Gold.yaml"), testSubscriptionPolicyFile)
+	writeTestFile(t, filepath.Join(dir, "10KPerMin.json"), testAdvancedPolicyFile)
+	writeTestFile(t, filepath.Join(dir, "README.md"), "policies")
+
+	policies, err := readDesiredThrottlingPolicies(dir)
+	assert.Nil(t, err)
+	assert.Len(t, policies, 2)
+	assert.Equal(t, CmdPolicyTypeAdvanced, policies[0].Type)
+	assert.Equal(t, "10KPerMin", policies[0].Name)
+	assert.Equal(t, CmdPolicyTypeSubscription, policies[1].Type)
+	assert.Equal(t, "Gold", policies[1].Name)
+}
+
+func TestReadDesiredThrottlingPoliciesDuplicate(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "Gold.yaml"), testSubscriptionPolicyFile)
+	writeTestFile(t, filepath.Join(dir, "copy", "Gold.yaml"), testSubscriptionPolicyFile)
+
+	_, err := readDesiredThrottlingPolicies(dir)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "defined in both")
+}
+
+func TestReadDesiredThrottlingPoliciesInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "api.yaml"), "type: api\ndata:\n  name: PizzaAPI\n")
+
+	_, err := readDesiredThrottlingPolicies(dir)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not a throttling policy file")
+}
+
+func TestNormalizeThrottlingPolicyType(t *testing.T) {
+	assert.Equal(t, CmdPolicyTypeSubscription, normalizeThrottlingPolicyType("subscription policy"))
+	assert.Equal(t, CmdPolicyTypeSubscription, normalizeThrottlingPolicyType("SubscriptionThrottlePolicy"))
+	assert.Equal(t, CmdPolicyTypeApplication, normalizeThrottlingPolicyType("Application"))
+	assert.Equal(t, CmdPolicyTypeAdvanced, normalizeThrottlingPolicyType("API"))
+	assert.Equal(t, CmdPolicyTypeAdvanced, normalizeThrottlingPolicyType("advanced policy"))
+	assert.Equal(t, CmdPolicyTypeCustom, normalizeThrottlingPolicyType("custom rule"))
+	assert.Equal(t, CmdPolicyTypeCustom, normalizeThrottlingPolicyType("Global"))
+	assert.Equal(t, "", normalizeThrottlingPolicyType("unknown"))
+}
+
+func TestPlanAndApplyThrottlingPolicySync(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "Gold.yaml"), testSubscriptionPolicyFile)
+	writeTestFile(t, filepath.Join(dir, "10KPerMin.json"), testAdvancedPolicyFile)
+	desired, err := readDesiredThrottlingPolicies(dir)
+	assert.Nil(t, err)
+
+	exported := map[string]string{
+		// Same as the file except for the fields generated by the server
+		"sub/Gold": `{"type": "throttling policy", "subtype": "subscription policy", "data": {"policyId": 12,
+			"uuid": "u1", "isDeployed": true, "policyName": "Gold", "displayName": "Gold", "defaultLimit": {
+			"type": "REQUESTCOUNTLIMIT", "requestCount": {"timeUnit": "min", "unitTime": 1, "requestCount": 5000}}}}`,
+		"api/10KPerMin": `{"type": "throttling policy", "subtype": "advanced policy", "data": {
+			"policyName": "10KPerMin", "displayName": "10K Per Min"}}`,
+	}
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /throttling/policies/search":
+			_, _ = w.Write([]byte(`{"count": 4, "list": [
+				{"uuid": "u1", "policyName": "Gold", "type": "Subscription"},
+				{"uuid": "u2", "policyName": "10KPerMin", "type": "API"},
+				{"uuid": "u3", "policyName": "Unlimited", "type": "Application"},
+				{"uuid": "u4", "policyName": "Bronze", "type": "Subscription"}]}`))
+		case "GET /throttling/policies/export":
+			assert.Equal(t, "JSON", r.URL.Query().Get("format"))
+			_, _ = w.Write([]byte(exported[r.URL.Query().Get("type")+"/"+r.URL.Query().Get("name")]))
+		case "POST /throttling/policies/import":
+			_, header, err := r.FormFile("file")
+			assert.Nil(t, err)
+			calls = append(calls, call+"?overwrite="+r.URL.Query().Get("overwrite")+" "+header.Filename)
+			_, _ = w.Write([]byte(`{}`))
+		case "DELETE /throttling/policies/subscription/u4":
+			calls = append(calls, call)
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	changes, err := planThrottlingPolicySync("access-token", server.URL+"/", desired, false)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, ThrottlingPolicyActionUpdate, changes[0].Action)
+	assert.Equal(t, "10KPerMin", changes[0].Name)
+	assert.Equal(t, ThrottlingPolicyActionUnchanged, changes[1].Action)
+	assert.Equal(t, "Gold", changes[1].Name)
+
+	changes, err = planThrottlingPolicySync("access-token", server.URL+"/", desired, true)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, ThrottlingPolicyActionDelete, changes[2].Action)
+	assert.Equal(t, "Bronze", changes[2].Name)
+	assert.Equal(t, CmdPolicyTypeSubscription, changes[2].Type)
+
+	failed := applyThrottlingPolicyChanges("access-token", server.URL+"/", changes)
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, []string{
+		"POST /throttling/policies/import?overwrite=true 10KPerMin.json",
+		"DELETE /throttling/policies/subscription/u4",
+	}, calls)
+}
+
+func TestApplyThrottlingPolicyChangesFailure(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "10PerMin.yaml"), testSubscriptionPolicyFile)
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "POST /throttling/policies/import":
+			_, header, err := r.FormFile("file")
+			assert.Nil(t, err)
+			calls = append(calls, call+"?overwrite="+r.URL.Query().Get("overwrite")+" "+header.Filename)
+			_, _ = w.Write([]byte(`{}`))
+		case "DELETE /throttling/policies/custom/fail":
+			calls = append(calls, call)
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code": 409, "message": "Conflict", "description": "policy is in use"}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	changes := []ThrottlingPolicyChange{
+		{Action: ThrottlingPolicyActionCreate, Type: CmdPolicyTypeApplication, Name: "10PerMin",
+			File: filepath.Join(dir, "10PerMin.yaml")},
+		{Action: ThrottlingPolicyActionDelete, Type: CmdPolicyTypeCustom, Name: "blocked", Uuid: "fail"},
+	}
+
+	failed := applyThrottlingPolicyChanges("access-token", server.URL+"/", changes)
+	assert.Equal(t, 1, failed)
+	assert.Nil(t, changes[0].Err)
+	assert.Equal(t, "FAILED: error deleting the throttling policy. Status: 409 Conflict. policy is in use",
+		(&throttlingPolicyChangeRow{changes[1]}).Result())
+	assert.Equal(t, []string{
+		"POST /throttling/policies/import?overwrite=false 10PerMin.yaml",
+		"DELETE /throttling/policies/custom/fail",
+	}, calls)
+}
@@ -37,8 +37,16 @@ import (
 // @return appId, error
 func GetAppId(accessToken, environment, appName, appOwner string) (string, error) {
 	// Application REST API endpoint of the environment from the config file
-	applicationEndpoint := utils.GetAdminApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
-		"?user=" + appOwner + "&name=" + url.QueryEscape(appName)
+	applicationListEndpoint := utils.GetAdminApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return getAppId(accessToken, applicationListEndpoint, appName, appOwner)
+}
+
+// getAppId Get the ID of an Application if available
+// @param accessToken : Token to call the Admin Rest API
+// @param applicationListEndpoint : Application list endpoint of the Admin Rest API
+// @return appId, error
+func getAppId(accessToken, applicationListEndpoint, appName, appOwner string) (string, error) {
+	applicationEndpoint := applicationListEndpoint + "?user=" + appOwner + "&name=" + url.QueryEscape(appName)
 
 	// Prepping headers
 	headers := make(map[string]string)
@@ -59,7 +67,7 @@ func GetAppId(accessToken, environment, appName, appOwner string) (string, error
 			}
 			return appId, err
 		}
-		return "", errors.New("Cannot find the application: " + appName + " for owner: " + appOwner)
+		return "", &ArtifactNotFoundError{"Cannot find the application: " + appName + " for owner: " + appOwner}
 
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
@@ -0,0 +1,541 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Actions of a bulk delete plan
+const (
+	BulkDeleteActionDelete  = "delete"
+	BulkDeleteActionBlocked = "blocked"
+)
+
+const (
+	bulkDeleteOwnerHeader    = "OWNER"
+	bulkDeleteBlockersHeader = "BLOCKERS"
+
+	bulkDeletePlanTableFormat   = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Owner}}\t{{.Blockers}}"
+	bulkDeleteResultTableFormat = "table {{.Action}}\t{{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Owner}}\t{{.Result}}"
+
+	// Number of items fetched at once when listing the artifacts and subscriptions of a bulk delete
+	bulkDeletePageSize = 100
+)
+
+// BulkDeleteTarget is an artifact resolved by the query of a bulk delete
+type BulkDeleteTarget struct {
+	Action   string
+	Type     string
+	Id       string
+	Name     string
+	Version  string
+	Owner    string
+	Blockers []string
+	Err      error
+	// revisions holds the deployed revisions undeployed before the artifact is deleted
+	revisions []string
+}
+
+// PlanBulkDelete resolves the artifacts matching a query and checks each of them for blockers. An artifact is blocked
+// if it has subscriptions, if it is included in an API Product or if it has deployed revisions, unless the revisions
+// are to be undeployed.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the artifacts
+// @param artifactType : Type of the artifacts (API, API Product or Application)
+// @param query : Query of the artifacts
+// @param undeploy : Undeploy the deployed revisions instead of treating them as blockers
+// @return targets, error
+func PlanBulkDelete(accessToken, environment, artifactType, query string, undeploy bool) ([]BulkDeleteTarget,
+	error) {
+	publisherEndpoint := utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath)
+	adminEndpoint := utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return planBulkDelete(accessToken, publisherEndpoint, adminEndpoint, artifactType, query, undeploy)
+}
+
+// ApplyBulkDelete deletes the targets of a plan which are not blocked and records the result of each of them
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the artifacts
+// @param targets : Targets of the plan
+// @param backup : Back up each artifact to the recycle bin before it is deleted
+// @return number of targets which failed
+func ApplyBulkDelete(accessToken, environment string, targets []BulkDeleteTarget, backup bool) int {
+	publisherEndpoint := utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath)
+	adminEndpoint := utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)
+	return applyBulkDelete(accessToken, environment, publisherEndpoint, adminEndpoint, targets, backup)
+}
+
+func planBulkDelete(accessToken, publisherEndpoint, adminEndpoint, artifactType, query string,
+	undeploy bool) ([]BulkDeleteTarget, error) {
+	targets, err := listBulkDeleteTargets(accessToken, publisherEndpoint, adminEndpoint, artifactType, query)
+	if err != nil {
+		return nil, err
+	}
+	var productsOfAPIs map[string][]string
+	if artifactType == utils.ProjectTypeApi && len(targets) > 0 {
+		if productsOfAPIs, err = getAPIProductsOfAPIs(accessToken, publisherEndpoint); err != nil {
+			return nil, err
+		}
+	}
+	// The subscriptions of the applications are counted from the subscriptions listed by the publisher, as the
+	// devportal lists the subscriptions of the applications of the logged in user only
+	var applicationSubscriptions map[string]int
+	var applicationSubscriptionsErr error
+	if artifactType == utils.ProjectTypeApplication && len(targets) > 0 {
+		applicationSubscriptions, applicationSubscriptionsErr = countApplicationSubscriptions(accessToken,
+			publisherEndpoint)
+	}
+	for i := range targets {
+		target := &targets[i]
+		var subscriptions int
+		var err error
+		if artifactType == utils.ProjectTypeApplication {
+			subscriptions, err = applicationSubscriptions[target.Id], applicationSubscriptionsErr
+		} else {
+			subscriptions, err = countAPISubscriptions(accessToken, publisherEndpoint, *target)
+		}
+		if err != nil {
+			target.Blockers = append(target.Blockers, "subscriptions cannot be checked: "+err.Error())
+		} else if subscriptions > 0 {
+			target.Blockers = append(target.Blockers, strconv.Itoa(subscriptions)+" subscription(s)")
+		}
+		if products := productsOfAPIs[target.Id]; len(products) > 0 {
+			target.Blockers = append(target.Blockers, "included in API Product(s) "+strings.Join(products, ", "))
+		}
+		if artifactType != utils.ProjectTypeApplication {
+			revisions, err := getDeployedRevisionNumbers(accessToken,
+				getBulkDeleteArtifactEndpoint(publisherEndpoint, adminEndpoint, target.Type), *target)
+			if err != nil {
+				target.Blockers = append(target.Blockers, "deployments cannot be checked: "+err.Error())
+			} else if undeploy {
+				target.revisions = revisions
+			} else if len(revisions) > 0 {
+				target.Blockers = append(target.Blockers, "revision(s) "+strings.Join(revisions, ", ")+" deployed")
+			}
+		}
+		target.Action = BulkDeleteActionDelete
+		if len(target.Blockers) > 0 {
+			target.Action = BulkDeleteActionBlocked
+		}
+	}
+	return targets, nil
+}
+
+func applyBulkDelete(accessToken, environment, publisherEndpoint, adminEndpoint string, targets []BulkDeleteTarget,
+	backup bool) int {
+	failed := 0
+	for i := range targets {
+		target := &targets[i]
+		if target.Action != BulkDeleteActionDelete {
+			continue
+		}
+		target.Err = deleteBulkDeleteTarget(accessToken, environment,
+			getBulkDeleteArtifactEndpoint(publisherEndpoint, adminEndpoint, target.Type), *target, backup)
+		if target.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// deleteBulkDeleteTarget backs up a target if requested, undeploys its deployed revisions and deletes it
+// @param artifactEndpoint : Endpoint of the resources of the type of the target
+func deleteBulkDeleteTarget(accessToken, environment, artifactEndpoint string, target BulkDeleteTarget,
+	backup bool) error {
+	if backup {
+		_, err := BackupToRecycleBin(accessToken, environment, RecycleBinCommandDelete, RecycleBinArtifact{
+			Type: target.Type, Name: target.Name, Version: target.Version, Owner: target.Owner})
+		if err != nil {
+			return errors.New("cannot back up to the recycle bin: " + err.Error())
+		}
+	}
+	for _, revision := range target.revisions {
+		resp, err := undeployRevision(accessToken, artifactEndpoint, target.Id, revision, nil, true)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+			return getPublisherResponseError(resp, "undeploying revision "+revision+" of "+target.Name)
+		}
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(utils.AppendSlashToString(artifactEndpoint)+target.Id, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting "+target.Type+" "+target.Name)
+	}
+	return nil
+}
+
+// ParseApplicationQuery returns the name and the owner of the applications matched by a query. The query is made of
+// name:<name> and owner:<owner> terms, and a term without a prefix is taken as the name. The name is required and
+// may have a * wildcard.
+func ParseApplicationQuery(query string) (name, owner string, err error) {
+	for _, term := range strings.Fields(query) {
+		switch {
+		case strings.HasPrefix(term, "owner:"):
+			owner = strings.TrimPrefix(term, "owner:")
+		case strings.HasPrefix(term, "name:"):
+			name = strings.TrimPrefix(term, "name:")
+		case strings.Contains(term, ":"):
+			return "", "", errors.New("unsupported application query term " + term +
+				". Supported prefixes are name: and owner:")
+		default:
+			name = term
+		}
+	}
+	if name == "" {
+		return "", "", errors.New("the application query should have a name term (eg: name:test-*)")
+	}
+	return name, owner, nil
+}
+
+// getBulkDeleteArtifactEndpoint returns the endpoint of the resources of an artifact type
+func getBulkDeleteArtifactEndpoint(publisherEndpoint, adminEndpoint, artifactType string) string {
+	switch artifactType {
+	case utils.ProjectTypeApiProduct:
+		return publisherEndpoint + "/api-products"
+	case utils.ProjectTypeApplication:
+		return adminEndpoint + "/applications"
+	}
+	return publisherEndpoint + "/apis"
+}
+
+// listBulkDeleteTargets returns the artifacts of a type matching the query of a bulk delete
+func listBulkDeleteTargets(accessToken, publisherEndpoint, adminEndpoint, artifactType,
+	query string) ([]BulkDeleteTarget, error) {
+	var targets []BulkDeleteTarget
+	switch artifactType {
+	case utils.ProjectTypeApi:
+		var apis []utils.API
+		err := listAllBulkDeletePages(accessToken, publisherEndpoint+"/apis", map[string]string{"query": query},
+			"retrieving the APIs matching "+query, &apis)
+		if err != nil {
+			return nil, err
+		}
+		for _, api := range apis {
+			targets = append(targets, BulkDeleteTarget{Type: artifactType, Id: api.ID, Name: api.Name,
+				Version: api.Version, Owner: api.Provider})
+		}
+	case utils.ProjectTypeApiProduct:
+		apiProducts, err := listBulkDeleteAPIProducts(accessToken, publisherEndpoint, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, apiProduct := range apiProducts {
+			targets = append(targets, BulkDeleteTarget{Type: artifactType, Id: apiProduct.ID,
+				Name: apiProduct.Name, Version: apiProduct.Version, Owner: apiProduct.Provider})
+		}
+	case utils.ProjectTypeApplication:
+		name, owner, err := ParseApplicationQuery(query)
+		if err != nil {
+			return nil, err
+		}
+		queryParams := make(map[string]string)
+		if owner != "" {
+			queryParams["user"] = owner
+		}
+		// The part of the name before the first * narrows down the applications listed by the server
+		if literal := strings.SplitN(name, "*", 2)[0]; literal != "" {
+			queryParams["name"] = literal
+		}
+		var apps []utils.Application
+		err = listAllBulkDeletePages(accessToken, adminEndpoint+"/applications", queryParams,
+			"retrieving the applications", &apps)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			if matchesWildcard(name, app.Name) {
+				targets = append(targets, BulkDeleteTarget{Type: artifactType, Id: app.ID, Name: app.Name,
+					Owner: app.Owner})
+			}
+		}
+	default:
+		return nil, errors.New(artifactType + " cannot be deleted in bulk")
+	}
+	return targets, nil
+}
+
+// listBulkDeleteAPIProducts returns the API Products matching a query using the unified search
+func listBulkDeleteAPIProducts(accessToken, publisherEndpoint, query string) ([]utils.APIProduct, error) {
+	searchQuery := "type:\"" + utils.DefaultApiProductType + "\""
+	if query != "" {
+		searchQuery += " " + query
+	}
+	var apiProducts []utils.APIProduct
+	err := listAllBulkDeletePages(accessToken, publisherEndpoint+"/search", map[string]string{"query": searchQuery},
+		"retrieving the API Products matching "+searchQuery, &apiProducts)
+	return apiProducts, err
+}
+
+// listAllBulkDeletePages fetches the pages of a list of the REST APIs until the total no. of items is reached
+// @param url : Endpoint of the list
+// @param queryParams : Query params of the list other than the offset and the limit
+// @param action : Action used in the error message
+// @param list : Pointer to the slice the items of all the pages are unmarshalled to
+// @return error
+func listAllBulkDeletePages(accessToken, url string, queryParams map[string]string, action string,
+	list interface{}) error {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	var items []json.RawMessage
+	for {
+		pageParams := map[string]string{
+			"offset": strconv.Itoa(len(items)),
+			"limit":  strconv.Itoa(bulkDeletePageSize),
+		}
+		for key, value := range queryParams {
+			if value != "" {
+				pageParams[key] = value
+			}
+		}
+		resp, err := utils.InvokeGETRequestWithMultipleQueryParams(pageParams, url, headers)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return getPublisherResponseError(resp, action)
+		}
+		page := struct {
+			List       []json.RawMessage `json:"list"`
+			Pagination struct {
+				Total int `json:"total"`
+			} `json:"pagination"`
+		}{}
+		if err = json.Unmarshal(resp.Body(), &page); err != nil {
+			return err
+		}
+		items = append(items, page.List...)
+		if len(page.List) == 0 || len(items) >= page.Pagination.Total {
+			break
+		}
+	}
+	content, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, list)
+}
+
+// countAPISubscriptions returns the no. of subscriptions of an API or API Product
+func countAPISubscriptions(accessToken, publisherEndpoint string, target BulkDeleteTarget) (int, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(map[string]string{"apiId": target.Id},
+		publisherEndpoint+"/subscriptions", headers)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return 0, getPublisherResponseError(resp, "retrieving the subscriptions of "+target.Name)
+	}
+	list := struct {
+		Count int `json:"count"`
+	}{}
+	err = json.Unmarshal(resp.Body(), &list)
+	return list.Count, err
+}
+
+// countApplicationSubscriptions returns the no. of subscriptions of each application by the id of the application
+func countApplicationSubscriptions(accessToken, publisherEndpoint string) (map[string]int, error) {
+	var subscriptions []utils.Subscription
+	err := listAllBulkDeletePages(accessToken, publisherEndpoint+"/subscriptions", nil,
+		"retrieving the subscriptions", &subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	applicationSubscriptions := make(map[string]int)
+	for _, subscription := range subscriptions {
+		applicationSubscriptions[subscription.ApplicationInfo.ApplicationID]++
+	}
+	return applicationSubscriptions, nil
+}
+
+// getAPIProductsOfAPIs returns the API Products including each API by the id of the API
+func getAPIProductsOfAPIs(accessToken, publisherEndpoint string) (map[string][]string, error) {
+	apiProducts, err := listBulkDeleteAPIProducts(accessToken, publisherEndpoint, "")
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	productsOfAPIs := make(map[string][]string)
+	for _, apiProduct := range apiProducts {
+		resp, err := utils.InvokeGETRequest(publisherEndpoint+"/api-products/"+apiProduct.ID, headers)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, getPublisherResponseError(resp, "retrieving the API Product "+apiProduct.Name)
+		}
+		details := struct {
+			APIs []struct {
+				ApiId string `json:"apiId"`
+			} `json:"apis"`
+		}{}
+		if err = json.Unmarshal(resp.Body(), &details); err != nil {
+			return nil, err
+		}
+		for _, api := range details.APIs {
+			productsOfAPIs[api.ApiId] = append(productsOfAPIs[api.ApiId], apiProduct.Name+"_"+apiProduct.Version)
+		}
+	}
+	return productsOfAPIs, nil
+}
+
+// getDeployedRevisionNumbers returns the numbers of the deployed revisions of a target in ascending order
+// @param artifactEndpoint : Endpoint of the resources of the type of the target
+func getDeployedRevisionNumbers(accessToken, artifactEndpoint string, target BulkDeleteTarget) ([]string, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	url := utils.AppendSlashToString(artifactEndpoint) + target.Id + "/revisions"
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(map[string]string{"query": "deployed:true"}, url,
+		headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the revisions of "+target.Name)
+	}
+	revisionList := &utils.RevisionListResponse{}
+	if err = json.Unmarshal(resp.Body(), revisionList); err != nil {
+		return nil, err
+	}
+	var revisionNums []int
+	for _, revision := range revisionList.List {
+		revisionNums = append(revisionNums, getRevisionNumber(revision))
+	}
+	sort.Ints(revisionNums)
+	var revisions []string
+	for _, revisionNum := range revisionNums {
+		revisions = append(revisions, strconv.Itoa(revisionNum))
+	}
+	return revisions, nil
+}
+
+// bulkDeleteTargetRow holds a target of a bulk delete for outputting
+type bulkDeleteTargetRow struct {
+	target BulkDeleteTarget
+}
+
+// Action of the target
+func (r bulkDeleteTargetRow) Action() string {
+	return r.target.Action
+}
+
+// Type of the artifact
+func (r bulkDeleteTargetRow) Type() string {
+	return r.target.Type
+}
+
+// Id of the artifact
+func (r bulkDeleteTargetRow) Id() string {
+	return r.target.Id
+}
+
+// Name of the artifact
+func (r bulkDeleteTargetRow) Name() string {
+	return r.target.Name
+}
+
+// Version of the artifact
+func (r bulkDeleteTargetRow) Version() string {
+	return r.target.Version
+}
+
+// Owner of the artifact
+func (r bulkDeleteTargetRow) Owner() string {
+	return r.target.Owner
+}
+
+// Blockers of the artifact
+func (r bulkDeleteTargetRow) Blockers() string {
+	if len(r.target.Blockers) == 0 {
+		return "-"
+	}
+	return strings.Join(r.target.Blockers, "; ")
+}
+
+// Result of deleting the artifact
+func (r bulkDeleteTargetRow) Result() string {
+	if r.target.Err != nil {
+		return "FAILED: " + r.target.Err.Error()
+	}
+	if r.target.Action != BulkDeleteActionDelete {
+		return "-"
+	}
+	return "OK"
+}
+
+// MarshalJSON marshals the target using custom marshaller which uses methods instead of fields
+func (r *bulkDeleteTargetRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintBulkDeletePlan prints the targets of a bulk delete along with their blockers
+func PrintBulkDeletePlan(targets []BulkDeleteTarget) {
+	printBulkDeleteTargets(targets, bulkDeletePlanTableFormat)
+}
+
+// PrintBulkDeleteResults prints the result of deleting each of the targets of a bulk delete
+func PrintBulkDeleteResults(targets []BulkDeleteTarget) {
+	printBulkDeleteTargets(targets, bulkDeleteResultTableFormat)
+}
+
+func printBulkDeleteTargets(targets []BulkDeleteTarget, format string) {
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, target := range targets {
+			if err := t.Execute(w, &bulkDeleteTargetRow{target}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Action":   applyActionHeader,
+		"Type":     applyTypeHeader,
+		"Id":       apiIdHeader,
+		"Name":     applyNameHeader,
+		"Version":  applyVersionHeader,
+		"Owner":    bulkDeleteOwnerHeader,
+		"Blockers": bulkDeleteBlockersHeader,
+		"Result":   applyResultHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
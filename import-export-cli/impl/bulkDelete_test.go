@@ -0,0 +1,219 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestPlanBulkDeleteFindsBlockers(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /apis":
+			calls = append(calls, call+"?query="+r.URL.Query().Get("query"))
+			_, _ = w.Write([]byte(`{"count": 5, "list": [
+				{"id": "free", "name": "Free", "version": "1.0.0", "provider": "admin"},
+				{"id": "subscribed", "name": "Subscribed", "version": "1.0.0", "provider": "admin"},
+				{"id": "bundled", "name": "Bundled", "version": "1.0.0", "provider": "admin"},
+				{"id": "deployed", "name": "Deployed", "version": "1.0.0", "provider": "admin"},
+				{"id": "broken", "name": "Unknown", "version": "1.0.0", "provider": "admin"}]}`))
+		case "GET /search":
+			calls = append(calls, call+"?query="+r.URL.Query().Get("query"))
+			_, _ = w.Write([]byte(`{"count": 1, "list": [
+				{"id": "shop", "name": "Shop", "version": "1.0.0", "provider": "admin"}]}`))
+		case "GET /api-products/shop":
+			_, _ = w.Write([]byte(`{"id": "shop", "name": "Shop", "apis": [{"apiId": "bundled"}]}`))
+		case "GET /subscriptions":
+			switch r.URL.Query().Get("apiId") {
+			case "subscribed":
+				_, _ = w.Write([]byte(`{"count": 3, "list": []}`))
+			case "broken":
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+			}
+		case "GET /apis/deployed/revisions":
+			_, _ = w.Write([]byte(`{"count": 2, "list": [{"id": "r3", "displayName": "Revision 3"},
+				{"id": "r1", "displayName": "Revision 1"}]}`))
+		case "GET /apis/free/revisions", "GET /apis/subscribed/revisions", "GET /apis/bundled/revisions",
+			"GET /apis/broken/revisions":
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	plan, err := planBulkDelete("access-token", server.URL, server.URL+"/admin", utils.ProjectTypeApi,
+		"name:feature-", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /apis?query=name:feature-", `GET /search?query=type:"APIProduct"`}, calls)
+	require.Len(t, plan, 5)
+	assert.Equal(t, BulkDeleteActionDelete, plan[0].Action)
+	assert.Empty(t, plan[0].Blockers)
+	assert.Equal(t, BulkDeleteActionBlocked, plan[1].Action)
+	assert.Equal(t, []string{"3 subscription(s)"}, plan[1].Blockers)
+	assert.Equal(t, []string{"included in API Product(s) Shop_1.0.0"}, plan[2].Blockers)
+	assert.Equal(t, []string{"revision(s) 1, 3 deployed"}, plan[3].Blockers)
+	require.Len(t, plan[4].Blockers, 1)
+	assert.True(t, strings.HasPrefix(plan[4].Blockers[0], "subscriptions cannot be checked: error retrieving "+
+		"the subscriptions of Unknown. Status: 403"), plan[4].Blockers[0])
+}
+
+func TestPlanBulkDeleteUndeploysDeployedRevisions(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /search":
+			calls = append(calls, call+"?query="+r.URL.Query().Get("query"))
+			_, _ = w.Write([]byte(`{"count": 1, "list": [
+				{"id": "shop", "name": "Shop", "version": "1.0.0", "provider": "admin"}]}`))
+		case "GET /subscriptions":
+			_, _ = w.Write([]byte(`{"count": 0, "list": []}`))
+		case "GET /api-products/shop/revisions":
+			_, _ = w.Write([]byte(`{"count": 1, "list": [{"id": "r2", "displayName": "Revision 2"}]}`))
+		case "POST /api-products/shop/undeploy-revision":
+			calls = append(calls, call+"?revisionNumber="+r.URL.Query().Get("revisionNumber"))
+			w.WriteHeader(http.StatusCreated)
+		case "DELETE /api-products/shop":
+			calls = append(calls, call)
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	plan, err := planBulkDelete("access-token", server.URL, server.URL+"/admin", utils.ProjectTypeApiProduct,
+		"name:Shop", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`GET /search?query=type:"APIProduct" name:Shop`}, calls)
+	require.Len(t, plan, 1)
+	assert.Equal(t, BulkDeleteActionDelete, plan[0].Action)
+
+	calls = nil
+	assert.Equal(t, 0, applyBulkDelete("access-token", "production", server.URL, server.URL+"/admin", plan, false))
+	assert.Equal(t, []string{"POST /api-products/shop/undeploy-revision?revisionNumber=2",
+		"DELETE /api-products/shop"}, calls)
+}
+
+func TestPlanAndApplyBulkDeleteOfApplications(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /admin/applications":
+			calls = append(calls, call+"?user="+r.URL.Query().Get("user")+"&name="+r.URL.Query().Get("name"))
+			// The server matches the applications whose name contains the given name
+			_, _ = w.Write([]byte(`{"count": 4, "list": [
+				{"applicationId": "throwaway", "name": "test-Throwaway", "owner": "ci-bot"},
+				{"applicationId": "subscribed", "name": "test-Subscribed", "owner": "ci-bot"},
+				{"applicationId": "locked", "name": "test-Locked", "owner": "ci-bot"},
+				{"applicationId": "kept", "name": "Kept-test-", "owner": "ci-bot"}],
+				"pagination": {"offset": 0, "limit": 100, "total": 4}}`))
+		case "GET /subscriptions":
+			// The subscriptions are listed in pages of two
+			calls = append(calls, call+"?offset="+r.URL.Query().Get("offset"))
+			if r.URL.Query().Get("offset") == "0" {
+				_, _ = w.Write([]byte(`{"count": 2, "list": [
+					{"subscriptionId": "1", "applicationInfo": {"applicationId": "subscribed", "owner": "ci-bot"}},
+					{"subscriptionId": "2", "applicationInfo": {"applicationId": "subscribed", "owner": "ci-bot"}}],
+					"pagination": {"offset": 0, "limit": 2, "total": 3}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"count": 1, "list": [
+				{"subscriptionId": "3", "applicationInfo": {"applicationId": "default", "owner": "admin"}}],
+				"pagination": {"offset": 2, "limit": 2, "total": 3}}`))
+		case "DELETE /admin/applications/throwaway":
+			calls = append(calls, call)
+		case "DELETE /admin/applications/locked":
+			calls = append(calls, call)
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code": 409, "description": "cannot remove the application"}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	plan, err := planBulkDelete("access-token", server.URL, server.URL+"/admin", utils.ProjectTypeApplication,
+		"name:test-* owner:ci-bot", false)
+	require.NoError(t, err)
+	// The subscriptions of all the applications are listed once from the publisher
+	assert.Equal(t, []string{"GET /admin/applications?user=ci-bot&name=test-", "GET /subscriptions?offset=0",
+		"GET /subscriptions?offset=2"}, calls)
+	require.Len(t, plan, 3)
+	assert.Equal(t, BulkDeleteActionBlocked, plan[1].Action)
+	assert.Equal(t, []string{"2 subscription(s)"}, plan[1].Blockers)
+
+	calls = nil
+	assert.Equal(t, 1, applyBulkDelete("access-token", "production", server.URL, server.URL+"/admin", plan, false))
+	assert.Equal(t, []string{"DELETE /admin/applications/throwaway", "DELETE /admin/applications/locked"}, calls)
+	assert.Equal(t, "OK", bulkDeleteTargetRow{plan[0]}.Result())
+	assert.Equal(t, "-", bulkDeleteTargetRow{plan[1]}.Result())
+	assert.Equal(t, "FAILED: error deleting Application test-Locked. Status: 409 Conflict. cannot remove the application",
+		bulkDeleteTargetRow{plan[2]}.Result())
+}
+
+func TestListBulkDeleteTargetsOfApplicationsByExactName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET /applications", r.Method+" "+r.URL.Path)
+		assert.Equal(t, "Throwaway", r.URL.Query().Get("name"))
+		_, _ = w.Write([]byte(`{"count": 2, "list": [
+			{"applicationId": "throwaway", "name": "Throwaway", "owner": "ci-bot"},
+			{"applicationId": "kept", "name": "ThrowawayKept", "owner": "ci-bot"}]}`))
+	}))
+	defer server.Close()
+
+	targets, err := listBulkDeleteTargets("access-token", server.URL, server.URL, utils.ProjectTypeApplication,
+		"Throwaway")
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "throwaway", targets[0].Id)
+}
+
+func TestParseApplicationQuery(t *testing.T) {
+	name, owner, err := ParseApplicationQuery("name:test- owner:ci-bot")
+	require.NoError(t, err)
+	assert.Equal(t, "test-", name)
+	assert.Equal(t, "ci-bot", owner)
+
+	name, owner, err = ParseApplicationQuery("throwaway")
+	require.NoError(t, err)
+	assert.Equal(t, "throwaway", name)
+	assert.Equal(t, "", owner)
+
+	_, _, err = ParseApplicationQuery("owner:ci-bot")
+	assert.EqualError(t, err, "the application query should have a name term (eg: name:test-*)")
+
+	_, _, err = ParseApplicationQuery("status:APPROVED")
+	assert.EqualError(t, err, "unsupported application query term status:APPROVED. Supported prefixes are name: and owner:")
+}
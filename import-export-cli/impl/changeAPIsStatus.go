@@ -0,0 +1,492 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	statusChangeNameHeader     = "NAME"
+	statusChangeVersionHeader  = "VERSION"
+	statusChangeProviderHeader = "PROVIDER"
+	statusChangeStateHeader    = "CURRENT STATE"
+	statusChangeTargetHeader   = "TARGET STATE"
+	statusChangeResultHeader   = "RESULT"
+
+	statusChangePlanTableFormat   = "table {{.Name}}\t{{.Version}}\t{{.Provider}}\t{{.CurrentState}}\t{{.TargetState}}\t{{.Result}}"
+	statusChangeResultTableFormat = "table {{.Name}}\t{{.Version}}\t{{.Provider}}\t{{.Result}}"
+
+	// Maximum number of APIs resolved by a query of an API listed in a file
+	bulkStatusChangeQueryLimit = "1000"
+	// Number of APIs fetched at once when resolving a bulk status change query
+	bulkStatusChangePageSize = 100
+)
+
+// APIStatusChange is a planned lifecycle transition of an API
+type APIStatusChange struct {
+	API          utils.API
+	Action       string
+	CurrentState string
+	TargetState  string
+	// Reason the transition is not allowed from the current state. Empty if the transition is valid.
+	Invalid string
+	// Outcome of executing the transition
+	Err      error
+	Executed bool
+}
+
+// IsValid returns whether the transition is allowed from the current lifecycle state of the API
+func (c APIStatusChange) IsValid() bool {
+	return c.Invalid == ""
+}
+
+// lifecycleState is the lifecycle state of an API returned by the publisher REST API
+type lifecycleState struct {
+	State                string                `json:"state"`
+	AvailableTransitions []lifecycleTransition `json:"availableTransitions"`
+}
+
+type lifecycleTransition struct {
+	Event       string `json:"event"`
+	TargetState string `json:"targetState"`
+}
+
+// ResolveAPIsByQuery returns the APIs matching a search query. Query terms with a * wildcard (eg: name:Pet*) are
+// matched by apictl against the name, version, provider and context of the APIs, the others are sent to the
+// search endpoint. The part of a wildcard term before the first * is sent to the search endpoint as well.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to search the APIs in
+// @param query : Search query
+// @return array of APIs, error
+func ResolveAPIsByQuery(accessToken, environment, query string) ([]utils.API, error) {
+	serverQuery, patterns, err := splitBulkQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	apiListEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	fetch := func(offset int) (*utils.APIListResponse, error) {
+		queryParams := map[string]string{
+			"offset": strconv.Itoa(offset),
+			"limit":  strconv.Itoa(bulkStatusChangePageSize),
+		}
+		if serverQuery != "" {
+			queryParams["query"] = serverQuery
+		}
+		resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, apiListEndpoint, headers)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, getPublisherResponseError(resp, "retrieving the APIs matching "+query)
+		}
+		page := &utils.APIListResponse{}
+		if err = json.Unmarshal(resp.Body(), page); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}
+	apis, err := listAllAPIPages(fetch)
+	if err != nil {
+		return nil, err
+	}
+	return filterAPIsByPatterns(apis, patterns), nil
+}
+
+// listAllAPIPages fetches the pages of an API list until the total number of APIs is reached
+func listAllAPIPages(fetch func(offset int) (*utils.APIListResponse, error)) ([]utils.API, error) {
+	var apis []utils.API
+	for {
+		page, err := fetch(len(apis))
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, page.List...)
+		if len(page.List) == 0 || len(apis) >= page.Pagination.Total {
+			return apis, nil
+		}
+	}
+}
+
+// ResolveAPIsFromFile returns the APIs listed in a file. Each line of the file is name,version[,provider] and
+// lines starting with # are ignored.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to search the APIs in
+// @param filePath : Path of the file with the list of APIs
+// @return array of APIs, error
+func ResolveAPIsFromFile(accessToken, environment, filePath string) ([]utils.API, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	identifiers, err := readAPIIdentifiers(file)
+	if err != nil {
+		return nil, err
+	}
+	var apis []utils.API
+	for _, identifier := range identifiers {
+		query := "name:\"" + identifier.Name + "\" version:\"" + identifier.Version + "\""
+		if identifier.Provider != "" {
+			query += " provider:\"" + identifier.Provider + "\""
+		}
+		_, matches, err := GetAPIListFromEnv(accessToken, environment, query, bulkStatusChangeQueryLimit)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, api := range matches {
+			if api.Name == identifier.Name && api.Version == identifier.Version &&
+				(identifier.Provider == "" || api.Provider == identifier.Provider) {
+				apis = append(apis, api)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.New("API " + identifier.Name + " " + identifier.Version + " listed in " + filePath +
+				" is not available in the Publisher")
+		}
+	}
+	return apis, nil
+}
+
+// PlanAPIStatusChanges resolves the current lifecycle state of the APIs and whether the action is allowed
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the APIs
+// @param apis : APIs to change the status of
+// @param action : Lifecycle action to be performed
+// @param concurrency : Maximum number of concurrent requests
+// @return array of planned transitions
+func PlanAPIStatusChanges(accessToken, environment string, apis []utils.API, action string,
+	concurrency int) []APIStatusChange {
+	endpoint := utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath))
+	changes := make([]APIStatusChange, len(apis))
+	runConcurrently(len(apis), concurrency, func(i int) {
+		state, err := getLifecycleState(accessToken, endpoint, apis[i].ID)
+		if err != nil {
+			changes[i] = APIStatusChange{API: apis[i], Action: action, CurrentState: apis[i].LifeCycleStatus,
+				Invalid: err.Error()}
+			return
+		}
+		changes[i] = planAPIStatusChange(apis[i], action, state)
+	})
+	return changes
+}
+
+// ExecuteAPIStatusChanges performs the valid planned transitions
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the APIs
+// @param changes : Planned transitions
+// @param concurrency : Maximum number of concurrent requests
+// @return number of failed transitions
+func ExecuteAPIStatusChanges(accessToken, environment string, changes []APIStatusChange, concurrency int) int {
+	endpoint := utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath))
+	runConcurrently(len(changes), concurrency, func(i int) {
+		if !changes[i].IsValid() {
+			return
+		}
+		changes[i].Executed = true
+		changes[i].Err = changeAPIStatusById(accessToken, endpoint, changes[i].API.ID, changes[i].Action)
+	})
+	failed := 0
+	for _, change := range changes {
+		if change.Executed && change.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// planAPIStatusChange checks the action against the transitions available from the current lifecycle state
+func planAPIStatusChange(api utils.API, action string, state *lifecycleState) APIStatusChange {
+	change := APIStatusChange{API: api, Action: action, CurrentState: state.State}
+	var events []string
+	for _, transition := range state.AvailableTransitions {
+		if strings.EqualFold(transition.Event, action) {
+			change.Action = transition.Event
+			change.TargetState = transition.TargetState
+			return change
+		}
+		events = append(events, transition.Event)
+	}
+	change.Invalid = "'" + action + "' is not allowed from " + state.State
+	if len(events) > 0 {
+		change.Invalid += " (allowed: " + strings.Join(events, ", ") + ")"
+	}
+	return change
+}
+
+func getLifecycleState(accessToken, apiListEndpoint, apiId string) (*lifecycleState, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(apiListEndpoint+apiId+"/lifecycle-state", headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		state := &lifecycleState{}
+		err = json.Unmarshal(resp.Body(), state)
+		return state, err
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return nil, fmt.Errorf("authorization failed while retrieving the lifecycle state of the API: " + apiId)
+	}
+	return nil, errors.New("Request didn't respond 200 OK for retrieving the lifecycle state. Status: " +
+		resp.Status())
+}
+
+func changeAPIStatusById(accessToken, apiListEndpoint, apiId, action string) error {
+	queryParams := make(map[string]string)
+	queryParams[utils.LifeCycleAction] = action
+	queryParams[utils.ApiId] = apiId
+
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+
+	resp, err := utils.InvokePOSTRequestWithQueryParam(queryParams, apiListEndpoint+"change-lifecycle", headers, "")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		return nil
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return fmt.Errorf("authorization failed while changing the status of the API: " + apiId)
+	}
+	return errors.New("Request didn't respond 200 OK for changing the status. Status: " + resp.Status())
+}
+
+// runConcurrently calls fn for each index from 0 to n-1 with at most concurrency calls running at a time
+func runConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// bulkQueryPatternKeys are the fields of an API which can be matched against a query term with a * wildcard
+var bulkQueryPatternKeys = map[string]bool{"name": true, "version": true, "provider": true, "context": true}
+
+// splitBulkQuery separates the query terms with a * wildcard from the terms supported by the search endpoint. The
+// part of a wildcard term before the first * (eg: name:Pet of name:Pet*) is kept in the query of the search endpoint
+// to narrow down the APIs listed.
+func splitBulkQuery(query string) (serverQuery string, patterns map[string]string, err error) {
+	patterns = make(map[string]string)
+	var serverTerms []string
+	for _, term := range strings.Fields(query) {
+		if !strings.Contains(term, "*") {
+			serverTerms = append(serverTerms, term)
+			continue
+		}
+		separatorIndex := strings.Index(term, ":")
+		if separatorIndex <= 0 || !bulkQueryPatternKeys[strings.ToLower(term[:separatorIndex])] {
+			return "", nil, errors.New("unsupported query term " + term + ". A * wildcard is supported with " +
+				"the name:, version:, provider: and context: prefixes only")
+		}
+		key := strings.ToLower(term[:separatorIndex])
+		pattern := strings.Trim(term[separatorIndex+1:], "\"")
+		patterns[key] = pattern
+		if literal := pattern[:strings.Index(pattern, "*")]; literal != "" {
+			serverTerms = append(serverTerms, key+":"+literal)
+		}
+	}
+	return strings.Join(serverTerms, " "), patterns, nil
+}
+
+// matchesWildcard returns whether a value matches a pattern in which a * matches any sequence of characters,
+// including /
+func matchesWildcard(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+// filterAPIsByPatterns returns the APIs matching all the wildcard patterns
+func filterAPIsByPatterns(apis []utils.API, patterns map[string]string) []utils.API {
+	var matched []utils.API
+	for _, api := range apis {
+		fields := map[string]string{"name": api.Name, "version": api.Version, "provider": api.Provider,
+			"context": api.Context}
+		matches := true
+		for key, pattern := range patterns {
+			value, ok := fields[key]
+			if !ok || !matchesWildcard(pattern, value) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			matched = append(matched, api)
+		}
+	}
+	return matched
+}
+
+// readAPIIdentifiers reads the name,version[,provider] lines of an API list
+func readAPIIdentifiers(reader io.Reader) ([]utils.API, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var identifiers []utils.API
+	for i, record := range records {
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("invalid line %d: expected name,version[,provider]", i+1)
+		}
+		identifier := utils.API{Name: strings.TrimSpace(record[0]), Version: strings.TrimSpace(record[1])}
+		if len(record) == 3 {
+			identifier.Provider = strings.TrimSpace(record[2])
+		}
+		identifiers = append(identifiers, identifier)
+	}
+	return identifiers, nil
+}
+
+// apiStatusChangeRow is a row of the status change plan and result tables
+type apiStatusChangeRow struct {
+	change APIStatusChange
+}
+
+// Name of the API
+func (r apiStatusChangeRow) Name() string {
+	return r.change.API.Name
+}
+
+// Version of the API
+func (r apiStatusChangeRow) Version() string {
+	return r.change.API.Version
+}
+
+// Provider of the API
+func (r apiStatusChangeRow) Provider() string {
+	return r.change.API.Provider
+}
+
+// CurrentState of the API
+func (r apiStatusChangeRow) CurrentState() string {
+	return r.change.CurrentState
+}
+
+// TargetState of the API after the transition
+func (r apiStatusChangeRow) TargetState() string {
+	return r.change.TargetState
+}
+
+// Result of planning or executing the transition
+func (r apiStatusChangeRow) Result() string {
+	if !r.change.IsValid() {
+		return "SKIPPED: " + r.change.Invalid
+	}
+	if !r.change.Executed {
+		return r.change.Action
+	}
+	if r.change.Err != nil {
+		return "FAILED: " + r.change.Err.Error()
+	}
+	return "OK: " + r.change.CurrentState + " -> " + r.change.TargetState
+}
+
+// MarshalJSON marshals the row using custom marshaller which uses methods instead of fields
+func (r *apiStatusChangeRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintAPIStatusChangePlan prints the planned transitions, including the ones not allowed from the current state
+func PrintAPIStatusChangePlan(changes []APIStatusChange) {
+	printAPIStatusChanges(changes, statusChangePlanTableFormat)
+}
+
+// PrintAPIStatusChangeResults prints the outcome of the transitions
+func PrintAPIStatusChangeResults(changes []APIStatusChange) {
+	printAPIStatusChanges(changes, statusChangeResultTableFormat)
+}
+
+func printAPIStatusChanges(changes []APIStatusChange, format string) {
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, change := range changes {
+			if err := t.Execute(w, &apiStatusChangeRow{change}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Name":         statusChangeNameHeader,
+		"Version":      statusChangeVersionHeader,
+		"Provider":     statusChangeProviderHeader,
+		"CurrentState": statusChangeStateHeader,
+		"TargetState":  statusChangeTargetHeader,
+		"Result":       statusChangeResultHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,132 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestSplitBulkQuery(t *testing.T) {
+	serverQuery, patterns, err := splitBulkQuery("name:Pet* version:*.0 provider:admin context:/pet*")
+	assert.Nil(t, err)
+	assert.Equal(t, "name:Pet provider:admin context:/pet", serverQuery)
+	assert.Equal(t, map[string]string{"name": "Pet*", "version": "*.0", "context": "/pet*"}, patterns)
+
+	_, _, err = splitBulkQuery("tags:pet* provider:admin")
+	assert.EqualError(t, err, "unsupported query term tags:pet*. A * wildcard is supported with the name:, "+
+		"version:, provider: and context: prefixes only")
+	_, _, err = splitBulkQuery("Pet*")
+	assert.NotNil(t, err)
+}
+
+func TestMatchesWildcard(t *testing.T) {
+	assert.True(t, matchesWildcard("/pet*", "/pet/v1"))
+	assert.True(t, matchesWildcard("Pet*Store", "PetStore"))
+	assert.True(t, matchesWildcard("*Store", "PetStore"))
+	assert.True(t, matchesWildcard("1.*.0", "1.2.0"))
+	assert.True(t, matchesWildcard("PetStore", "PetStore"))
+	assert.False(t, matchesWildcard("PetStore", "PetStores"))
+	assert.False(t, matchesWildcard("Pet*Store", "PetClinic"))
+	assert.False(t, matchesWildcard("a*a", "a"))
+}
+
+func TestFilterAPIsByPatterns(t *testing.T) {
+	apis := []utils.API{
+		{Name: "PetStore", Version: "1.0.0"},
+		{Name: "PetStore", Version: "2.0.0"},
+		{Name: "PetClinic", Version: "1.1.0"},
+		{Name: "PizzaShack", Version: "1.0.0"},
+	}
+	matched := filterAPIsByPatterns(apis, map[string]string{"name": "Pet*", "version": "1.*"})
+	assert.Equal(t, []utils.API{apis[0], apis[2]}, matched)
+
+	// A * matches across the / of the context
+	apis[3].Context = "/pizza/v1"
+	assert.Equal(t, []utils.API{apis[3]}, filterAPIsByPatterns(apis, map[string]string{"context": "/pizza*"}))
+
+	assert.Empty(t, filterAPIsByPatterns(apis, map[string]string{"unknown": "*"}))
+	assert.Len(t, filterAPIsByPatterns(apis, map[string]string{}), 4)
+}
+
+func TestListAllAPIPages(t *testing.T) {
+	var offsets []int
+	apis, err := listAllAPIPages(func(offset int) (*utils.APIListResponse, error) {
+		offsets = append(offsets, offset)
+		page := &utils.APIListResponse{}
+		for i := offset; i < 250 && i < offset+bulkStatusChangePageSize; i++ {
+			page.List = append(page.List, utils.API{Name: "API" + strconv.Itoa(i)})
+		}
+		page.Pagination.Total = 250
+		return page, nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, apis, 250)
+	assert.Equal(t, "API249", apis[249].Name)
+	assert.Equal(t, []int{0, 100, 200}, offsets)
+}
+
+func TestReadAPIIdentifiers(t *testing.T) {
+	identifiers, err := readAPIIdentifiers(strings.NewReader("# name,version,provider\nPetStore, 1.0.0\n" +
+		"PizzaShack,1.0.0,admin\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.API{{Name: "PetStore", Version: "1.0.0"},
+		{Name: "PizzaShack", Version: "1.0.0", Provider: "admin"}}, identifiers)
+
+	_, err = readAPIIdentifiers(strings.NewReader("PetStore\n"))
+	assert.NotNil(t, err)
+}
+
+func TestPlanAPIStatusChange(t *testing.T) {
+	state := &lifecycleState{State: "PUBLISHED", AvailableTransitions: []lifecycleTransition{
+		{Event: "Deprecate", TargetState: "DEPRECATED"}, {Event: "Block", TargetState: "BLOCKED"}}}
+	api := utils.API{Name: "PetStore", Version: "1.0.0"}
+
+	change := planAPIStatusChange(api, "deprecate", state)
+	assert.True(t, change.IsValid())
+	assert.Equal(t, "Deprecate", change.Action)
+	assert.Equal(t, "DEPRECATED", change.TargetState)
+
+	change = planAPIStatusChange(api, "Retire", state)
+	assert.False(t, change.IsValid())
+	assert.Contains(t, change.Invalid, "PUBLISHED")
+	assert.Contains(t, change.Invalid, "Deprecate, Block")
+}
+
+func TestRunConcurrentlyIsBounded(t *testing.T) {
+	var running, maxRunning, calls int32
+	runConcurrently(20, 3, func(i int) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		atomic.AddInt32(&calls, 1)
+		atomic.AddInt32(&running, -1)
+	})
+	assert.Equal(t, int32(20), calls)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+}
@@ -0,0 +1,783 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Source types of a document
+const (
+	DocumentSourceTypeInline   = "INLINE"
+	DocumentSourceTypeMarkdown = "MARKDOWN"
+	DocumentSourceTypeURL      = "URL"
+	DocumentSourceTypeFile     = "FILE"
+)
+
+const (
+	// DocumentTypeHowTo is the default type of a document
+	DocumentTypeHowTo          = "HOWTO"
+	documentTypeOther          = "OTHER"
+	documentVisibilityAPILevel = "API_LEVEL"
+	documentFrontMatterFence   = "---"
+	documentListLimit          = "1000"
+)
+
+// Types and visibilities of a document accepted by the publisher
+var documentTypes = []string{DocumentTypeHowTo, "SAMPLES", "PUBLIC_FORUM", "SUPPORT_FORUM", "API_MESSAGE_FORMAT",
+	"SWAGGER_DOC", documentTypeOther}
+var documentSourceTypes = []string{DocumentSourceTypeInline, DocumentSourceTypeMarkdown, DocumentSourceTypeURL,
+	DocumentSourceTypeFile}
+var documentVisibilities = []string{documentVisibilityAPILevel, "PRIVATE", "OWNER_ONLY"}
+
+const (
+	documentIdHeader         = "ID"
+	documentSourceTypeHeader = "SOURCE TYPE"
+	documentVisibilityHeader = "VISIBILITY"
+	documentSummaryHeader    = "SUMMARY"
+	documentChangesHeader    = "CHANGES"
+
+	defaultDocumentTableFormat = "table {{.Id}}\t{{.Name}}\t{{.Type}}\t{{.SourceType}}\t{{.Visibility}}\t{{.Summary}}"
+	docSyncPlanTableFormat     = "table {{.Action}}\t{{.Name}}\t{{.Type}}\t{{.Changes}}"
+	docSyncResultTableFormat   = "table {{.Action}}\t{{.Name}}\t{{.Type}}\t{{.Result}}"
+)
+
+// LocalDocument is a document to be added to an API or API Product along with its content
+type LocalDocument struct {
+	utils.Document
+	// Content is the content of an inline or a markdown document
+	Content string
+	// Path is the file of the document
+	Path string
+}
+
+// documentFrontMatter is the front-matter of a document file
+type documentFrontMatter struct {
+	Name          string `yaml:"name"`
+	Type          string `yaml:"type"`
+	Visibility    string `yaml:"visibility"`
+	Summary       string `yaml:"summary"`
+	OtherTypeName string `yaml:"otherTypeName"`
+}
+
+// DocSyncChange is a change of a document sync plan
+type DocSyncChange struct {
+	Action  string
+	Name    string
+	Type    string
+	Changes []string
+	Err     error
+	local   *LocalDocument
+	remote  utils.Document
+}
+
+// GetDocuments retrieves the documents of an API or API Product
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @return documents, error
+func GetDocuments(accessToken, environment string, artifact RevisionArtifact) ([]utils.Document, error) {
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return nil, err
+	}
+	return listDocuments(accessToken, documentsEndpoint)
+}
+
+// AddDocument adds a document to an API or API Product and uploads its content
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @param document : Document to be added
+// @return added document, error
+func AddDocument(accessToken, environment string, artifact RevisionArtifact, document LocalDocument) (utils.Document,
+	error) {
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return utils.Document{}, err
+	}
+	return addDocument(accessToken, documentsEndpoint, document)
+}
+
+// UpdateDocument updates the metadata and the content of a document of an API or API Product. The fields of the
+// document which are not given are left as they are, and the content is uploaded only if it is given.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @param document : Name of the document along with the fields to be updated
+// @return error
+func UpdateDocument(accessToken, environment string, artifact RevisionArtifact, document LocalDocument) error {
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return err
+	}
+	return updateDocument(accessToken, documentsEndpoint, document)
+}
+
+// DeleteDocument deletes a document of an API or API Product
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @param name : Name of the document
+// @return error
+func DeleteDocument(accessToken, environment string, artifact RevisionArtifact, name string) error {
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return err
+	}
+	existing, err := findDocument(accessToken, documentsEndpoint, name)
+	if err != nil {
+		return err
+	}
+	return deleteDocument(accessToken, documentsEndpoint, existing.DocumentId)
+}
+
+// PlanDocSync compares the document files of a directory with the documents of an API or API Product
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @param dir : Directory of the document files
+// @param prune : Delete the documents which are not in the directory
+// @return changes, error
+func PlanDocSync(accessToken, environment string, artifact RevisionArtifact, dir string, prune bool) ([]DocSyncChange,
+	error) {
+	documents, err := ReadDocumentDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return nil, err
+	}
+	return planDocSync(accessToken, documentsEndpoint, documents, prune)
+}
+
+// ApplyDocSync executes the changes of a document sync plan
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product
+// @param changes : Changes of the plan. The error of each failed change is set.
+// @return no. of failed changes
+func ApplyDocSync(accessToken, environment string, artifact RevisionArtifact, changes []DocSyncChange) (int, error) {
+	documentsEndpoint, err := getDocumentsEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return 0, err
+	}
+	return applyDocSync(accessToken, documentsEndpoint, changes), nil
+}
+
+func addDocument(accessToken, documentsEndpoint string, document LocalDocument) (utils.Document, error) {
+	if err := normalizeDocument(&document.Document); err != nil {
+		return utils.Document{}, err
+	}
+	if _, err := findDocument(accessToken, documentsEndpoint, document.Name); err == nil {
+		return utils.Document{}, errors.New("document " + document.Name + " already exists")
+	}
+	created, err := createDocument(accessToken, documentsEndpoint, document.Document)
+	if err != nil {
+		return utils.Document{}, err
+	}
+	if hasDocumentContent(document) {
+		if err = uploadDocumentContent(accessToken, documentsEndpoint, created.DocumentId, document); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+func updateDocument(accessToken, documentsEndpoint string, document LocalDocument) error {
+	existing, err := findDocument(accessToken, documentsEndpoint, document.Name)
+	if err != nil {
+		return err
+	}
+	updated := existing
+	for _, field := range []struct{ value, target *string }{
+		{&document.Type, &updated.Type},
+		{&document.Summary, &updated.Summary},
+		{&document.SourceType, &updated.SourceType},
+		{&document.SourceUrl, &updated.SourceUrl},
+		{&document.OtherTypeName, &updated.OtherTypeName},
+		{&document.Visibility, &updated.Visibility},
+	} {
+		if *field.value != "" {
+			*field.target = *field.value
+		}
+	}
+	if err = normalizeDocument(&updated); err != nil {
+		return err
+	}
+	if updated != existing {
+		if err = putDocument(accessToken, documentsEndpoint, updated); err != nil {
+			return err
+		}
+	}
+	document.Document = updated
+	if hasDocumentContent(document) {
+		return uploadDocumentContent(accessToken, documentsEndpoint, updated.DocumentId, document)
+	}
+	return nil
+}
+
+func findDocument(accessToken, documentsEndpoint, name string) (utils.Document, error) {
+	documents, err := listDocuments(accessToken, documentsEndpoint)
+	if err != nil {
+		return utils.Document{}, err
+	}
+	for _, document := range documents {
+		if document.Name == name {
+			return document, nil
+		}
+	}
+	return utils.Document{}, errors.New("document " + name + " not found")
+}
+
+// hasDocumentContent returns whether the content of a document is to be uploaded
+func hasDocumentContent(document LocalDocument) bool {
+	switch document.SourceType {
+	case DocumentSourceTypeInline, DocumentSourceTypeMarkdown:
+		return document.Content != ""
+	case DocumentSourceTypeFile:
+		return document.Path != ""
+	}
+	return false
+}
+
+// normalizeDocument validates the type, the source type and the visibility of a document and sets their defaults
+func normalizeDocument(document *utils.Document) error {
+	document.Type = strings.ToUpper(document.Type)
+	if document.Type == "HOW_TO" {
+		document.Type = DocumentTypeHowTo
+	}
+	document.SourceType = strings.ToUpper(document.SourceType)
+	document.Visibility = strings.ToUpper(document.Visibility)
+	if document.Visibility == "" {
+		document.Visibility = documentVisibilityAPILevel
+	}
+	switch {
+	case document.Name == "":
+		return errors.New("name of the document is not defined")
+	case !containsString(documentTypes, document.Type):
+		return fmt.Errorf("invalid type %q of the document %s. Supported types are %s", document.Type,
+			document.Name, strings.Join(documentTypes, ", "))
+	case !containsString(documentSourceTypes, document.SourceType):
+		return fmt.Errorf("invalid source type %q of the document %s. Supported source types are %s",
+			document.SourceType, document.Name, strings.Join(documentSourceTypes, ", "))
+	case !containsString(documentVisibilities, document.Visibility):
+		return fmt.Errorf("invalid visibility %q of the document %s. Supported visibilities are %s",
+			document.Visibility, document.Name, strings.Join(documentVisibilities, ", "))
+	case document.Type == documentTypeOther && document.OtherTypeName == "":
+		return errors.New("the document " + document.Name + " of the type " + documentTypeOther +
+			" should have an other type name")
+	case document.SourceType == DocumentSourceTypeURL && document.SourceUrl == "":
+		return errors.New("the document " + document.Name + " of the source type " + DocumentSourceTypeURL +
+			" should have a source URL")
+	}
+	return nil
+}
+
+// ReadDocumentFile reads a document file. A Markdown file (.md) is read as a MARKDOWN document, an HTML or a text
+// file as an INLINE document and any other file as a FILE document. The front-matter of a Markdown, HTML or text
+// file sets the name, the type, the visibility and the summary of the document.
+// @param path : Path of the document file
+// @return document, error
+func ReadDocumentFile(path string) (LocalDocument, error) {
+	document := LocalDocument{Path: path}
+	extension := strings.ToLower(filepath.Ext(path))
+	switch extension {
+	case ".md", ".markdown":
+		document.SourceType = DocumentSourceTypeMarkdown
+	case ".html", ".htm", ".txt":
+		document.SourceType = DocumentSourceTypeInline
+	default:
+		document.SourceType = DocumentSourceTypeFile
+		document.FileName = filepath.Base(path)
+		document.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		return document, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return document, err
+	}
+	frontMatter, body, err := splitDocumentFrontMatter(string(content))
+	if err != nil {
+		return document, errors.New("error reading the front-matter of " + path + ": " + err.Error())
+	}
+	document.Name = frontMatter.Name
+	if document.Name == "" {
+		document.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	document.Type = frontMatter.Type
+	document.Visibility = frontMatter.Visibility
+	document.Summary = frontMatter.Summary
+	document.OtherTypeName = frontMatter.OtherTypeName
+	document.Content = body
+	return document, nil
+}
+
+// splitDocumentFrontMatter splits the YAML front-matter enclosed in "---" lines from the content of a document
+func splitDocumentFrontMatter(content string) (documentFrontMatter, string, error) {
+	frontMatter := documentFrontMatter{}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, documentFrontMatterFence+"\n") {
+		return frontMatter, content, nil
+	}
+	// Prefix the new line of the opening fence so that an empty front-matter is closed as well
+	rest := content[len(documentFrontMatterFence):]
+	end := strings.Index(rest, "\n"+documentFrontMatterFence+"\n")
+	if end < 0 && strings.HasSuffix(rest, "\n"+documentFrontMatterFence) {
+		end = len(rest) - len(documentFrontMatterFence) - 1
+	}
+	if end < 0 {
+		return frontMatter, content, errors.New("the front-matter is not closed")
+	}
+	if err := yaml.UnmarshalStrict([]byte(rest[:end]), &frontMatter); err != nil {
+		return frontMatter, content, err
+	}
+	body := strings.TrimPrefix(rest[end+len(documentFrontMatterFence)+1:], "\n")
+	return frontMatter, body, nil
+}
+
+// ReadDocumentDir reads the Markdown, HTML and text document files of a directory and its subdirectories
+// @param dir : Directory of the document files
+// @return documents, error
+func ReadDocumentDir(dir string) ([]LocalDocument, error) {
+	var documents []LocalDocument
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		document, err := ReadDocumentFile(path)
+		if err != nil {
+			return err
+		}
+		if document.SourceType == DocumentSourceTypeFile {
+			utils.Logln(utils.LogPrefixInfo + "Ignoring " + path + " which is not a Markdown, HTML or text file")
+			return nil
+		}
+		if document.Type == "" {
+			document.Type = DocumentTypeHowTo
+		}
+		if err = normalizeDocument(&document.Document); err != nil {
+			return errors.New("error reading " + path + ": " + err.Error())
+		}
+		if existing, ok := files[document.Name]; ok {
+			return fmt.Errorf("document %s is defined in both %s and %s", document.Name, existing, path)
+		}
+		files[document.Name] = path
+		documents = append(documents, document)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(documents) == 0 {
+		return nil, errors.New("no document files were found in " + dir)
+	}
+	return documents, nil
+}
+
+func planDocSync(accessToken, documentsEndpoint string, documents []LocalDocument, prune bool) ([]DocSyncChange,
+	error) {
+	current, err := listDocuments(accessToken, documentsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	currentByName := make(map[string]utils.Document)
+	for _, document := range current {
+		currentByName[document.Name] = document
+	}
+
+	var changes []DocSyncChange
+	for i := range documents {
+		document := &documents[i]
+		change := DocSyncChange{Name: document.Name, Type: document.Type, local: document}
+		existing, exists := currentByName[document.Name]
+		if !exists {
+			change.Action = ApplyActionCreate
+			changes = append(changes, change)
+			continue
+		}
+		delete(currentByName, document.Name)
+		change.remote = existing
+		for _, field := range []struct{ name, local, remote string }{
+			{"type", document.Type, existing.Type},
+			{"source type", document.SourceType, existing.SourceType},
+			{"visibility", document.Visibility, existing.Visibility},
+			{"summary", document.Summary, existing.Summary},
+			{"other type name", document.OtherTypeName, existing.OtherTypeName},
+		} {
+			if field.local != field.remote {
+				change.Changes = append(change.Changes, field.name)
+			}
+		}
+		if existing.SourceType == document.SourceType {
+			content, err := getDocumentContent(accessToken, documentsEndpoint, existing.DocumentId)
+			if err != nil {
+				return nil, err
+			}
+			if strings.TrimSpace(content) != strings.TrimSpace(document.Content) {
+				change.Changes = append(change.Changes, "content")
+			}
+		} else {
+			change.Changes = append(change.Changes, "content")
+		}
+		if len(change.Changes) == 0 {
+			change.Action = ApplyActionUnchanged
+		} else {
+			change.Action = ApplyActionUpdate
+		}
+		changes = append(changes, change)
+	}
+	if prune {
+		var names []string
+		for name := range currentByName {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			changes = append(changes, DocSyncChange{Action: ApplyActionDelete, Name: name,
+				Type: currentByName[name].Type, remote: currentByName[name]})
+		}
+	}
+	return changes, nil
+}
+
+func applyDocSync(accessToken, documentsEndpoint string, changes []DocSyncChange) int {
+	failed := 0
+	for i := range changes {
+		change := &changes[i]
+		switch change.Action {
+		case ApplyActionCreate:
+			var created utils.Document
+			created, change.Err = createDocument(accessToken, documentsEndpoint, change.local.Document)
+			if change.Err == nil {
+				change.Err = uploadDocumentContent(accessToken, documentsEndpoint, created.DocumentId, *change.local)
+			}
+		case ApplyActionUpdate:
+			document := *change.local
+			document.DocumentId = change.remote.DocumentId
+			if len(change.Changes) > 1 || change.Changes[0] != "content" {
+				change.Err = putDocument(accessToken, documentsEndpoint, document.Document)
+			}
+			if change.Err == nil && containsString(change.Changes, "content") {
+				change.Err = uploadDocumentContent(accessToken, documentsEndpoint, document.DocumentId, document)
+			}
+		case ApplyActionDelete:
+			change.Err = deleteDocument(accessToken, documentsEndpoint, change.remote.DocumentId)
+		}
+		if change.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// getDocumentsEndpoint returns the endpoint of the documents of an API or API Product
+func getDocumentsEndpoint(accessToken, environment string, artifact RevisionArtifact) (string, error) {
+	artifactEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return "", err
+	}
+	return artifactEndpoint + "/documents", nil
+}
+
+func listDocuments(accessToken, documentsEndpoint string) ([]utils.Document, error) {
+	resp, err := utils.InvokeGETRequest(documentsEndpoint+"?limit="+documentListLimit, getRevisionHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the documents")
+	}
+	documentList := &utils.DocumentList{}
+	if err = json.Unmarshal(resp.Body(), documentList); err != nil {
+		return nil, err
+	}
+	return documentList.List, nil
+}
+
+func getDocumentContent(accessToken, documentsEndpoint, documentId string) (string, error) {
+	resp, err := utils.InvokeGETRequest(documentsEndpoint+"/"+documentId+"/content", getRevisionHeaders(accessToken))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		// A document which has no content yet
+		return "", nil
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", getPublisherResponseError(resp, "retrieving the content of the document")
+	}
+	return string(resp.Body()), nil
+}
+
+func createDocument(accessToken, documentsEndpoint string, document utils.Document) (utils.Document, error) {
+	document.DocumentId = ""
+	body, err := json.Marshal(document)
+	if err != nil {
+		return utils.Document{}, err
+	}
+	resp, err := utils.InvokePOSTRequest(documentsEndpoint, getRevisionHeaders(accessToken), string(body))
+	if err != nil {
+		return utils.Document{}, err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return utils.Document{}, getPublisherResponseError(resp, "adding the document "+document.Name)
+	}
+	created := utils.Document{}
+	err = json.Unmarshal(resp.Body(), &created)
+	return created, err
+}
+
+func putDocument(accessToken, documentsEndpoint string, document utils.Document) error {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePUTRequestWithoutQueryParams(documentsEndpoint+"/"+document.DocumentId,
+		getRevisionHeaders(accessToken), string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "updating the document "+document.Name)
+	}
+	return nil
+}
+
+func deleteDocument(accessToken, documentsEndpoint, documentId string) error {
+	resp, err := utils.InvokeDELETERequest(documentsEndpoint+"/"+documentId, getRevisionHeaders(accessToken))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting the document")
+	}
+	return nil
+}
+
+// uploadDocumentContent uploads the content of an inline or a markdown document, or the file of a file document
+func uploadDocumentContent(accessToken, documentsEndpoint, documentId string, document LocalDocument) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if document.SourceType == DocumentSourceTypeFile {
+		file, err := os.Open(document.Path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		part, err := writer.CreateFormFile("file", filepath.Base(document.Path))
+		if err != nil {
+			return err
+		}
+		if _, err = io.Copy(part, file); err != nil {
+			return err
+		}
+	} else if err := writer.WriteField("inlineContent", document.Content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = writer.FormDataContentType()
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokePOSTRequest(documentsEndpoint+"/"+documentId+"/content", headers, body.Bytes())
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return getPublisherResponseError(resp, "uploading the content of the document "+document.Name)
+	}
+	return nil
+}
+
+// documentRow holds information about a document for outputting
+type documentRow struct {
+	document utils.Document
+}
+
+// Id of the document
+func (r documentRow) Id() string {
+	return r.document.DocumentId
+}
+
+// Name of the document
+func (r documentRow) Name() string {
+	return r.document.Name
+}
+
+// Type of the document
+func (r documentRow) Type() string {
+	if r.document.Type == documentTypeOther && r.document.OtherTypeName != "" {
+		return r.document.Type + " (" + r.document.OtherTypeName + ")"
+	}
+	return r.document.Type
+}
+
+// SourceType of the document
+func (r documentRow) SourceType() string {
+	return r.document.SourceType
+}
+
+// Visibility of the document
+func (r documentRow) Visibility() string {
+	return r.document.Visibility
+}
+
+// Summary of the document
+func (r documentRow) Summary() string {
+	return r.document.Summary
+}
+
+// MarshalJSON marshals the document using custom marshaller which uses methods instead of fields
+func (r *documentRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintDocuments prints the documents of an API or API Product
+// @param documents : Documents
+// @param format : Format type of the output
+func PrintDocuments(documents []utils.Document, format string) {
+	if format == "" {
+		format = defaultDocumentTableFormat
+	}
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, document := range documents {
+			if err := t.Execute(w, &documentRow{document}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Id":         documentIdHeader,
+		"Name":       applyNameHeader,
+		"Type":       applyTypeHeader,
+		"SourceType": documentSourceTypeHeader,
+		"Visibility": documentVisibilityHeader,
+		"Summary":    documentSummaryHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// docSyncChangeRow holds a change of a document sync plan for outputting
+type docSyncChangeRow struct {
+	change DocSyncChange
+}
+
+// Action of the change
+func (r docSyncChangeRow) Action() string {
+	return r.change.Action
+}
+
+// Name of the document
+func (r docSyncChangeRow) Name() string {
+	return r.change.Name
+}
+
+// Type of the document
+func (r docSyncChangeRow) Type() string {
+	return r.change.Type
+}
+
+// Changes of the document
+func (r docSyncChangeRow) Changes() string {
+	if len(r.change.Changes) == 0 {
+		return "-"
+	}
+	return strings.Join(r.change.Changes, ", ")
+}
+
+// Result of the change
+func (r docSyncChangeRow) Result() string {
+	if r.change.Err != nil {
+		return "FAILED: " + r.change.Err.Error()
+	}
+	if r.change.Action == ApplyActionUnchanged {
+		return "-"
+	}
+	return "OK"
+}
+
+// MarshalJSON marshals the change using custom marshaller which uses methods instead of fields
+func (r *docSyncChangeRow) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintDocSyncPlan prints the changes of a document sync plan
+func PrintDocSyncPlan(changes []DocSyncChange) {
+	printDocSyncChanges(changes, docSyncPlanTableFormat)
+}
+
+// PrintDocSyncResults prints the result of each change of a document sync plan
+func PrintDocSyncResults(changes []DocSyncChange) {
+	printDocSyncChanges(changes, docSyncResultTableFormat)
+}
+
+func printDocSyncChanges(changes []DocSyncChange, format string) {
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, change := range changes {
+			if err := t.Execute(w, &docSyncChangeRow{change}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Action":  applyActionHeader,
+		"Name":    applyNameHeader,
+		"Type":    applyTypeHeader,
+		"Changes": documentChangesHeader,
+		"Result":  applyResultHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,279 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testMarkdownDocument = `---
+name: Getting Started
+type: HOW_TO
+visibility: private
+summary: How to invoke the API
+---
+# Getting Started
+
+Invoke the API with an access token.
+`
+
+func TestReadDocumentFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "guide.md")
+	writeTestFile(t, path, testMarkdownDocument)
+
+	document, err := ReadDocumentFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "Getting Started", document.Name)
+	assert.Equal(t, DocumentSourceTypeMarkdown, document.SourceType)
+	assert.Equal(t, "How to invoke the API", document.Summary)
+	assert.Equal(t, "# Getting Started\n\nInvoke the API with an access token.\n", document.Content)
+
+	require.Nil(t, normalizeDocument(&document.Document))
+	assert.Equal(t, "HOWTO", document.Type)
+	assert.Equal(t, "PRIVATE", document.Visibility)
+}
+
+func TestReadDocumentFileWithoutFrontMatter(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "faq.html")
+	writeTestFile(t, path, "<p>FAQ</p>")
+
+	document, err := ReadDocumentFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "faq", document.Name)
+	assert.Equal(t, DocumentSourceTypeInline, document.SourceType)
+	assert.Equal(t, "<p>FAQ</p>", document.Content)
+}
+
+func TestReadDocumentFileInvalidFrontMatter(t *testing.T) {
+	dir := t.TempDir()
+	unknownField := filepath.Join(dir, "unknown.md")
+	writeTestFile(t, unknownField, "---\ntitle: Guide\n---\nbody")
+	notClosed := filepath.Join(dir, "notClosed.md")
+	writeTestFile(t, notClosed, "---\nname: Guide\nbody")
+
+	_, err := ReadDocumentFile(unknownField)
+	assert.NotNil(t, err)
+	_, err = ReadDocumentFile(notClosed)
+	assert.NotNil(t, err)
+}
+
+func TestReadDocumentDir(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "guide.md"), testMarkdownDocument)
+	writeTestFile(t, filepath.Join(dir, "samples", "curl.txt"), "---\ntype: samples\n---\ncurl")
+	writeTestFile(t, filepath.Join(dir, "logo.png"), "png")
+
+	documents, err := ReadDocumentDir(dir)
+	require.Nil(t, err)
+	require.Len(t, documents, 2)
+	assert.Equal(t, "Getting Started", documents[0].Name)
+	assert.Equal(t, "curl", documents[1].Name)
+	assert.Equal(t, "SAMPLES", documents[1].Type)
+	assert.Equal(t, "API_LEVEL", documents[1].Visibility)
+
+	writeTestFile(t, filepath.Join(dir, "copy.md"), testMarkdownDocument)
+	_, err = ReadDocumentDir(dir)
+	assert.NotNil(t, err)
+}
+
+func TestNormalizeDocumentErrors(t *testing.T) {
+	assert.NotNil(t, normalizeDocument(&utils.Document{Name: "Guide", Type: "GUIDE", SourceType: "INLINE"}))
+	assert.NotNil(t, normalizeDocument(&utils.Document{Name: "Guide", Type: "OTHER", SourceType: "INLINE"}))
+	assert.NotNil(t, normalizeDocument(&utils.Document{Name: "Wiki", Type: "HOWTO", SourceType: "URL"}))
+	assert.NotNil(t, normalizeDocument(&utils.Document{Name: "Guide", Type: "HOWTO", SourceType: "INLINE",
+		Visibility: "PUBLIC"}))
+	assert.Nil(t, normalizeDocument(&utils.Document{Name: "Wiki", Type: "HOWTO", SourceType: "URL",
+		SourceUrl: "https://wiki.example.com"}))
+}
+
+func TestAddDocument(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /documents":
+			_, _ = w.Write([]byte(`{"count": 1, "list": [{"documentId": "1", "name": "Guide"}]}`))
+		case "POST /documents":
+			document := utils.Document{}
+			if err := json.NewDecoder(r.Body).Decode(&document); err != nil {
+				t.Error(err)
+			}
+			calls = append(calls, call+" "+document.Name)
+			document.DocumentId = "new-" + document.Name
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(document)
+		case "POST /documents/new-FAQ/content":
+			calls = append(calls, call+" "+r.FormValue("inlineContent"))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	documentsEndpoint := server.URL + "/documents"
+
+	_, err := addDocument("access-token", documentsEndpoint, LocalDocument{Document: utils.Document{Name: "Guide", Type: "HOWTO",
+		SourceType: "MARKDOWN"}, Content: "guide"})
+	assert.NotNil(t, err)
+
+	created, err := addDocument("access-token", documentsEndpoint, LocalDocument{Document: utils.Document{Name: "FAQ", Type: "HOW_TO",
+		SourceType: "MARKDOWN"}, Content: "faq"})
+	require.Nil(t, err)
+	assert.Equal(t, "HOWTO", created.Type)
+	assert.Equal(t, "new-FAQ", created.DocumentId)
+	assert.Equal(t, []string{"POST /documents FAQ", "POST /documents/new-FAQ/content faq"}, calls)
+}
+
+func TestUpdateDocument(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /documents":
+			_, _ = w.Write([]byte(`{"count": 1, "list": [{"documentId": "1", "name": "Guide", "type": "HOWTO", ` +
+				`"sourceType": "MARKDOWN", "visibility": "API_LEVEL", "summary": "Guide"}]}`))
+		case "POST /documents/1/content":
+			calls = append(calls, call+" "+r.FormValue("inlineContent"))
+			w.WriteHeader(http.StatusCreated)
+		case "PUT /documents/1":
+			document := utils.Document{}
+			if err := json.NewDecoder(r.Body).Decode(&document); err != nil {
+				t.Error(err)
+			}
+			calls = append(calls, call+" "+document.Summary)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	documentsEndpoint := server.URL + "/documents"
+
+	// Only the content is given
+	err := updateDocument("access-token", documentsEndpoint, LocalDocument{Document: utils.Document{Name: "Guide"},
+		Content: "guide"})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"POST /documents/1/content guide"}, calls)
+
+	calls = nil
+	err = updateDocument("access-token", documentsEndpoint, LocalDocument{Document: utils.Document{Name: "Guide",
+		Summary: "New"}})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"PUT /documents/1 New"}, calls)
+
+	err = updateDocument("access-token", documentsEndpoint, LocalDocument{Document: utils.Document{Name: "FAQ", Summary: "New"}})
+	assert.NotNil(t, err)
+}
+
+func TestPlanAndApplyDocSync(t *testing.T) {
+	local := []LocalDocument{
+		{Document: utils.Document{Name: "Guide", Type: "HOWTO", SourceType: "MARKDOWN", Visibility: "API_LEVEL",
+			Summary: "Guide"}, Content: "guide\n"},
+		{Document: utils.Document{Name: "FAQ", Type: "HOWTO", SourceType: "MARKDOWN", Visibility: "API_LEVEL"},
+			Content: "new faq"},
+		{Document: utils.Document{Name: "Samples", Type: "SAMPLES", SourceType: "INLINE", Visibility: "PRIVATE"},
+			Content: "curl"},
+		{Document: utils.Document{Name: "Forum", Type: "PUBLIC_FORUM", SourceType: "INLINE",
+			Visibility: "API_LEVEL"}, Content: "forum"},
+	}
+	remote := []utils.Document{
+		{DocumentId: "1", Name: "Guide", Type: "HOWTO", SourceType: "MARKDOWN", Visibility: "API_LEVEL",
+			Summary: "Guide"},
+		{DocumentId: "2", Name: "FAQ", Type: "HOWTO", SourceType: "MARKDOWN", Visibility: "API_LEVEL"},
+		{DocumentId: "3", Name: "Samples", Type: "SAMPLES", SourceType: "INLINE", Visibility: "API_LEVEL"},
+		{DocumentId: "4", Name: "Old", Type: "HOWTO", SourceType: "INLINE"},
+	}
+	contents := map[string]string{"1": "guide", "2": "faq", "3": "curl"}
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch {
+		case call == "GET /documents":
+			body, _ := json.Marshal(utils.DocumentList{Count: len(remote), List: remote})
+			_, _ = w.Write(body)
+		case r.Method == http.MethodGet && strings.HasSuffix(call, "/content"):
+			content, ok := contents[strings.TrimSuffix(strings.TrimPrefix(call, "GET /documents/"), "/content")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(content))
+		case call == "POST /documents":
+			document := utils.Document{}
+			if err := json.NewDecoder(r.Body).Decode(&document); err != nil {
+				t.Error(err)
+			}
+			calls = append(calls, call+" "+document.Name)
+			document.DocumentId = "new-" + document.Name
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(document)
+		case r.Method == http.MethodPost && strings.HasSuffix(call, "/content"):
+			calls = append(calls, call+" "+r.FormValue("inlineContent"))
+			w.WriteHeader(http.StatusCreated)
+		case call == "PUT /documents/3":
+			calls = append(calls, call)
+			_, _ = w.Write([]byte(`{}`))
+		case call == "DELETE /documents/4":
+			calls = append(calls, call)
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code": 409, "description": "document is in use"}`))
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	documentsEndpoint := server.URL + "/documents"
+
+	changes, err := planDocSync("access-token", documentsEndpoint, local, false)
+	require.Nil(t, err)
+	require.Len(t, changes, 4)
+	assert.Equal(t, ApplyActionUnchanged, changes[0].Action)
+	assert.Equal(t, ApplyActionUpdate, changes[1].Action)
+	assert.Equal(t, []string{"content"}, changes[1].Changes)
+	assert.Equal(t, ApplyActionUpdate, changes[2].Action)
+	assert.Equal(t, []string{"visibility"}, changes[2].Changes)
+	assert.Equal(t, ApplyActionCreate, changes[3].Action)
+
+	changes, err = planDocSync("access-token", documentsEndpoint, local, true)
+	require.Nil(t, err)
+	require.Len(t, changes, 5)
+	assert.Equal(t, ApplyActionDelete, changes[4].Action)
+	assert.Equal(t, "Old", changes[4].Name)
+
+	failed := applyDocSync("access-token", documentsEndpoint, changes)
+	assert.Equal(t, 1, failed)
+	require.NotNil(t, changes[4].Err)
+	assert.Equal(t, "error deleting the document. Status: 409 Conflict. document is in use", changes[4].Err.Error())
+	assert.Equal(t, []string{"POST /documents/2/content new faq", "PUT /documents/3", "POST /documents Forum",
+		"POST /documents/new-Forum/content forum", "DELETE /documents/4"}, calls)
+}
@@ -0,0 +1,364 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	gatewayEnvNameHeader        = "NAME"
+	gatewayEnvDisplayNameHeader = "DISPLAY NAME"
+	gatewayEnvTypeHeader        = "TYPE"
+	gatewayEnvVhostsHeader      = "VHOSTS"
+	gatewayEnvReadOnlyHeader    = "READ ONLY"
+
+	defaultGatewayEnvTableFormat = "table {{.Name}}\t{{.DisplayName}}\t{{.Type}}\t{{.Vhosts}}\t{{.ReadOnly}}"
+)
+
+// Default ports of a vhost used when they are not given in the vhost definition
+const (
+	defaultVhostHttpPort  = 80
+	defaultVhostHttpsPort = 443
+	defaultVhostWsPort    = 9099
+	defaultVhostWssPort   = 8099
+)
+
+// gatewayEnvironment holds information about a gateway environment for outputting
+type gatewayEnvironment struct {
+	env utils.GatewayEnvironment
+}
+
+// Name of the gateway environment
+func (g gatewayEnvironment) Name() string {
+	return g.env.Name
+}
+
+// DisplayName of the gateway environment
+func (g gatewayEnvironment) DisplayName() string {
+	return g.env.DisplayName
+}
+
+// Type of the gateway environment
+func (g gatewayEnvironment) Type() string {
+	return g.env.Type
+}
+
+// Vhosts of the gateway environment
+func (g gatewayEnvironment) Vhosts() string {
+	var hosts []string
+	for _, vhost := range g.env.Vhosts {
+		hosts = append(hosts, vhost.Host)
+	}
+	return strings.Join(hosts, ",")
+}
+
+// ReadOnly status of the gateway environment (defined in the deployment.toml of the server)
+func (g gatewayEnvironment) ReadOnly() bool {
+	return g.env.IsReadOnly
+}
+
+// MarshalJSON marshals the gateway environment using custom marshaller which uses methods instead of fields
+func (g *gatewayEnvironment) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(g)
+}
+
+// GetGatewayEnvironments retrieves the gateway environments of an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to retrieve the gateway environments from
+// @return array of gateway environments, error
+func GetGatewayEnvironments(accessToken, environment string) ([]utils.GatewayEnvironment, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(getGatewayEnvironmentsEndpoint(environment), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the gateway environments")
+	}
+	envList := &utils.GatewayEnvironmentListResponse{}
+	if err = json.Unmarshal(resp.Body(), envList); err != nil {
+		return nil, err
+	}
+	return envList.List, nil
+}
+
+// GetGatewayEnvironment retrieves a gateway environment by its name
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the gateway environment
+// @param name : Name of the gateway environment
+// @return gateway environment, error
+func GetGatewayEnvironment(accessToken, environment, name string) (*utils.GatewayEnvironment, error) {
+	gatewayEnvs, err := GetGatewayEnvironments(accessToken, environment)
+	if err != nil {
+		return nil, err
+	}
+	for i := range gatewayEnvs {
+		if gatewayEnvs[i].Name == name {
+			return &gatewayEnvs[i], nil
+		}
+	}
+	return nil, errors.New("gateway environment " + name + " not found")
+}
+
+// AddGatewayEnvironment creates a gateway environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to add the gateway environment to
+// @param gatewayEnv : Gateway environment to be added
+// @return error
+func AddGatewayEnvironment(accessToken, environment string, gatewayEnv utils.GatewayEnvironment) error {
+	body, err := json.Marshal(gatewayEnv)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePOSTRequest(getGatewayEnvironmentsEndpoint(environment),
+		getGatewayEnvironmentHeaders(accessToken), string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "adding the gateway environment "+gatewayEnv.Name)
+	}
+	return nil
+}
+
+// UpdateGatewayEnvironment updates a gateway environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the gateway environment
+// @param gatewayEnv : Gateway environment with the updated values (the ID should be set)
+// @return error
+func UpdateGatewayEnvironment(accessToken, environment string, gatewayEnv utils.GatewayEnvironment) error {
+	if gatewayEnv.IsReadOnly {
+		return errors.New("gateway environment " + gatewayEnv.Name + " is read only. " +
+			"It is defined in the deployment.toml of the server")
+	}
+	body, err := json.Marshal(gatewayEnv)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePUTRequestWithoutQueryParams(getGatewayEnvironmentsEndpoint(environment)+"/"+
+		gatewayEnv.ID, getGatewayEnvironmentHeaders(accessToken), string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "updating the gateway environment "+gatewayEnv.Name)
+	}
+	return nil
+}
+
+// DeleteGatewayEnvironment deletes a gateway environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the gateway environment
+// @param name : Name of the gateway environment
+// @return error
+func DeleteGatewayEnvironment(accessToken, environment, name string) error {
+	gatewayEnv, err := GetGatewayEnvironment(accessToken, environment, name)
+	if err != nil {
+		return err
+	}
+	if gatewayEnv.IsReadOnly {
+		return errors.New("gateway environment " + name + " is read only. " +
+			"It is defined in the deployment.toml of the server")
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(getGatewayEnvironmentsEndpoint(environment)+"/"+gatewayEnv.ID, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting the gateway environment "+name)
+	}
+	return nil
+}
+
+// ParseVhostDefinitions parses the vhost definitions given in the form of a host name or comma separated
+// key=value pairs (host, context, http-port, https-port, ws-port, wss-port)
+// @param definitions : Vhost definitions
+// @return array of vhosts, error
+func ParseVhostDefinitions(definitions []string) ([]utils.GatewayVhost, error) {
+	var vhosts []utils.GatewayVhost
+	for _, definition := range definitions {
+		vhost := utils.GatewayVhost{HttpPort: defaultVhostHttpPort, HttpsPort: defaultVhostHttpsPort,
+			WsPort: defaultVhostWsPort, WssPort: defaultVhostWssPort}
+		if !strings.Contains(definition, "=") {
+			vhost.Host = strings.TrimSpace(definition)
+		} else {
+			for _, pair := range strings.Split(definition, ",") {
+				keyValue := strings.SplitN(pair, "=", 2)
+				if len(keyValue) != 2 {
+					return nil, errors.New("invalid vhost definition " + definition + ". Expected key=value pairs")
+				}
+				key, value := strings.TrimSpace(keyValue[0]), strings.TrimSpace(keyValue[1])
+				var port *int
+				switch key {
+				case "host":
+					vhost.Host = value
+				case "context":
+					vhost.HttpContext = value
+				case "http-port":
+					port = &vhost.HttpPort
+				case "https-port":
+					port = &vhost.HttpsPort
+				case "ws-port":
+					port = &vhost.WsPort
+				case "wss-port":
+					port = &vhost.WssPort
+				default:
+					return nil, errors.New("unknown key " + key + " in vhost definition " + definition)
+				}
+				if port != nil {
+					value, err := strconv.Atoi(value)
+					if err != nil {
+						return nil, errors.New("invalid port of " + key + " in vhost definition " + definition)
+					}
+					*port = value
+				}
+			}
+		}
+		if vhost.Host == "" {
+			return nil, errors.New("host is not defined in vhost definition " + definition)
+		}
+		vhosts = append(vhosts, vhost)
+	}
+	return vhosts, nil
+}
+
+// ValidateDeploymentEnvironments checks whether the gateway environments and vhosts in the deployment environments
+// file of a project exist in the environment. The validation is skipped if the gateway environments cannot be
+// retrieved, so that it does not block imports when the user has no access to the admin REST API.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to which the project is imported
+// @param projectDir : Directory of the project
+// @return error
+func ValidateDeploymentEnvironments(accessToken, environment, projectDir string) error {
+	path := filepath.Join(projectDir, utils.DeploymentEnvFile)
+	if !utils.IsFileExist(path) {
+		return nil
+	}
+	file, err := readProjectFile(path)
+	if err != nil {
+		return err
+	}
+	deployments, _ := file["data"].([]interface{})
+	if len(deployments) == 0 {
+		return nil
+	}
+	gatewayEnvs, err := GetGatewayEnvironments(accessToken, environment)
+	if err != nil {
+		utils.Logln(utils.LogPrefixWarning + "Skipping the validation of the deployment environments: " + err.Error())
+		return nil
+	}
+	return validateDeployments(deployments, gatewayEnvs)
+}
+
+// validateDeployments checks the deployments of a deployment environments file against the gateway environments
+func validateDeployments(deployments []interface{}, gatewayEnvs []utils.GatewayEnvironment) error {
+	var envNames []string
+	for _, gatewayEnv := range gatewayEnvs {
+		envNames = append(envNames, gatewayEnv.Name)
+	}
+	for _, deployment := range deployments {
+		entry, _ := deployment.(map[string]interface{})
+		name, _ := entry["deploymentEnvironment"].(string)
+		vhost, _ := entry["deploymentVhost"].(string)
+		var gatewayEnv *utils.GatewayEnvironment
+		for i := range gatewayEnvs {
+			if gatewayEnvs[i].Name == name {
+				gatewayEnv = &gatewayEnvs[i]
+				break
+			}
+		}
+		if gatewayEnv == nil {
+			return fmt.Errorf("%s refers to the unknown gateway environment '%s'. Available gateway environments: %s",
+				utils.DeploymentEnvFile, name, strings.Join(envNames, ", "))
+		}
+		if vhost == "" {
+			continue
+		}
+		var hosts []string
+		found := false
+		for _, definedVhost := range gatewayEnv.Vhosts {
+			hosts = append(hosts, definedVhost.Host)
+			if definedVhost.Host == vhost {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s refers to the vhost '%s' which is not defined in the gateway environment '%s'. "+
+				"Available vhosts: %s", utils.DeploymentEnvFile, vhost, name, strings.Join(hosts, ", "))
+		}
+	}
+	return nil
+}
+
+func getGatewayEnvironmentsEndpoint(environment string) string {
+	return utils.AppendSlashToString(utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)) +
+		"environments"
+}
+
+func getGatewayEnvironmentHeaders(accessToken string) map[string]string {
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	return headers
+}
+
+// PrintGatewayEnvironments prints the gateway environments in the given format
+// @param gatewayEnvs : Gateway environments
+// @param format : Format type of the output
+func PrintGatewayEnvironments(gatewayEnvs []utils.GatewayEnvironment, format string) {
+	if format == "" {
+		format = defaultGatewayEnvTableFormat
+	}
+	gatewayEnvContext := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, env := range gatewayEnvs {
+			if err := t.Execute(w, &gatewayEnvironment{env}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	gatewayEnvTableHeaders := map[string]string{
+		"Name":        gatewayEnvNameHeader,
+		"DisplayName": gatewayEnvDisplayNameHeader,
+		"Type":        gatewayEnvTypeHeader,
+		"Vhosts":      gatewayEnvVhostsHeader,
+		"ReadOnly":    gatewayEnvReadOnlyHeader,
+	}
+	if err := gatewayEnvContext.Write(renderer, gatewayEnvTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
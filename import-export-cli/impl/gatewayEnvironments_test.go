@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestParseVhostDefinitions(t *testing.T) {
+	vhosts, err := ParseVhostDefinitions([]string{"api.example.com",
+		"host=internal.example.com,context=/gw,https-port=8243,http-port=8280"})
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.GatewayVhost{
+		{Host: "api.example.com", HttpPort: 80, HttpsPort: 443, WsPort: 9099, WssPort: 8099},
+		{Host: "internal.example.com", HttpContext: "/gw", HttpPort: 8280, HttpsPort: 8243, WsPort: 9099,
+			WssPort: 8099},
+	}, vhosts)
+
+	_, err = ParseVhostDefinitions([]string{"context=/gw"})
+	assert.NotNil(t, err)
+	_, err = ParseVhostDefinitions([]string{"host=api.example.com,https-port=abc"})
+	assert.NotNil(t, err)
+	_, err = ParseVhostDefinitions([]string{"host=api.example.com,port=443"})
+	assert.NotNil(t, err)
+}
+
+func TestValidateDeployments(t *testing.T) {
+	gatewayEnvs := []utils.GatewayEnvironment{
+		{Name: "Default", Vhosts: []utils.GatewayVhost{{Host: "localhost"}}},
+		{Name: "External", Vhosts: []utils.GatewayVhost{{Host: "api.example.com"}, {Host: "api2.example.com"}}},
+	}
+	deployment := func(name, vhost string) interface{} {
+		return map[string]interface{}{"deploymentEnvironment": name, "deploymentVhost": vhost}
+	}
+
+	assert.Nil(t, validateDeployments([]interface{}{deployment("Default", ""),
+		deployment("External", "api2.example.com")}, gatewayEnvs))
+
+	err := validateDeployments([]interface{}{deployment("Internal", "")}, gatewayEnvs)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown gateway environment 'Internal'")
+	assert.Contains(t, err.Error(), "Default, External")
+
+	err = validateDeployments([]interface{}{deployment("External", "localhost")}, gatewayEnvs)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "vhost 'localhost'")
+	assert.Contains(t, err.Error(), "api.example.com, api2.example.com")
+}
@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
@@ -31,7 +33,6 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-var tokenType string
 var subscriptionThrottlingTier string
 var applicationThrottlingPolicy string
 var apiName string
@@ -39,14 +40,60 @@ var apiVersion string
 var apiProvider string
 var keyGenEnv string
 var keyGenTokenEndpoint string
+var keyGenOptions KeyGenOptions
 
 //Subscribe the given API or API Product to the default application and generate an access token
 func GetKeys(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string) {
+	GetKeysWithOptions(cred, envName, name, version, provider, tokenEndpoint, KeyGenOptions{})
+}
+
+// GetKeysWithOptions subscribes the given API or API Product to the default application and prints a token or an
+// API key generated as requested by the options
+// @param cred : Credentials of the environment
+// @param envName : Environment of the API or API Product
+// @param name : Name of the API or API Product
+// @param version : Version of the API or API Product
+// @param provider : Provider of the API or API Product
+// @param tokenEndpoint : Token endpoint to override the one of the environment
+// @param options : Grant type, scopes and validity period of the token
+func GetKeysWithOptions(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string,
+	options KeyGenOptions) {
+	tokenResponse := generateKeysForAPI(cred, envName, name, version, provider, tokenEndpoint, options)
+	fmt.Println(tokenResponse.AccessToken)
+	if tokenResponse.RefreshToken != "" && options.GrantType != "" &&
+		options.GrantType != utils.GrantTypeClientCredentials {
+		fmt.Println("Refresh Token: " + tokenResponse.RefreshToken)
+	}
+	if options.Decode {
+		// API keys are JWTs too, hence these can be decoded the same way as the access tokens
+		PrintDecodedJWT(tokenResponse.AccessToken)
+	}
+}
+
+// GenerateAccessTokenForAPI subscribes the given API or API Product to the default application and returns an access
+// token to invoke it
+// @param cred : Credentials of the environment
+// @param envName : Environment of the API or API Product
+// @param name : Name of the API or API Product
+// @param version : Version of the API or API Product
+// @param provider : Provider of the API or API Product
+// @param tokenEndpoint : Token endpoint to override the one of the environment
+// @return access token
+func GenerateAccessTokenForAPI(cred credentials.Credential, envName, name, version, provider,
+	tokenEndpoint string) string {
+	return generateKeysForAPI(cred, envName, name, version, provider, tokenEndpoint, KeyGenOptions{}).AccessToken
+}
+
+// generateKeysForAPI subscribes the given API or API Product to the default application and generates a token or an
+// API key to invoke it as requested by the options
+func generateKeysForAPI(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string,
+	options KeyGenOptions) *utils.TokenResponse {
 	keyGenEnv = envName
 	apiName = name
 	apiVersion = version
 	apiProvider = provider
 	keyGenTokenEndpoint = tokenEndpoint
+	keyGenOptions = options
 
 	//generating access token for the env based on the credentials
 	accessToken, err := credentials.GetOAuthAccessToken(cred, keyGenEnv)
@@ -54,13 +101,14 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
 	utils.Logln(utils.LogPrefixInfo + "Generated a token to access the Publisher and DevPortal REST APIs.")
-	//retrieving subscription tiers
-	tiers, err := getAvailableAPITiers(accessToken)
+	//retrieving the API or API Product to get the subscription tiers and the security schemes
+	api, err := getApiOrProductToSubscribe(accessToken)
 
-	if tiers != nil && err == nil {
-		utils.Logln(utils.LogPrefixInfo+"Retrieved available subscription tiers of the API or API Product: ", tiers)
+	if api != nil && len(api.Policies) > 0 && err == nil {
+		utils.Logln(utils.LogPrefixInfo+"Retrieved available subscription tiers of the API or API Product: ",
+			api.Policies)
 		// Needs an available subscription tier when subscribing to the particular API or API Product using the application
-		subscriptionThrottlingTier = tiers[0]
+		subscriptionThrottlingTier = api.Policies[0]
 	} else {
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
@@ -77,57 +125,8 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 		utils.HandleErrorAndExit("Internal error occurred", err)
 	}
 	utils.Logln(utils.LogPrefixInfo + "Searched if application exists.")
-	//if the application exists
 	if appId != "" {
 		utils.Logln(utils.LogPrefixInfo + "CLI application already exists")
-		// Subscribe API or API Product to a given application
-		subId, err := subscribe(appId, accessToken)
-		// If subscription fails
-		if subId == "" && err != nil {
-			utils.HandleErrorAndExit("Error occurred while subscribing.", err)
-		}
-
-		scopes, err := getScopes(appId, accessToken)
-		//retrieve application specific details
-		appDetails, err := getApplicationDetails(appId, accessToken)
-		if appDetails != nil {
-			//Reading configuration to check if the application needs to be updated
-			configVars := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
-			tokenType = configVars.Config.TokenType
-
-			//retrieve keys of application to see if there are already generated keys
-			appKeys, keysErr := getApplicationKeys(appId, accessToken)
-			if keysErr != nil {
-				utils.HandleErrorAndExit("Error occurred while getting CLI application keys.", keysErr)
-			}
-
-			//if keys have been already generated before, then update the consumer key and secret
-			if appKeys.Count != 0 {
-				//If the keys have not been generated and the application is updated
-				token, err := getNewToken(&appKeys.List[0], scopes)
-				//Assert token endpoint related fails and errors
-				if err != nil {
-					utils.HandleErrorAndExit("Error while generating token. ", err)
-				}
-
-				if accessToken != "" {
-					// Access Token generated successfully.
-					fmt.Println(token)
-				} else {
-					utils.HandleErrorAndExit("Error while generating token: ", err)
-				}
-			} else {
-				//If the application is already created but the keys have not generated in the first time
-				keygenResponse, err := generateApplicationKeys(appId, accessToken)
-				if keygenResponse == nil && err != nil {
-					utils.HandleErrorAndExit("Error occurred while generating CLI application keys.", err)
-				}
-				// Access Token generated successfully.
-				fmt.Println(keygenResponse.Token.AccessToken)
-			}
-		} else {
-			utils.HandleErrorAndExit("Error while retrieving the CLI application:", err)
-		}
 	} else {
 		//If the default cli appId does not exist in the environment
 		//Create the application
@@ -139,49 +138,82 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 			//if error occurred while creating the application, then
 			utils.HandleErrorAndExit("Error while creating the CLI application:", err)
 		}
-		//Search the if the given API or API Product is present to subscribe
-		subId, err := subscribe(appId, accessToken)
-		//If subscription failed
-		if subId == "" && err != nil {
-			utils.HandleErrorAndExit("Error occurred while subscribing.", err)
+	}
+	// Subscribe API or API Product to a given application
+	subId, err := subscribe(appId, accessToken)
+	// If subscription fails
+	if subId == "" && err != nil {
+		utils.HandleErrorAndExit("Error occurred while subscribing.", err)
+	}
+
+	if options.APIKey || isAPIKeySecuredOnly(api) {
+		apiKey, err := generateAPIKey(appId, utils.ProductionKeyType, options.ValidityPeriod, accessToken)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while generating API key: ", err)
 		}
-		scopes, err := getScopes(appId, accessToken)
+		return &utils.TokenResponse{AccessToken: apiKey, TokenType: utils.TokenTypeAPIKey}
+	}
+
+	scopes := options.Scopes
+	if len(scopes) == 0 {
+		scopes, err = getScopes(appId, accessToken)
 		//If errors occurred while retrieving scopes
 		if scopes == nil && err != nil {
 			utils.HandleErrorAndExit("Error while retrieving scopes ", err)
 		}
-		//Generate the tokens
+	}
+
+	//retrieve keys of application to see if there are already generated keys
+	appKeys, keysErr := getApplicationKeys(appId, accessToken)
+	if keysErr != nil {
+		utils.HandleErrorAndExit("Error occurred while getting CLI application keys.", keysErr)
+	}
+	appKey := getProductionKey(appKeys)
+	if appKey == nil {
+		//If the application is already created but the keys have not generated in the first time
 		keygenResponse, err := generateApplicationKeys(appId, accessToken)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while generating CLI application keys", err)
-		}
-		appKey := &utils.ApplicationKey{}
-		appKey.ConsumerKey = keygenResponse.ConsumerKey
-		appKey.ConsumerSecret = keygenResponse.ConsumerSecret
-		token, err := getNewToken(appKey, scopes)
-		if token != "" {
-			// Access Token generated successfully.
-			fmt.Println(token)
-		} else {
-			utils.HandleErrorAndExit("Error while generating token: ", err)
+		if keygenResponse == nil && err != nil {
+			utils.HandleErrorAndExit("Error occurred while generating CLI application keys.", err)
+		}
+		appKey = &utils.ApplicationKey{
+			KeyMappingId:        keygenResponse.KeyMappingId,
+			ConsumerKey:         keygenResponse.ConsumerKey,
+			ConsumerSecret:      keygenResponse.ConsumerSecret,
+			SupportedGrantTypes: keygenResponse.SupportedGrantTypes,
+			KeyType:             keygenResponse.KeyType,
 		}
 	}
+
+	tokenResponse, err := getTokenWithOptions(appId, appKey, scopes, accessToken)
+	if err != nil || tokenResponse.AccessToken == "" {
+		utils.HandleErrorAndExit("Error while generating token: ", err)
+	}
+	// Access Token generated successfully.
+	return tokenResponse
 }
 
-// Retrieve an available throttling tiers of the API or API Product
+// Retrieve the API or API Product to be subscribed along with its throttling tiers
 // @param accessToken : Access token to authenticate the devportal REST API
-// @return tiers, error
-func getAvailableAPITiers(accessToken string) ([]string, error) {
+// @return API, error
+func getApiOrProductToSubscribe(accessToken string) (*utils.APIData, error) {
 	apiId, err := searchApiOrProduct(accessToken)
 	if apiId == "" && err != nil {
 		return nil, err
 	}
-	api, err := getApiOrProduct(apiId, accessToken)
-	if err == nil && api != nil {
-		return api.Policies, err
-	} else {
-		return nil, err
+	return getApiOrProduct(apiId, accessToken)
+}
+
+// getProductionKey returns the production key of an application if there is one, or else any key of it
+func getProductionKey(appKeys *utils.AppKeyList) *utils.ApplicationKey {
+	if appKeys == nil || len(appKeys.List) == 0 {
+		return nil
+	}
+	for i := range appKeys.List {
+		if appKeys.List[i].KeyType == utils.ProductionKeyType {
+			return &appKeys.List[i]
+		}
 	}
+	return &appKeys.List[0]
 }
 
 // Retrieve an available application throttling policy
@@ -263,6 +295,21 @@ func CallDCREndpoint(credential credentials.Credential, keyGenEnv string) (strin
 	}
 }
 
+// GetDevPortalAccessToken registers the devportal client of the user and generates a token to call the devportal
+// REST API with it
+// @param credential : Credentials of the environment
+// @param environment : Environment to call the devportal REST API of
+// @return accessToken, error
+func GetDevPortalAccessToken(credential credentials.Credential, environment string) (string, error) {
+	var err error
+	credential.ClientId, credential.ClientSecret, err = CallDCREndpoint(credential, environment)
+	if err != nil {
+		return "", err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Called DCR endpoint successfully")
+	return credentials.GetOAuthAccessToken(credential, environment)
+}
+
 // Search if the application exists with the name
 // @param appName : Name of the application
 // @param accessToken : Access token to authenticate the devportal REST API
@@ -280,18 +327,20 @@ func searchApplication(appName string, accessToken string) (string, error) {
 		appData := &utils.AppList{}
 		data := []byte(resp.Body())
 		err = json.Unmarshal(data, &appData)
-		if appData.Count != 0 {
-			appId := appData.List[0].ApplicationID
-			return appId, err
+		// The search query matches the applications partially, hence pick the one with the exact name
+		for _, app := range appData.List {
+			if app.Name == appName {
+				return app.ApplicationID, err
+			}
 		}
-		return "", nil
+		return "", err
 
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
 		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("authorization failed while searching CLI application: " + appName)
+			return "", fmt.Errorf("authorization failed while searching application: " + appName)
 		}
 		return "", errors.New("Request didn't respond 200 OK for searching existing applications. " +
 			"Status: " + resp.Status())
@@ -388,7 +437,7 @@ func subscribe(appId string, accessToken string) (string, error) {
 	if apiId != "" && err == nil {
 		//If the API or API Product is present, subscribe that API or API Product to the application
 		utils.Logln(utils.LogPrefixInfo+"API or API Product name: ", apiName, "& version: ", apiVersion, "exists")
-		subId, err := subscribeApiOrProduct(apiId, appId, accessToken)
+		subId, err := subscribeApiOrProduct(apiId, appId, subscriptionThrottlingTier, accessToken)
 		if subId != "" {
 			utils.Logln(utils.LogPrefixInfo+"API or API Product", apiName, ":", apiVersion, "subscribed successfully.")
 		} else {
@@ -443,9 +492,10 @@ func getApiOrProduct(apiId string, accessToken string) (*utils.APIData, error) {
 // Subscribe the API or API Product to a given Application
 // @param apiId : API or API Product ID to be subscribed
 // @param appId : Application ID to be subscribed
+// @param tier : Subscription throttling tier
 // @param accessToken : Access token to call the REST API
 // @return subscriptionId, error
-func subscribeApiOrProduct(apiId string, appId string, accessToken string) (string, error) {
+func subscribeApiOrProduct(apiId, appId, tier, accessToken string) (string, error) {
 	//todo: subscription endpoint to be included in conf
 	subEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	subEndpoint = strings.Replace(subEndpoint, "applications", "subscriptions", -1)
@@ -476,7 +526,7 @@ func subscribeApiOrProduct(apiId string, appId string, accessToken string) (stri
 		subscriptionReq := &utils.SubscriptionCreateRequest{
 			APIID:            apiId,
 			ApplicationID:    appId,
-			ThrottlingPolicy: subscriptionThrottlingTier,
+			ThrottlingPolicy: tier,
 		}
 		//If there is no subscription, make a subscription
 		body, err := json.Marshal(subscriptionReq)
@@ -609,19 +659,25 @@ func updateApplicationDetails(appId string, body string, accessToken string) (*u
 // @param throttlingPolicy : Throttling policy to create the application
 // @return client_id, client_secret, error
 func createApplication(accessToken string, throttlingPolicy string) (string, string, error) {
-
-	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
-	headers := make(map[string]string)
-	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
 	conf := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
-	appUpdateReq := utils.AppCreateRequest{
+	return createApplicationWithRequest(accessToken, utils.AppCreateRequest{
 		Name:             utils.DefaultCliApp,
 		ThrottlingPolicy: throttlingPolicy,
 		Description:      "Default application for apictl testing purposes",
 		TokenType:        conf.Config.TokenType,
-	}
-	body, err := json.Marshal(appUpdateReq)
+	})
+}
+
+// Create an application in a given environment
+// @param accessToken : Access token to call the devportal REST API
+// @param appCreateReq : Details of the application to be created
+// @return appId, appName, error
+func createApplicationWithRequest(accessToken string, appCreateReq utils.AppCreateRequest) (string, string, error) {
+	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	body, err := json.Marshal(appCreateReq)
 	if body == nil && err != nil {
 		utils.HandleErrorAndExit("Error occurred while creating CLI application update request.", err)
 	}
@@ -639,7 +695,8 @@ func createApplication(accessToken string, throttlingPolicy string) (string, str
 		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", "", fmt.Errorf("authorization failed while trying to create the CLI application")
+			return "", "", fmt.Errorf("authorization failed while trying to create the application: " +
+				appCreateReq.Name)
 		}
 		return "", "", errors.New("Request didn't respond 200 OK for application creation. Status: " + resp.Status())
 	}
@@ -650,13 +707,31 @@ func createApplication(accessToken string, throttlingPolicy string) (string, str
 // @param scopes[] : Scopes to generate the token
 // @return accessToken, error
 func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
+	tokenResponse, err := requestToken(key, url.Values{
+		"grant_type": {utils.GrantTypeClientCredentials},
+		"scope":      {strings.Join(scopes, " ")},
+	})
+	if err != nil {
+		return "", err
+	}
+	return tokenResponse.AccessToken, nil
+}
+
+// Calling token endpoint of the environment with the given grant
+// @param key : Details of the particular key
+// @param form : Grant type and the parameters of the grant
+// @return TokenResponse, error
+func requestToken(key *utils.ApplicationKey, form url.Values) (*utils.TokenResponse, error) {
 	var tokenEndpoint string
 	if keyGenTokenEndpoint == "" {
 		tokenEndpoint = utils.GetTokenEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	} else {
 		tokenEndpoint = keyGenTokenEndpoint
 	}
-	body := "grant_type=client_credentials&scope=" + strings.Join(scopes, " ")
+	if keyGenOptions.ValidityPeriod > 0 {
+		form.Set("validity_period", strconv.Itoa(keyGenOptions.ValidityPeriod))
+	}
+	body := form.Encode()
 
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " +
@@ -668,7 +743,7 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 	resp, err := utils.InvokePOSTRequest(tokenEndpoint, headers, body)
 
 	if err != nil {
-		return "", errors.New("Token Endpoint is not valid. " + err.Error())
+		return nil, errors.New("Token Endpoint is not valid. " + err.Error())
 	}
 
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
@@ -676,18 +751,16 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 		keygenResponse := &utils.TokenResponse{}
 		data := []byte(resp.Body())
 		err = json.Unmarshal(data, &keygenResponse)
-
-		accessToken := keygenResponse.AccessToken
-		return accessToken, err
+		return keygenResponse, err
 
 	} else {
 		utils.Logf("Error: %s\n", resp.Error())
 		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("authorization failed while generating a token for the CLI application")
+			return nil, fmt.Errorf("authorization failed while generating a token for the CLI application")
 		}
-		return "", errors.New("Request didn't respond 200 OK for generating a new token. Status: " + resp.Status())
+		return nil, errors.New("Request didn't respond 200 OK for generating a new token. Status: " + resp.Status())
 	}
 
 }
@@ -719,17 +792,25 @@ func getScopes(appId string, accessToken string) ([]string, error) {
 // @param token : Token to invoke the devportal REST API
 // @return client_id, client_secret, error
 func generateApplicationKeys(appId string, token string) (*utils.KeygenResponse, error) {
+	return generateApplicationKeysWithRequest(appId, token, utils.KeygenRequest{
+		KeyType:                 utils.ProductionKeyType,
+		GrantTypesToBeSupported: utils.GrantTypesToBeSupported,
+		ValidityTime:            utils.DefaultTokenValidityPeriod,
+	})
+}
 
+// Generate client credentials for an application with the given key generation request
+// @param appId : Application ID of the app to be generated keys
+// @param token : Token to invoke the devportal REST API
+// @param generateKeyReq : Key type, key manager, grant types and callback URL of the keys
+// @return KeygenResponse, error
+func generateApplicationKeysWithRequest(appId, token string, generateKeyReq utils.KeygenRequest) (*utils.KeygenResponse,
+	error) {
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) +
 		"/" + appId + "/generate-keys"
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + token
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
-	generateKeyReq := utils.KeygenRequest{
-		KeyType:                 utils.ProductionKeyType,
-		GrantTypesToBeSupported: utils.GrantTypesToBeSupported,
-		ValidityTime:            utils.DefaultTokenValidityPeriod,
-	}
 	body, err := json.Marshal(generateKeyReq)
 	if body == nil && err != nil {
 		utils.HandleErrorAndExit("Error occurred while creating CLI application key generation request.", err)
@@ -747,7 +828,7 @@ func generateApplicationKeys(appId string, token string) (*utils.KeygenResponse,
 		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return nil, fmt.Errorf("authorization failed while generating keys of the CLI application: " + appId)
+			return nil, fmt.Errorf("authorization failed while generating keys of the application: " + appId)
 		}
 		return nil, errors.New("Request didn't respond 200 OK for application key generation. Status: " + resp.Status())
 	}
@@ -0,0 +1,306 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const identityMapContextTenantPrefix = "/t/"
+
+// IdentityMap rewrites the identities referred by the artifacts being imported, so that they can be moved between
+// tenants and user stores. Users are mapped by an exact match in Users, or else by their user store domain and their
+// tenant domain. Roles are mapped by an exact match in Roles, or else by their user store domain.
+type IdentityMap struct {
+	Users      map[string]string `yaml:"users"`
+	Roles      map[string]string `yaml:"roles"`
+	Tenants    map[string]string `yaml:"tenants"`
+	UserStores map[string]string `yaml:"userStores"`
+}
+
+// IdentityMapReport holds the identities of a project rewritten by an identity map and the ones left as they are
+type IdentityMapReport struct {
+	Project  string
+	Changes  []string
+	Unmapped []string
+}
+
+func (report *IdentityMapReport) change(format string, a ...interface{}) {
+	report.Changes = append(report.Changes, fmt.Sprintf(format, a...))
+}
+
+func (report *IdentityMapReport) unmapped(format string, a ...interface{}) {
+	unmapped := fmt.Sprintf(format, a...)
+	if !containsString(report.Unmapped, unmapped) {
+		report.Unmapped = append(report.Unmapped, unmapped)
+	}
+}
+
+// LoadIdentityMap reads an identity map file
+// @param path : Path of the identity map file
+// @return identity map, error
+func LoadIdentityMap(path string) (*IdentityMap, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	identityMap := &IdentityMap{}
+	if err = yaml.UnmarshalStrict(content, identityMap); err != nil {
+		return nil, errors.New("error reading the identity map " + path + ": " + err.Error())
+	}
+	if len(identityMap.Users) == 0 && len(identityMap.Roles) == 0 && len(identityMap.Tenants) == 0 &&
+		len(identityMap.UserStores) == 0 {
+		return nil, errors.New("the identity map " + path + " has no mappings")
+	}
+	return identityMap, nil
+}
+
+// applyIdentityMapToProject rewrites the identities of an API, API Product or application project and prints
+// the report of the rewritten and the unmapped identities
+func applyIdentityMapToProject(dir string, identityMap *IdentityMap) error {
+	reports, err := identityMap.apply(dir, "")
+	if err != nil {
+		return err
+	}
+	PrintIdentityMapReports(reports)
+	return nil
+}
+
+// PrintIdentityMapReports prints the identities rewritten in projects and the ones which were not mapped
+func PrintIdentityMapReports(reports []*IdentityMapReport) {
+	for _, report := range reports {
+		for _, change := range report.Changes {
+			fmt.Println("Identity map: " + report.Project + ": " + change)
+		}
+		if len(report.Unmapped) > 0 {
+			fmt.Println("Identity map: " + report.Project + ": unmapped identities: " +
+				strings.Join(report.Unmapped, ", "))
+		}
+	}
+}
+
+// apply rewrites the identities of the project in dir and of the API projects bundled inside it
+func (m *IdentityMap) apply(dir, parent string) ([]*IdentityMapReport, error) {
+	var reports []*IdentityMapReport
+	for _, name := range []string{"api", "api_product", "application"} {
+		definition, _, _ := resolveYamlOrJSON(filepath.Join(dir, name))
+		if definition == "" {
+			continue
+		}
+		report := &IdentityMapReport{Project: parent + filepath.Base(dir)}
+		if err := m.applyToDefinitionFile(definition, name, report); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+		break
+	}
+
+	apisDir := filepath.Join(dir, "APIs")
+	entries, err := ioutil.ReadDir(apisDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return reports, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dependentReports, err := m.apply(filepath.Join(apisDir, entry.Name()), filepath.Base(dir)+"/")
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, dependentReports...)
+	}
+	return reports, nil
+}
+
+func (m *IdentityMap) applyToDefinitionFile(path, name string, report *IdentityMapReport) error {
+	file, err := readProjectFile(path)
+	if err != nil {
+		return err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	if data == nil {
+		return errors.New("definition " + path + " has no data")
+	}
+	changes := len(report.Changes)
+	if name == "application" {
+		m.applyToApplication(data, report)
+	} else {
+		m.applyToAPI(data, report)
+	}
+	if len(report.Changes) == changes {
+		return nil
+	}
+	fileType, _ := file["type"].(string)
+	version, _ := file["version"].(string)
+	return writeProjectFile(path, fileType, version, data)
+}
+
+// applyToAPI rewrites the provider, the roles, the tenants and the context of an API or API Product
+func (m *IdentityMap) applyToAPI(data map[string]interface{}, report *IdentityMapReport) {
+	m.rewriteUser(data, "provider", "provider", report)
+	m.rewriteRoles(data, "visibleRoles", "visible role", report)
+	m.rewriteRoles(data, "accessControlRoles", "access control role", report)
+	m.rewriteTenants(data, "visibleTenants", report)
+	m.rewriteTenants(data, "subscriptionAvailableTenants", report)
+	if context, ok := data["context"].(string); ok && strings.HasPrefix(context, identityMapContextTenantPrefix) {
+		tenantAndPath := strings.SplitN(strings.TrimPrefix(context, identityMapContextTenantPrefix), "/", 2)
+		if tenant, mapped := m.Tenants[tenantAndPath[0]]; mapped {
+			tenantAndPath[0] = tenant
+			data["context"] = identityMapContextTenantPrefix + strings.Join(tenantAndPath, "/")
+			report.change("context %s -> %s", context, data["context"])
+		} else {
+			report.unmapped("context tenant %s", tenantAndPath[0])
+		}
+	}
+	// The APIs of an API Product refer to their providers
+	if apis, ok := data["apis"].([]interface{}); ok {
+		for _, api := range apis {
+			if apiData, ok := api.(map[string]interface{}); ok {
+				m.rewriteUser(apiData, "provider", "API provider", report)
+			}
+		}
+	}
+}
+
+// applyToApplication rewrites the owner and the subscriber of an application and the subscribers and the API
+// providers of its subscriptions
+func (m *IdentityMap) applyToApplication(data map[string]interface{}, report *IdentityMapReport) {
+	if info, ok := data["applicationInfo"].(map[string]interface{}); ok {
+		m.rewriteUser(info, "owner", "owner", report)
+		if subscriber, ok := info["subscriber"].(map[string]interface{}); ok {
+			m.rewriteUser(subscriber, "name", "subscriber", report)
+		}
+	}
+	subscriptions, _ := data["subscribedAPIs"].([]interface{})
+	for _, subscription := range subscriptions {
+		subscriptionData, ok := subscription.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if subscriber, ok := subscriptionData["subscriber"].(map[string]interface{}); ok {
+			m.rewriteUser(subscriber, "name", "subscriber", report)
+		}
+		if apiId, ok := subscriptionData["apiId"].(map[string]interface{}); ok {
+			m.rewriteUser(apiId, "providerName", "API provider", report)
+		}
+	}
+}
+
+func (m *IdentityMap) rewriteUser(data map[string]interface{}, key, kind string, report *IdentityMapReport) {
+	user, ok := data[key].(string)
+	if !ok || user == "" {
+		return
+	}
+	if mapped, ok := m.mapUser(user); ok {
+		data[key] = mapped
+		if mapped != user {
+			report.change("%s %s -> %s", kind, user, mapped)
+		}
+		return
+	}
+	report.unmapped("%s %s", kind, user)
+}
+
+func (m *IdentityMap) rewriteRoles(data map[string]interface{}, key, kind string, report *IdentityMapReport) {
+	roles, ok := data[key].([]interface{})
+	if !ok {
+		return
+	}
+	for i, value := range roles {
+		role, ok := value.(string)
+		if !ok || role == "" {
+			continue
+		}
+		if mapped, ok := m.mapRole(role); ok {
+			roles[i] = mapped
+			if mapped != role {
+				report.change("%s %s -> %s", kind, role, mapped)
+			}
+			continue
+		}
+		report.unmapped("%s %s", kind, role)
+	}
+}
+
+func (m *IdentityMap) rewriteTenants(data map[string]interface{}, key string, report *IdentityMapReport) {
+	tenants, ok := data[key].([]interface{})
+	if !ok {
+		return
+	}
+	for i, value := range tenants {
+		tenant, ok := value.(string)
+		if !ok || tenant == "" {
+			continue
+		}
+		if mapped, ok := m.Tenants[tenant]; ok {
+			tenants[i] = mapped
+			if mapped != tenant {
+				report.change("tenant %s -> %s", tenant, mapped)
+			}
+			continue
+		}
+		report.unmapped("tenant %s", tenant)
+	}
+}
+
+// mapUser returns the user mapped to a user and whether a mapping was found. A user which is not mapped exactly
+// is mapped by its user store domain (DOMAIN/user) and by its tenant domain (user@tenant).
+func (m *IdentityMap) mapUser(user string) (string, bool) {
+	if mapped, ok := m.Users[user]; ok {
+		return mapped, true
+	}
+	mapped, domainMapped := m.mapUserStoreDomain(user)
+	tenantMapped := false
+	if i := strings.LastIndex(mapped, "@"); i >= 0 {
+		if tenant, ok := m.Tenants[mapped[i+1:]]; ok {
+			mapped = mapped[:i+1] + tenant
+			tenantMapped = true
+		}
+	}
+	return mapped, domainMapped || tenantMapped
+}
+
+// mapRole returns the role mapped to a role and whether a mapping was found. A role which is not mapped exactly is
+// mapped by its user store domain (DOMAIN/role).
+func (m *IdentityMap) mapRole(role string) (string, bool) {
+	if mapped, ok := m.Roles[role]; ok {
+		return mapped, true
+	}
+	return m.mapUserStoreDomain(role)
+}
+
+func (m *IdentityMap) mapUserStoreDomain(name string) (string, bool) {
+	if i := strings.Index(name, "/"); i > 0 {
+		if domain, ok := m.UserStores[name[:i]]; ok {
+			return domain + name[i:], true
+		}
+	}
+	return name, false
+}
@@ -0,0 +1,186 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIdentityMap() *IdentityMap {
+	return &IdentityMap{
+		Users:      map[string]string{"admin": "admin@finance.com"},
+		Roles:      map[string]string{"Internal/publisher": "Internal/creator"},
+		Tenants:    map[string]string{"carbon.super": "finance.com", "dev.com": "finance.com"},
+		UserStores: map[string]string{"PRIMARY": "SECONDARY"},
+	}
+}
+
+func TestLoadIdentityMap(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "identity-map.yaml")
+	writeTestFile(t, path, `users:
+  admin: admin@finance.com
+userStores:
+  PRIMARY: SECONDARY
+`)
+	identityMap, err := LoadIdentityMap(path)
+	require.Nil(t, err)
+	assert.Equal(t, "admin@finance.com", identityMap.Users["admin"])
+	assert.Equal(t, "SECONDARY", identityMap.UserStores["PRIMARY"])
+
+	writeTestFile(t, path, "user:\n  admin: admin@finance.com\n")
+	_, err = LoadIdentityMap(path)
+	assert.NotNil(t, err, "Unknown sections should be rejected")
+
+	writeTestFile(t, path, "users: {}\n")
+	_, err = LoadIdentityMap(path)
+	assert.NotNil(t, err, "A map without mappings should be rejected")
+
+	_, err = LoadIdentityMap(filepath.Join(dir, "missing.yaml"))
+	assert.NotNil(t, err)
+}
+
+func TestIdentityMapMapUser(t *testing.T) {
+	identityMap := testIdentityMap()
+	for user, expected := range map[string]string{
+		"admin":               "admin@finance.com",
+		"PRIMARY/alice":       "SECONDARY/alice",
+		"bob@dev.com":         "bob@finance.com",
+		"PRIMARY/eve@dev.com": "SECONDARY/eve@finance.com",
+	} {
+		mapped, ok := identityMap.mapUser(user)
+		assert.True(t, ok, user)
+		assert.Equal(t, expected, mapped, user)
+	}
+	mapped, ok := identityMap.mapUser("carol@other.com")
+	assert.False(t, ok)
+	assert.Equal(t, "carol@other.com", mapped)
+}
+
+func TestIdentityMapRewritesAPIProductAndDependentAPIs(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "LeasingProduct")
+	writeTestFile(t, filepath.Join(dir, "api_product.yaml"), `type: api_product
+version: v4.1.0
+data:
+  name: LeasingProduct
+  provider: admin
+  context: /t/dev.com/leasing
+  visibleRoles:
+    - Internal/publisher
+    - PRIMARY/leasing
+    - auditor
+  visibleTenants:
+    - dev.com
+    - other.com
+  apis:
+    - name: PizzaAPI
+      provider: bob@dev.com
+`)
+	writeTestFile(t, filepath.Join(dir, "APIs", "PizzaAPI-1.0.0", "api.yaml"), `type: api
+version: v4.1.0
+data:
+  name: PizzaAPI
+  provider: bob@dev.com
+  accessControlRoles:
+    - PRIMARY/pizza
+`)
+
+	reports, err := testIdentityMap().apply(dir, "")
+	require.Nil(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, "LeasingProduct", reports[0].Project)
+	assert.Equal(t, []string{"visible role auditor", "tenant other.com"}, reports[0].Unmapped)
+	assert.Equal(t, "LeasingProduct/PizzaAPI-1.0.0", reports[1].Project)
+	assert.Empty(t, reports[1].Unmapped)
+
+	product, err := readProjectFile(filepath.Join(dir, "api_product.yaml"))
+	require.Nil(t, err)
+	assert.Equal(t, "api_product", product["type"])
+	data := product["data"].(map[string]interface{})
+	assert.Equal(t, "admin@finance.com", data["provider"])
+	assert.Equal(t, "/t/finance.com/leasing", data["context"])
+	assert.Equal(t, []interface{}{"Internal/creator", "SECONDARY/leasing", "auditor"}, data["visibleRoles"])
+	assert.Equal(t, []interface{}{"finance.com", "other.com"}, data["visibleTenants"])
+	assert.Equal(t, "bob@finance.com", data["apis"].([]interface{})[0].(map[string]interface{})["provider"])
+
+	api, err := readProjectFile(filepath.Join(dir, "APIs", "PizzaAPI-1.0.0", "api.yaml"))
+	require.Nil(t, err)
+	data = api["data"].(map[string]interface{})
+	assert.Equal(t, "bob@finance.com", data["provider"])
+	assert.Equal(t, []interface{}{"SECONDARY/pizza"}, data["accessControlRoles"])
+}
+
+func TestIdentityMapRewritesApplication(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "admin_SampleApp")
+	writeTestFile(t, filepath.Join(dir, "application.yaml"), `type: application
+version: v4.1.0
+data:
+  applicationInfo:
+    name: SampleApp
+    owner: admin
+    subscriber:
+      name: admin
+  subscribedAPIs:
+    - apiId:
+        providerName: bob@dev.com
+        apiName: PizzaAPI
+      subscriber:
+        name: admin
+    - apiId:
+        providerName: carol@other.com
+        apiName: LeasingAPI
+      subscriber:
+        name: admin
+`)
+
+	reports, err := testIdentityMap().apply(dir, "")
+	require.Nil(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, []string{"API provider carol@other.com"}, reports[0].Unmapped)
+
+	application, err := readProjectFile(filepath.Join(dir, "application.yaml"))
+	require.Nil(t, err)
+	data := application["data"].(map[string]interface{})
+	info := data["applicationInfo"].(map[string]interface{})
+	assert.Equal(t, "admin@finance.com", info["owner"])
+	assert.Equal(t, "admin@finance.com", info["subscriber"].(map[string]interface{})["name"])
+	subscription := data["subscribedAPIs"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "bob@finance.com", subscription["apiId"].(map[string]interface{})["providerName"])
+	assert.Equal(t, "admin@finance.com", subscription["subscriber"].(map[string]interface{})["name"])
+}
+
+func TestIdentityMapLeavesUnchangedProjectAsItIs(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "PizzaAPI")
+	content := "type: api\nversion: v4.1.0\ndata:\n  provider: carol@other.com\n"
+	writeTestFile(t, filepath.Join(dir, "api.yaml"), content)
+
+	reports, err := testIdentityMap().apply(dir, "")
+	require.Nil(t, err)
+	require.Len(t, reports, 1)
+	assert.Empty(t, reports[0].Changes)
+	assert.Equal(t, []string{"provider carol@other.com"}, reports[0].Unmapped)
+	written, err := ioutil.ReadFile(filepath.Join(dir, "api.yaml"))
+	require.Nil(t, err)
+	assert.Equal(t, content, string(written))
+}
@@ -202,17 +202,25 @@ func importAPI(endpoint, filePath, accessToken string, extraParams map[string]st
 // ImportAPIToEnv function is used with import-api command
 func ImportAPIToEnv(accessOAuthToken, importEnvironment, importPath, apiParamsPath string, importAPIUpdate,
 	preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments bool, dryRun bool,
-	apiLoggingCmdFormat string) error {
+	apiLoggingCmdFormat string, identityMap *IdentityMap) error {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(importEnvironment, utils.MainConfigFilePath)
 	return ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiParamsPath, importAPIUpdate,
-		preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments, dryRun, apiLoggingCmdFormat)
+		preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments, dryRun, apiLoggingCmdFormat,
+		identityMap)
 }
 
 // ImportAPI function is used with import-api command
 func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiParamsPath string, importAPIUpdate,
 	preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments bool,
-	dryRun bool, apiLoggingCmdFormat string) error {
+	dryRun bool, apiLoggingCmdFormat string, identityMap *IdentityMap) error {
 	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName)
+	importPath, ociCleanupFunc, err := resolveOCIImportPath(importPath, utils.ProjectTypeApi)
+	if err != nil {
+		return err
+	}
+	if ociCleanupFunc != nil {
+		defer ociCleanupFunc()
+	}
 	resolvedAPIFilePath, err := resolveImportFilePath(importPath, exportDirectory)
 	if err != nil {
 		return err
@@ -261,6 +269,20 @@ func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPat
 		}
 	}
 
+	if identityMap != nil {
+		// Rewrite the provider, roles and tenants of the API for the target environment
+		err = applyIdentityMapToProject(apiFilePath, identityMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Fail early if the API is deployed to a gateway environment or vhost that does not exist
+	err = ValidateDeploymentEnvironments(accessOAuthToken, importEnvironment, apiFilePath)
+	if err != nil {
+		return err
+	}
+
 	// if apiFilePath contains a directory, zip it. Otherwise, leave it as it is.
 	apiFilePath, err, cleanupFunc := utils.CreateZipFileFromProject(apiFilePath, importAPISkipCleanup)
 	if err != nil {
@@ -80,6 +80,13 @@ func importAPIPolicy(endpoint string, importPath string, accessToken string, isO
 	policyName := policyPaths[len(policyPaths)-1]
 
 	for _, file := range files {
+		if file.Name() == utils.PolicySampleParamsFile {
+			// Sample parameters are only used to render the policy locally
+			if err := os.Remove(filepath.Join(tmpPath, file.Name())); err != nil {
+				return err
+			}
+			continue
+		}
 		originalFilePath := tmpPath + "/" + file.Name()
 		ext := filepath.Ext(originalFilePath)
 
@@ -88,8 +95,8 @@ func importAPIPolicy(endpoint string, importPath string, accessToken string, isO
 		isExt := ext == ".yaml" || ext == ".yml" || ext == ".json" || ext == ".j2" || ext == ".gotmpl"
 
 		if isExt && expectedPolicyFileName != file.Name() {
-			errorTxt := file.Name() + " should be equivalent to the policy name " + policyName
-			utils.HandleErrorAndExit("Policy Directory name and policy files are not consistent", errors.New(errorTxt))
+			return errors.New("policy directory name and policy files are not consistent: " + file.Name() +
+				" should be equivalent to the policy name " + policyName)
 		}
 	}
 
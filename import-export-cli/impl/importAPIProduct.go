@@ -80,18 +80,25 @@ func importAPIProduct(endpoint, filePath, accessToken string, extraParams map[st
 // ImportAPIProductToEnv function is used with import-api-product command
 func ImportAPIProductToEnv(accessOAuthToken, importEnvironment, importPath, apiProductParamsPath string, importAPIs, importAPIsUpdate,
 	importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup, rotateRevision,
-	skipDeployments bool) error {
+	skipDeployments bool, identityMap *IdentityMap) error {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(importEnvironment, utils.MainConfigFilePath)
 	return ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiProductParamsPath, importAPIs,
 		importAPIsUpdate, importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup, rotateRevision,
-		skipDeployments)
+		skipDeployments, identityMap)
 }
 
 // ImportAPIProduct function is used with import-api-product command
 func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiProductParamsPath string, importAPIs, importAPIsUpdate,
 	importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup,
-	rotateRevision, skipDeployments bool) error {
+	rotateRevision, skipDeployments bool, identityMap *IdentityMap) error {
 	var exportDirectory = filepath.Join(utils.ExportDirectory, utils.ExportedApiProductsDirName)
+	importPath, ociCleanupFunc, err := resolveOCIImportPath(importPath, utils.ProjectTypeApiProduct)
+	if err != nil {
+		return err
+	}
+	if ociCleanupFunc != nil {
+		defer ociCleanupFunc()
+	}
 
 	resolvedAPIProductFilePath, err := resolveImportAPIProductFilePath(importPath, exportDirectory)
 	if err != nil {
@@ -137,6 +144,14 @@ func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, im
 		}
 	}
 
+	if identityMap != nil {
+		// Rewrite the providers, roles and tenants of the API Product and its APIs for the target environment
+		err = applyIdentityMapToProject(apiProductFilePath, identityMap)
+		if err != nil {
+			return err
+		}
+	}
+
 	if skipDeployments {
 		//If skip deployments flag used, deployment_environments files will be removed from import artifacts
 		loc := filepath.Join(apiProductFilePath, utils.DeploymentEnvFile)
@@ -147,6 +162,12 @@ func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, im
 		}
 	}
 
+	// Fail early if the API Product is deployed to a gateway environment or vhost that does not exist
+	err = ValidateDeploymentEnvironments(accessOAuthToken, importEnvironment, apiProductFilePath)
+	if err != nil {
+		return err
+	}
+
 	// If apiProductFilePath contains a directory, zip it. Otherwise, leave it as it is.
 	apiProductFilePath, err, cleanupFunc := utils.CreateZipFileFromProject(apiProductFilePath, importAPIProductSkipCleanup)
 	if err != nil {
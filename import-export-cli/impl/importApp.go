@@ -44,11 +44,19 @@ import (
 // @param skipSubscriptions: Skip importing subscriptions
 // @param skipKeys: skip importing keys of application
 // @param skipCleanup: skip cleaning up temporary files created during the operation
+// @param identityMap: Identity map to rewrite the owner and the subscribers of the application, or nil
+// @param keyOptions: How the keys of the application are moved to the environment, or nil
 func ImportApplicationToEnv(accessToken, environment, filename, appOwner string, updateApplication, preserveOwner,
-	skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
+	skipSubscriptions, skipKeys, skipCleanup bool, identityMap *IdentityMap,
+	keyOptions *AppKeyImportOptions) (*http.Response, error) {
 	devportalApplicationsEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	if keyOptions.remapsKeys(skipKeys) {
+		if err := resolveAppKeyImportOptions(accessToken, environment, keyOptions); err != nil {
+			return nil, err
+		}
+	}
 	return ImportApplication(accessToken, devportalApplicationsEndpoint, filename, appOwner, updateApplication, preserveOwner,
-		skipSubscriptions, skipKeys, skipCleanup)
+		skipSubscriptions, skipKeys, skipCleanup, identityMap, keyOptions)
 }
 
 // ImportApplication function is used with import-app command
@@ -61,8 +69,18 @@ func ImportApplicationToEnv(accessToken, environment, filename, appOwner string,
 // @param skipSubscriptions: Skip importing subscriptions
 // @param skipKeys: skip importing keys of application
 // @param skipCleanup: skip cleaning up temporary files created during the operation
+// @param identityMap: Identity map to rewrite the owner and the subscribers of the application, or nil
+// @param keyOptions: How the keys of the application are moved to the environment, or nil
 func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, appOwner string, updateApplication, preserveOwner,
-	skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
+	skipSubscriptions, skipKeys, skipCleanup bool, identityMap *IdentityMap,
+	keyOptions *AppKeyImportOptions) (*http.Response, error) {
+
+	remapKeys := keyOptions.remapsKeys(skipKeys)
+	regenerateKeys := remapKeys && keyOptions.RegenerateKeys
+	if regenerateKeys {
+		// The exported keys are replaced by the keys generated once the application is imported
+		skipKeys = true
+	}
 
 	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedAppsDirName)
 	devportalApplicationsEndpoint = utils.AppendSlashToString(devportalApplicationsEndpoint)
@@ -74,11 +92,51 @@ func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, app
 		utils.SearchAndTag + "update=" + strconv.FormatBool(updateApplication)
 	utils.Logln(utils.LogPrefixInfo + "Import URL: " + applicationImportEndpoint)
 
+	filename, ociCleanupFunc, err := resolveOCIImportPath(filename, utils.ProjectTypeApplication)
+	if err != nil {
+		return nil, err
+	}
+	if ociCleanupFunc != nil {
+		defer ociCleanupFunc()
+	}
+
 	applicationFilePath, err := resolveApplicationImportFilePath(filename, exportDirectory)
 	if err != nil {
 		utils.HandleErrorAndExit("Error creating request.", err)
 	}
 
+	var keygenRequests []utils.KeygenRequest
+	if identityMap != nil || remapKeys {
+		utils.Logln(utils.LogPrefixInfo + "Creating workspace")
+		tmpPath, err := utils.GetTempCloneFromDirOrZip(applicationFilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if skipCleanup {
+				utils.Logln(utils.LogPrefixInfo+"Leaving", tmpPath)
+				return
+			}
+			utils.Logln(utils.LogPrefixInfo+"Deleting", tmpPath)
+			if err := os.RemoveAll(tmpPath); err != nil {
+				utils.Logln(utils.LogPrefixError + err.Error())
+			}
+		}()
+		// Rewrite the owner and the subscribers of the application for the target environment
+		if identityMap != nil {
+			if err = applyIdentityMapToProject(tmpPath, identityMap); err != nil {
+				return nil, err
+			}
+		}
+		// Rewrite the keys of the application for the key managers of the target environment
+		if remapKeys {
+			if keygenRequests, err = remapApplicationKeys(tmpPath, keyOptions); err != nil {
+				return nil, err
+			}
+		}
+		applicationFilePath = tmpPath
+	}
+
 	// If applicationFilePath contains a directory, zip it. Otherwise, leave it as it is.
 	applicationFilePath, err, cleanupFunc := utils.CreateZipFileFromProject(applicationFilePath, skipCleanup)
 	if err != nil {
@@ -100,6 +158,12 @@ func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, app
 	if resp.StatusCode() == http.StatusCreated || resp.StatusCode() == http.StatusOK {
 		// 201 Created or 200 OK
 		fmt.Println("Successfully imported Application.")
+		if regenerateKeys {
+			if err = regenerateApplicationKeys(accessToken, devportalApplicationsEndpoint, resp.Body(),
+				keygenRequests); err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	} else {
 		// We have an HTTP error
@@ -19,7 +19,6 @@
 package impl
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -63,14 +62,7 @@ func importThrottlingPolicy(endpoint string, importPath string, accessToken stri
 		return nil
 	} else {
 		// We have an HTTP error
-		if resp.StatusCode() == http.StatusConflict && ThrottlePolicyUpdate {
-			fmt.Println("Cannot Update")
-		}
-		fmt.Println("Error importing Throttling Policy.")
-		fmt.Println("Status: " + resp.Status())
-		fmt.Println("Response:", resp.IsSuccess())
-
-		return errors.New(resp.Status())
+		return getPublisherResponseError(resp, "importing throttling policy "+filepath.Base(resolvedPolicyFilePath))
 	}
 }
 
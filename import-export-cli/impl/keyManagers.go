@@ -0,0 +1,415 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	keyManagerIdHeader          = "ID"
+	keyManagerNameHeader        = "NAME"
+	keyManagerTypeHeader        = "TYPE"
+	keyManagerEnabledHeader     = "ENABLED"
+	keyManagerDescriptionHeader = "DESCRIPTION"
+
+	defaultKeyManagerTableFormat = "table {{.Id}}\t{{.Name}}\t{{.Type}}\t{{.Enabled}}\t{{.Description}}"
+
+	// keyManagerFilePermission is the permission of the exported key manager files, which hold client ids
+	keyManagerFilePermission = 0600
+)
+
+// keyManagerSecretFields are the parts of the names of the key manager properties which hold secrets. The values of
+// these properties are not exported and have to be provided with a params file when importing the key manager.
+var keyManagerSecretFields = []string{"secret", "password", "apikey", "api_key"}
+
+// keyManagerFile is the content of an exported key manager file
+type keyManagerFile struct {
+	Type    string                 `json:"type" yaml:"type"`
+	Version string                 `json:"version" yaml:"version"`
+	Data    map[string]interface{} `json:"data" yaml:"data"`
+}
+
+// keyManager holds information about a key manager for outputting
+type keyManager struct {
+	info utils.KeyManagerInfo
+}
+
+// Id of the key manager
+func (k keyManager) Id() string {
+	return k.info.ID
+}
+
+// Name of the key manager
+func (k keyManager) Name() string {
+	return k.info.Name
+}
+
+// Type of the key manager
+func (k keyManager) Type() string {
+	return k.info.Type
+}
+
+// Enabled status of the key manager
+func (k keyManager) Enabled() bool {
+	return k.info.Enabled
+}
+
+// Description of the key manager
+func (k keyManager) Description() string {
+	return k.info.Description
+}
+
+// MarshalJSON marshals the key manager using custom marshaller which uses methods instead of fields
+func (k *keyManager) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(k)
+}
+
+// GetKeyManagers retrieves the key managers of an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to retrieve the key managers from
+// @return array of key managers, error
+func GetKeyManagers(accessToken, environment string) ([]utils.KeyManagerInfo, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(getKeyManagersEndpoint(environment), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the key managers")
+	}
+	keyManagerList := &utils.KeyManagerListResponse{}
+	if err = json.Unmarshal(resp.Body(), keyManagerList); err != nil {
+		return nil, err
+	}
+	return keyManagerList.List, nil
+}
+
+// ExportKeyManager writes the configuration of a key manager to a file
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to export the key manager from
+// @param name : Name of the key manager
+// @param exportDirectory : Directory to write the key manager file to
+// @param exportFormat : File format of the key manager file (YAML or JSON)
+// @return path of the exported file, error
+func ExportKeyManager(accessToken, environment, name, exportDirectory, exportFormat string) (string, error) {
+	id, err := getKeyManagerId(accessToken, environment, name)
+	if err != nil {
+		return "", err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(getKeyManagersEndpoint(environment)+"/"+id, headers)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", getPublisherResponseError(resp, "retrieving the key manager "+name)
+	}
+	data := make(map[string]interface{})
+	if err = json.Unmarshal(resp.Body(), &data); err != nil {
+		return "", err
+	}
+	if err = utils.CreateDirIfNotExist(exportDirectory); err != nil {
+		return "", err
+	}
+	return writeKeyManagerFile(exportDirectory, name, data, exportFormat)
+}
+
+// ImportKeyManager creates or updates a key manager from a key manager file
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to import the key manager to
+// @param path : Key manager file
+// @param paramsPath : Params file with the environment specific values of the key manager (optional)
+// @param update : Update the key manager if it already exists
+// @return name of the imported key manager, error
+func ImportKeyManager(accessToken, environment, path, paramsPath string, update bool) (string, error) {
+	data, err := readKeyManagerFile(path)
+	if err != nil {
+		return "", err
+	}
+	if paramsPath != "" {
+		if data, err = applyKeyManagerParams(data, paramsPath, environment); err != nil {
+			return "", err
+		}
+	}
+	name, _ := data["name"].(string)
+	if missing := findEmptyKeyManagerSecrets(data, ""); len(missing) > 0 {
+		return name, errors.New("secrets of the key manager " + name + " are not provided: " +
+			strings.Join(missing, ", ") + ". Provide them with a params file")
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return name, err
+	}
+
+	keyManagers, err := GetKeyManagers(accessToken, environment)
+	if err != nil {
+		return name, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	endpoint := getKeyManagersEndpoint(environment)
+	for _, keyManager := range keyManagers {
+		if keyManager.Name != name {
+			continue
+		}
+		if !update {
+			return name, errors.New("key manager " + name + " already exists. Use --update to update it")
+		}
+		resp, err := utils.InvokePUTRequestWithoutQueryParams(endpoint+"/"+keyManager.ID, headers, string(body))
+		if err != nil {
+			return name, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return name, getPublisherResponseError(resp, "updating the key manager "+name)
+		}
+		return name, nil
+	}
+	resp, err := utils.InvokePOSTRequest(endpoint, headers, string(body))
+	if err != nil {
+		return name, err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return name, getPublisherResponseError(resp, "creating the key manager "+name)
+	}
+	return name, nil
+}
+
+// DeleteKeyManager deletes a key manager
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the key manager
+// @param name : Name of the key manager
+// @return error
+func DeleteKeyManager(accessToken, environment, name string) error {
+	id, err := getKeyManagerId(accessToken, environment, name)
+	if err != nil {
+		return err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(getKeyManagersEndpoint(environment)+"/"+id, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting the key manager "+name)
+	}
+	return nil
+}
+
+func getKeyManagersEndpoint(environment string) string {
+	return utils.AppendSlashToString(utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)) +
+		"key-managers"
+}
+
+func getKeyManagerId(accessToken, environment, name string) (string, error) {
+	keyManagers, err := GetKeyManagers(accessToken, environment)
+	if err != nil {
+		return "", err
+	}
+	for _, keyManager := range keyManagers {
+		if keyManager.Name == name {
+			return keyManager.ID, nil
+		}
+	}
+	return "", errors.New("key manager " + name + " not found")
+}
+
+// writeKeyManagerFile writes a key manager to <name>.yaml or <name>.json in a directory without its secrets
+func writeKeyManagerFile(directory, name string, data map[string]interface{}, exportFormat string) (string,
+	error) {
+	delete(data, "id")
+	if removed := removeKeyManagerSecrets(data, ""); len(removed) > 0 {
+		fmt.Println(utils.LogPrefixWarning + "Secrets of the key manager " + name + " are not exported: " +
+			strings.Join(removed, ", "))
+	}
+	file := keyManagerFile{Type: utils.SchemaTypeKeyManager, Version: utils.MigrateProjectLatestSchemaVersion,
+		Data: data}
+	var content []byte
+	var err error
+	path := filepath.Join(directory, name)
+	if strings.EqualFold(exportFormat, "json") {
+		path += ".json"
+		content, err = json.MarshalIndent(file, "", "  ")
+	} else {
+		path += ".yaml"
+		content, err = yaml.Marshal(file)
+	}
+	if err != nil {
+		return "", err
+	}
+	return path, ioutil.WriteFile(path, content, keyManagerFilePermission)
+}
+
+// removeKeyManagerSecrets empties the values of the secret properties of a key manager configuration
+// @param data : Key manager configuration
+// @param prefix : Path of the configuration in the key manager
+// @return paths of the emptied properties
+func removeKeyManagerSecrets(data map[string]interface{}, prefix string) []string {
+	var removed []string
+	for key, value := range data {
+		if properties, ok := value.(map[string]interface{}); ok {
+			removed = append(removed, removeKeyManagerSecrets(properties, prefix+key+".")...)
+			continue
+		}
+		if value == nil || value == "" {
+			continue
+		}
+		if isKeyManagerSecret(key) {
+			data[key] = ""
+			removed = append(removed, prefix+key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// findEmptyKeyManagerSecrets finds the secret properties of a key manager configuration which have empty values
+// @param data : Key manager configuration
+// @param prefix : Path of the configuration in the key manager
+// @return paths of the empty properties
+func findEmptyKeyManagerSecrets(data map[string]interface{}, prefix string) []string {
+	var empty []string
+	for key, value := range data {
+		if properties, ok := value.(map[string]interface{}); ok {
+			empty = append(empty, findEmptyKeyManagerSecrets(properties, prefix+key+".")...)
+			continue
+		}
+		if value == "" && isKeyManagerSecret(key) {
+			empty = append(empty, prefix+key)
+		}
+	}
+	sort.Strings(empty)
+	return empty
+}
+
+func isKeyManagerSecret(key string) bool {
+	for _, field := range keyManagerSecretFields {
+		if strings.Contains(strings.ToLower(key), field) {
+			return true
+		}
+	}
+	return false
+}
+
+// readKeyManagerFile reads the key manager configuration of a key manager file
+func readKeyManagerFile(path string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		if content, err = utils.YamlToJson(content); err != nil {
+			return nil, errors.New("error reading " + path + ": " + err.Error())
+		}
+	}
+	file := &keyManagerFile{}
+	if err = json.Unmarshal(content, file); err != nil {
+		return nil, errors.New("error reading " + path + ": " + err.Error())
+	}
+	if file.Type != utils.SchemaTypeKeyManager {
+		return nil, errors.New(path + " is not a key manager file")
+	}
+	if name, _ := file.Data["name"].(string); name == "" {
+		return nil, errors.New("name of the key manager is not defined in " + path)
+	}
+	delete(file.Data, "id")
+	return file.Data, nil
+}
+
+// applyKeyManagerParams merges the configs of the environment in the params file over the key manager configuration
+func applyKeyManagerParams(data map[string]interface{}, paramsPath, environment string) (map[string]interface{},
+	error) {
+	keyManagerParams, err := params.LoadKeyManagerParamsFromFile(paramsPath)
+	if err != nil {
+		return nil, errors.New("error loading params file " + paramsPath + ": " + err.Error())
+	}
+	envParams := keyManagerParams.GetEnv(environment)
+	if envParams == nil || len(envParams.Config) == 0 {
+		utils.Logln(utils.LogPrefixInfo + "No key manager params defined for the environment " + environment)
+		return data, nil
+	}
+	configs, err := yaml.Marshal(envParams.Config)
+	if err != nil {
+		return nil, err
+	}
+	if configs, err = utils.YamlToJson(configs); err != nil {
+		return nil, err
+	}
+	original, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := utils.MergeJSON(original, configs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{})
+	if err = json.Unmarshal(merged, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PrintKeyManagers prints the key managers in the given format
+// @param keyManagers : Key managers
+// @param format : Format type of the output
+func PrintKeyManagers(keyManagers []utils.KeyManagerInfo, format string) {
+	if format == "" {
+		format = defaultKeyManagerTableFormat
+	}
+	keyManagerContext := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, info := range keyManagers {
+			if err := t.Execute(w, &keyManager{info}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	keyManagerTableHeaders := map[string]string{
+		"Id":          keyManagerIdHeader,
+		"Name":        keyManagerNameHeader,
+		"Type":        keyManagerTypeHeader,
+		"Enabled":     keyManagerEnabledHeader,
+		"Description": keyManagerDescriptionHeader,
+	}
+	if err := keyManagerContext.Write(renderer, keyManagerTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
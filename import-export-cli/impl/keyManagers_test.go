@@ -0,0 +1,146 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyManagerFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	data := map[string]interface{}{"id": "123", "name": "Okta", "type": "Okta", "enabled": true,
+		"additionalProperties": map[string]interface{}{"client_id": "abc"}}
+
+	path, err := writeKeyManagerFile(dir, "Okta", data, "YAML")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "Okta.yaml"), path)
+
+	read, err := readKeyManagerFile(path)
+	assert.Nil(t, err)
+	assert.Nil(t, read["id"])
+	assert.Equal(t, "Okta", read["name"])
+	assert.Equal(t, true, read["enabled"])
+	assert.Equal(t, "abc", read["additionalProperties"].(map[string]interface{})["client_id"])
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestWriteKeyManagerFileRemovesSecrets(t *testing.T) {
+	dir := t.TempDir()
+	data := map[string]interface{}{"name": "Okta", "type": "Okta",
+		"additionalProperties": map[string]interface{}{"client_id": "abc", "client_secret": "s3cret",
+			"Password": "pa55"}}
+
+	path, err := writeKeyManagerFile(dir, "Okta", data, "JSON")
+	assert.Nil(t, err)
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(content), "s3cret")
+	assert.NotContains(t, string(content), "pa55")
+
+	read, err := readKeyManagerFile(path)
+	assert.Nil(t, err)
+	properties := read["additionalProperties"].(map[string]interface{})
+	assert.Equal(t, "abc", properties["client_id"])
+	assert.Equal(t, "", properties["client_secret"])
+	assert.Equal(t, "", properties["Password"])
+}
+
+func TestReadKeyManagerFileRejectsOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scope.yaml")
+	writeTestFile(t, path, "type: scope\nversion: v4.5.0\ndata:\n  name: read_orders\n")
+	_, err := readKeyManagerFile(path)
+	assert.NotNil(t, err)
+
+	path = filepath.Join(dir, "km.yaml")
+	writeTestFile(t, path, "type: key_manager\nversion: v4.5.0\ndata:\n  type: Okta\n")
+	_, err = readKeyManagerFile(path)
+	assert.NotNil(t, err)
+}
+
+func TestApplyKeyManagerParams(t *testing.T) {
+	dir := t.TempDir()
+	paramsPath := filepath.Join(dir, "params.yaml")
+	os.Setenv("APICTL_TEST_OKTA_SECRET", "s3cret")
+	defer os.Unsetenv("APICTL_TEST_OKTA_SECRET")
+	writeTestFile(t, paramsPath, `environments:
+  - name: prod
+    configs:
+      issuer: https://prod.okta.com/oauth2/default
+      additionalProperties:
+        client_secret: ${APICTL_TEST_OKTA_SECRET}
+`)
+	data := map[string]interface{}{"name": "Okta", "issuer": "https://dev.okta.com/oauth2/default",
+		"additionalProperties": map[string]interface{}{"client_id": "abc", "client_secret": ""}}
+
+	merged, err := applyKeyManagerParams(data, paramsPath, "prod")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://prod.okta.com/oauth2/default", merged["issuer"])
+	properties := merged["additionalProperties"].(map[string]interface{})
+	assert.Equal(t, "abc", properties["client_id"])
+	assert.Equal(t, "s3cret", properties["client_secret"])
+
+	unchanged, err := applyKeyManagerParams(data, paramsPath, "dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://dev.okta.com/oauth2/default", unchanged["issuer"])
+}
+
+func TestFindEmptyKeyManagerSecrets(t *testing.T) {
+	data := map[string]interface{}{"name": "Okta", "issuer": "",
+		"additionalProperties": map[string]interface{}{"client_id": "abc", "client_secret": "", "Password": "",
+			"apiKey": "key"}}
+	assert.Equal(t, []string{"additionalProperties.Password", "additionalProperties.client_secret"},
+		findEmptyKeyManagerSecrets(data, ""))
+
+	data["additionalProperties"] = map[string]interface{}{"client_secret": "s3cret", "Password": "pa55"}
+	assert.Empty(t, findEmptyKeyManagerSecrets(data, ""))
+}
+
+func TestImportKeyManagerRejectsMissingSecrets(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Okta.yaml")
+	writeTestFile(t, path, `type: key_manager
+version: v4.1.0
+data:
+  name: Okta
+  additionalProperties:
+    client_id: abc
+    client_secret: ""
+    api_key: ""
+`)
+	paramsPath := filepath.Join(dir, "params.yaml")
+	writeTestFile(t, paramsPath, `environments:
+  - name: prod
+    configs:
+      additionalProperties:
+        client_secret: s3cret
+`)
+
+	_, err := ImportKeyManager("access-token", "prod", path, paramsPath, false)
+	assert.EqualError(t, err, "secrets of the key manager Okta are not provided: additionalProperties.api_key. "+
+		"Provide them with a params file")
+}
@@ -0,0 +1,1131 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// legacySchemaVersion is the version reported for projects exported from APIM 3.x, which have no version header
+const legacySchemaVersion = "3.x"
+
+// ProjectMigrationReport holds the outcome of migrating a single project or deployment directory
+type ProjectMigrationReport struct {
+	Path          string
+	SourceVersion string
+	TargetVersion string
+	Changes       []string
+	LossyChanges  []string
+	Err           error
+}
+
+func (report *ProjectMigrationReport) change(format string, a ...interface{}) {
+	report.Changes = append(report.Changes, fmt.Sprintf(format, a...))
+}
+
+func (report *ProjectMigrationReport) lossy(format string, a ...interface{}) {
+	report.LossyChanges = append(report.LossyChanges, fmt.Sprintf(format, a...))
+}
+
+// Migrated returns whether any file of the project was changed during the migration
+func (report *ProjectMigrationReport) Migrated() bool {
+	return report.Err == nil && len(report.Changes) > 0
+}
+
+// operationPolicySpecification is the policy definition written for each migrated mediation sequence
+type operationPolicySpecification struct {
+	Type    string                           `yaml:"type"`
+	Version string                           `yaml:"version"`
+	Data    operationPolicySpecificationData `yaml:"data"`
+}
+
+type operationPolicySpecificationData struct {
+	Category          string        `yaml:"category"`
+	Name              string        `yaml:"name"`
+	Version           string        `yaml:"version"`
+	DisplayName       string        `yaml:"displayName"`
+	Description       string        `yaml:"description"`
+	ApplicableFlows   []string      `yaml:"applicableFlows"`
+	SupportedGateways []string      `yaml:"supportedGateways"`
+	SupportedApiTypes []string      `yaml:"supportedApiTypes"`
+	PolicyAttributes  []interface{} `yaml:"policyAttributes"`
+}
+
+// orderedProjectFile is used to keep the header fields on top when writing a project file as JSON
+type orderedProjectFile struct {
+	Type    string      `json:"type"`
+	Version string      `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+// Fields of a 3.x API definition which are generated by the server and have no use in the target schema
+var droppedLegacyAPIFields = map[string]bool{
+	"documents":                  true,
+	"lastUpdated":                true,
+	"lastUpdatedTimestamp":       true,
+	"createdTime":                true,
+	"apiHeaderChanged":           true,
+	"apiResourcePatternsChanged": true,
+	"isPublishedDefaultVersion":  true,
+	"isLatest":                   true,
+	"rating":                     true,
+	"environmentList":            true,
+	"thumbnailUrl":               true,
+	"endpoints":                  true,
+	"contextTemplate":            true,
+	"environments":               true,
+}
+
+// Auth types of 3.x URI templates and their equivalents in the target schema
+var legacyAuthTypes = map[string]string{
+	"Any":              "Application & Application User",
+	"Application":      "Application",
+	"Application_User": "Application User",
+	"None":             "None",
+}
+
+// Mediation policy types and the flows of the operation policies they are converted to
+var mediationPolicyFlows = map[string]string{
+	"IN":    "request",
+	"OUT":   "response",
+	"FAULT": "fault",
+}
+
+// ResolveMigrationTargetVersion converts the target given by the user (eg: 4.x, 4.2.0, v4.5.0) to a schema version
+// @param target : Target version given by the user
+// @return schema version written in the project files
+// @return error
+func ResolveMigrationTargetVersion(target string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(target)), "v"), ".")
+	if parts[0] != "4" || len(parts) > 3 {
+		return "", errors.New("unsupported target version " + target + ". Projects can only be migrated to 4.x")
+	}
+	if len(parts) == 1 || parts[1] == "x" {
+		return utils.MigrateProjectLatestSchemaVersion, nil
+	}
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return "", errors.New("invalid target version " + target)
+		}
+	}
+	version := "v" + strings.Join(parts, ".")
+	if compareSchemaVersions(version, utils.MigrateProjectLatestSchemaVersion) > 0 {
+		return "", errors.New("target version " + target + " is newer than the latest supported version " +
+			utils.MigrateProjectLatestSchemaVersion)
+	}
+	return version, nil
+}
+
+// compareSchemaVersions compares two schema versions (eg: v4.0.0). Empty and 3.x versions are older than any other.
+func compareSchemaVersions(first, second string) int {
+	firstParts, secondParts := parseSchemaVersion(first), parseSchemaVersion(second)
+	for i := 0; i < 3; i++ {
+		if firstParts[i] != secondParts[i] {
+			if firstParts[i] < secondParts[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseSchemaVersion(version string) [3]int {
+	var parsed [3]int
+	for i, part := range strings.Split(strings.TrimPrefix(strings.ToLower(version), "v"), ".") {
+		if i > 2 {
+			break
+		}
+		parsed[i], _ = strconv.Atoi(part)
+	}
+	return parsed
+}
+
+// MigrateProjects migrates the projects found in a path to the target schema version. The path can be a project
+// directory, a project archive, a deployment directory or a directory containing any of them (eg: the output
+// directory of export apis).
+// @param path : Path to search for projects
+// @param targetVersion : Schema version to migrate to
+// @param dryRun : Only report the changes without writing them
+// @return reports of the migrated projects
+// @return error
+func MigrateProjects(path, targetVersion string, dryRun bool) ([]*ProjectMigrationReport, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	targets, err := findMigrationTargets(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("no projects were found in " + path)
+	}
+
+	var reports []*ProjectMigrationReport
+	for _, target := range targets {
+		report := &ProjectMigrationReport{Path: target, TargetVersion: targetVersion}
+		report.Err = migrateTarget(target, report, dryRun)
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// findMigrationTargets returns the project directories and archives found in a path
+func findMigrationTargets(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		if strings.EqualFold(filepath.Ext(path), ".zip") {
+			return []string{path}, nil
+		}
+		return nil, nil
+	}
+	if isMigratableDirectory(path) {
+		return []string{path}, nil
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var targets []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		found, err := findMigrationTargets(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, found...)
+	}
+	return targets, nil
+}
+
+// isMigratableDirectory checks whether a directory is a project or a deployment directory
+func isMigratableDirectory(dir string) bool {
+	candidates := []string{
+		utils.APIDefinitionFileYaml, utils.APIDefinitionFileJson,
+		utils.APIProductDefinitionFileYaml, utils.APIProductDefinitionFileJson,
+		utils.ApplicationDefinitionFileYaml, utils.ApplicationDefinitionFileJson,
+		utils.ParamFile,
+		filepath.Join(utils.MigrateProjectLegacyMetaDir, utils.APIDefinitionFileYaml),
+		filepath.Join(utils.MigrateProjectLegacyMetaDir, utils.APIDefinitionFileJson),
+	}
+	for _, candidate := range candidates {
+		if utils.IsFileExist(filepath.Join(dir, candidate)) {
+			return true
+		}
+	}
+	return false
+}
+
+// migrateTarget migrates a project directory or archive. Archives and dry runs are migrated in a temporary copy.
+func migrateTarget(target string, report *ProjectMigrationReport, dryRun bool) error {
+	info, err := os.Stat(target)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !dryRun {
+		return migrateProjectDirectory(target, report)
+	}
+
+	clone, err := utils.GetTempCloneFromDirOrZip(target)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(filepath.Dir(clone))
+
+	if err = migrateProjectDirectory(clone, report); err != nil {
+		return err
+	}
+	if dryRun || info.IsDir() || !report.Migrated() {
+		return nil
+	}
+	utils.Logln(utils.LogPrefixInfo + "Writing the migrated archive " + target)
+	return utils.Zip(clone, target)
+}
+
+// migrateProjectDirectory migrates the definition, deployment environments and params files of a project directory
+func migrateProjectDirectory(dir string, report *ProjectMigrationReport) error {
+	legacyDefinition, _, _ := resolveYamlOrJSON(filepath.Join(dir, utils.MigrateProjectLegacyMetaDir, "api"))
+	if legacyDefinition != "" {
+		if err := migrateLegacyAPIProject(dir, legacyDefinition, report); err != nil {
+			return err
+		}
+	} else {
+		migrated := false
+		for _, name := range []string{"api", "api_product", "application"} {
+			definition, _, _ := resolveYamlOrJSON(filepath.Join(dir, name))
+			if definition == "" {
+				continue
+			}
+			if err := migrateDefinitionFile(dir, definition, report); err != nil {
+				return err
+			}
+			migrated = true
+			break
+		}
+		if !migrated && report.SourceVersion == "" {
+			report.SourceVersion = "-"
+		}
+		if err := migrateDeploymentEnvironmentsFile(dir, report); err != nil {
+			return err
+		}
+	}
+	if err := migrateDependentAPIs(dir, report); err != nil {
+		return err
+	}
+	return migrateParamsFile(filepath.Join(dir, utils.ParamFile), report)
+}
+
+// migrateDependentAPIs migrates the API projects which are bundled inside an API Product project
+func migrateDependentAPIs(dir string, report *ProjectMigrationReport) error {
+	apisDir := filepath.Join(dir, "APIs")
+	entries, err := ioutil.ReadDir(apisDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dependentReport := &ProjectMigrationReport{TargetVersion: report.TargetVersion}
+		if err := migrateProjectDirectory(filepath.Join(apisDir, entry.Name()), dependentReport); err != nil {
+			return err
+		}
+		for _, change := range dependentReport.Changes {
+			report.change("%s: %s", entry.Name(), change)
+		}
+		for _, lossyChange := range dependentReport.LossyChanges {
+			report.lossy("%s: %s", entry.Name(), lossyChange)
+		}
+	}
+	return nil
+}
+
+// readProjectFile reads a YAML or JSON project file as a generic map
+func readProjectFile(path string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		if content, err = utils.YamlToJson(content); err != nil {
+			return nil, err
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	file := map[string]interface{}{}
+	if err = decoder.Decode(&file); err != nil {
+		return nil, errors.New("error reading " + path + ": " + err.Error())
+	}
+	return file, nil
+}
+
+// writeProjectFile writes a project file with the given header in YAML or JSON based on the extension of the path
+func writeProjectFile(path, fileType, version string, data interface{}) error {
+	var content []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		content, err = json.MarshalIndent(orderedProjectFile{Type: fileType, Version: version, Data: data}, "", "  ")
+	} else {
+		var dataJSON []byte
+		if dataJSON, err = json.Marshal(data); err != nil {
+			return err
+		}
+		var yamlData interface{}
+		if err = yaml.Unmarshal(dataJSON, &yamlData); err != nil {
+			return err
+		}
+		content, err = yaml.Marshal(yaml.MapSlice{
+			{Key: "type", Value: fileType},
+			{Key: "version", Value: version},
+			{Key: "data", Value: yamlData},
+		})
+	}
+	if err != nil {
+		return err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Writing " + path)
+	return ioutil.WriteFile(path, content, os.ModePerm)
+}
+
+// migrateDefinitionFile upgrades the header of a 4.x definition file and the parts of the API which changed since
+func migrateDefinitionFile(dir, definitionPath string, report *ProjectMigrationReport) error {
+	file, err := readProjectFile(definitionPath)
+	if err != nil {
+		return err
+	}
+	fileType, _ := file["type"].(string)
+	sourceVersion, _ := file["version"].(string)
+	report.SourceVersion = sourceVersion
+	if compareSchemaVersions(sourceVersion, report.TargetVersion) > 0 {
+		return errors.New("the project is in schema version " + sourceVersion + " which is newer than " +
+			report.TargetVersion + ". Downgrading projects is not supported")
+	}
+
+	data, _ := file["data"].(map[string]interface{})
+	if data == nil {
+		return errors.New("definition " + definitionPath + " has no data")
+	}
+	changed := sourceVersion != report.TargetVersion
+	if fileType == utils.SchemaTypeAPI {
+		if endpointConfig, ok := data["endpointConfig"].(string); ok {
+			data["endpointConfig"] = convertEndpointConfigString(endpointConfig, report)
+			changed = true
+		}
+		if policies, ok := data["mediationPolicies"].([]interface{}); ok && len(policies) > 0 {
+			if err = migrateMediationPolicies(dir, data, report); err != nil {
+				return err
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if sourceVersion != report.TargetVersion {
+		report.change("updated the version of %s from %s to %s", filepath.Base(definitionPath),
+			displaySchemaVersion(sourceVersion), report.TargetVersion)
+	}
+	return writeProjectFile(definitionPath, fileType, report.TargetVersion, data)
+}
+
+func displaySchemaVersion(version string) string {
+	if version == "" {
+		return "<none>"
+	}
+	return version
+}
+
+// migrateDeploymentEnvironmentsFile upgrades the header of the deployment environments file of a 4.x project
+func migrateDeploymentEnvironmentsFile(dir string, report *ProjectMigrationReport) error {
+	path := filepath.Join(dir, utils.DeploymentEnvFile)
+	if !utils.IsFileExist(path) {
+		return nil
+	}
+	file, err := readProjectFile(path)
+	if err != nil {
+		return err
+	}
+	version, _ := file["version"].(string)
+	if compareSchemaVersions(version, report.TargetVersion) >= 0 {
+		return nil
+	}
+	report.change("updated the version of %s from %s to %s", utils.DeploymentEnvFile, displaySchemaVersion(version),
+		report.TargetVersion)
+	return writeProjectFile(path, utils.SchemaTypeDeploymentEnvironments, report.TargetVersion, file["data"])
+}
+
+// convertEndpointConfigString converts an endpoint configuration stored as a JSON string to an object
+func convertEndpointConfigString(endpointConfig string, report *ProjectMigrationReport) interface{} {
+	if strings.TrimSpace(endpointConfig) == "" {
+		return nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(endpointConfig))
+	decoder.UseNumber()
+	var config map[string]interface{}
+	if err := decoder.Decode(&config); err != nil {
+		report.lossy("the endpoint configuration is not valid JSON and was kept as it is: %s", err.Error())
+		return endpointConfig
+	}
+	report.change("converted the endpoint configuration from a JSON string to an object")
+	return config
+}
+
+// migrateMediationPolicies converts the mediation policies of an API to operation policies attached to all of its
+// operations. The sequences of the mediation policies become the templates of the operation policies.
+func migrateMediationPolicies(dir string, data map[string]interface{}, report *ProjectMigrationReport) error {
+	policies, _ := data["mediationPolicies"].([]interface{})
+	data["mediationPolicies"] = []interface{}{}
+	operations, _ := data["operations"].([]interface{})
+	apiType, _ := data["type"].(string)
+	if apiType == "" {
+		apiType = "HTTP"
+	}
+
+	for _, policy := range policies {
+		policyMap, _ := policy.(map[string]interface{})
+		name, _ := policyMap["name"].(string)
+		policyType, _ := policyMap["type"].(string)
+		shared, _ := policyMap["shared"].(bool)
+		flow, ok := mediationPolicyFlows[strings.ToUpper(policyType)]
+		if name == "" || !ok {
+			report.lossy("mediation policy %q of type %q cannot be converted and was dropped", name, policyType)
+			continue
+		}
+		if shared {
+			report.lossy("shared mediation policy %s is not part of the project. Create it as a common operation "+
+				"policy and attach it to the operations", name)
+			continue
+		}
+		sequence, err := readMediationSequence(dir, strings.ToLower(policyType), name)
+		if err != nil {
+			return err
+		}
+		if sequence == nil {
+			report.lossy("the sequence of mediation policy %s was not found in the project and it was dropped", name)
+			continue
+		}
+		if err = writeOperationPolicy(dir, name, flow, apiType, sequence, report.TargetVersion); err != nil {
+			return err
+		}
+		if len(operations) == 0 {
+			report.lossy("operation policy %s was created but the API has no operations to attach it to", name)
+			continue
+		}
+		for _, operation := range operations {
+			attachOperationPolicy(operation, flow, name)
+		}
+		report.change("converted %s mediation policy %s to operation policy %s_%s attached to %d operation(s)",
+			strings.ToUpper(policyType), name, name, utils.MigrateProjectPolicyVersion, len(operations))
+	}
+
+	sequencesDir := filepath.Join(dir, utils.MigrateProjectLegacySequencesDir)
+	if exists, _ := utils.IsDirExists(sequencesDir); exists {
+		report.change("removed the %s directory", utils.MigrateProjectLegacySequencesDir)
+		return os.RemoveAll(sequencesDir)
+	}
+	return nil
+}
+
+// readMediationSequence reads the custom sequence of a mediation policy. Returns nil if the sequence is not found.
+func readMediationSequence(dir, policyType, name string) ([]byte, error) {
+	sequenceDir := filepath.Join(dir, utils.MigrateProjectLegacySequencesDir, policyType+"-sequence")
+	for _, candidate := range []string{
+		filepath.Join(sequenceDir, "Custom", name+".xml"),
+		filepath.Join(sequenceDir, name+".xml"),
+	} {
+		if utils.IsFileExist(candidate) {
+			return ioutil.ReadFile(candidate)
+		}
+	}
+	return nil, nil
+}
+
+// writeOperationPolicy writes the specification and the template of an operation policy to the Policies directory
+func writeOperationPolicy(dir, name, flow, apiType string, template []byte, version string) error {
+	policiesDir := filepath.Join(dir, utils.InitProjectSequences)
+	if err := os.MkdirAll(policiesDir, os.ModePerm); err != nil {
+		return err
+	}
+	specification := operationPolicySpecification{
+		Type:    utils.SchemaTypeOperationPolicySpecification,
+		Version: version,
+		Data: operationPolicySpecificationData{
+			Category:          "Mediation",
+			Name:              name,
+			Version:           utils.MigrateProjectPolicyVersion,
+			DisplayName:       name,
+			Description:       "Migrated from the " + name + " mediation sequence",
+			ApplicableFlows:   []string{flow},
+			SupportedGateways: []string{"Synapse"},
+			SupportedApiTypes: []string{apiType},
+			PolicyAttributes:  []interface{}{},
+		},
+	}
+	content, err := yaml.Marshal(specification)
+	if err != nil {
+		return err
+	}
+	fileName := filepath.Join(policiesDir, name+"_"+utils.MigrateProjectPolicyVersion)
+	if err = ioutil.WriteFile(fileName+".yaml", content, os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName+".j2", template, os.ModePerm)
+}
+
+// attachOperationPolicy adds an operation policy to the given flow of an operation
+func attachOperationPolicy(operation interface{}, flow, name string) {
+	operationMap, ok := operation.(map[string]interface{})
+	if !ok {
+		return
+	}
+	operationPolicies, _ := operationMap["operationPolicies"].(map[string]interface{})
+	if operationPolicies == nil {
+		operationPolicies = map[string]interface{}{
+			"request":  []interface{}{},
+			"response": []interface{}{},
+			"fault":    []interface{}{},
+		}
+	}
+	flowPolicies, _ := operationPolicies[flow].([]interface{})
+	operationPolicies[flow] = append(flowPolicies, map[string]interface{}{
+		"policyName":    name,
+		"policyVersion": utils.MigrateProjectPolicyVersion,
+		"parameters":    map[string]interface{}{},
+	})
+	operationMap["operationPolicies"] = operationPolicies
+}
+
+// migrateLegacyAPIProject converts an API project exported from APIM 3.x to the target schema
+func migrateLegacyAPIProject(dir, legacyDefinition string, report *ProjectMigrationReport) error {
+	report.SourceVersion = legacySchemaVersion
+	legacy, err := readProjectFile(legacyDefinition)
+	if err != nil {
+		return err
+	}
+	if _, isProduct := legacy["apiProductIdentifier"]; isProduct {
+		return errors.New("migrating API Product projects exported from APIM 3.x is not supported. Export the " +
+			"API Product again after migrating the server")
+	}
+
+	data := ConvertLegacyAPIDefinition(legacy, report)
+	if err = migrateMediationPolicies(dir, data, report); err != nil {
+		return err
+	}
+	definitionPath := filepath.Join(dir, utils.APIDefinitionFileYaml)
+	if strings.EqualFold(filepath.Ext(legacyDefinition), ".json") {
+		definitionPath = filepath.Join(dir, utils.APIDefinitionFileJson)
+	}
+	if err = writeProjectFile(definitionPath, utils.SchemaTypeAPI, report.TargetVersion, data); err != nil {
+		return err
+	}
+	report.change("converted %s/%s to %s", utils.MigrateProjectLegacyMetaDir, filepath.Base(legacyDefinition),
+		filepath.Base(definitionPath))
+
+	if err = writeLegacyDeploymentEnvironments(dir, legacy, report); err != nil {
+		return err
+	}
+	if err = moveLegacyDefinitions(dir, report); err != nil {
+		return err
+	}
+	report.change("removed the %s directory", utils.MigrateProjectLegacyMetaDir)
+	return os.RemoveAll(filepath.Join(dir, utils.MigrateProjectLegacyMetaDir))
+}
+
+// moveLegacyDefinitions moves the API definitions (swagger, GraphQL schema, etc.) from the Meta-information
+// directory to the Definitions directory
+func moveLegacyDefinitions(dir string, report *ProjectMigrationReport) error {
+	metaDir := filepath.Join(dir, utils.MigrateProjectLegacyMetaDir)
+	entries, err := ioutil.ReadDir(metaDir)
+	if err != nil {
+		return err
+	}
+	definitionsDir := filepath.Join(dir, utils.InitProjectDefinitions)
+	for _, entry := range entries {
+		name := entry.Name()
+		base := strings.TrimSuffix(name, filepath.Ext(name))
+		if entry.IsDir() || base == "api" {
+			continue
+		}
+		if base != "swagger" && base != "schema" && base != "asyncapi" {
+			report.lossy("%s/%s has no equivalent in the target schema and was dropped",
+				utils.MigrateProjectLegacyMetaDir, name)
+			continue
+		}
+		if err = os.MkdirAll(definitionsDir, os.ModePerm); err != nil {
+			return err
+		}
+		if err = os.Rename(filepath.Join(metaDir, name), filepath.Join(definitionsDir, name)); err != nil {
+			return err
+		}
+		report.change("moved %s/%s to %s/%s", utils.MigrateProjectLegacyMetaDir, name,
+			utils.InitProjectDefinitions, name)
+	}
+	return nil
+}
+
+// writeLegacyDeploymentEnvironments writes the deployment environments file using the gateway environments of a
+// 3.x API
+func writeLegacyDeploymentEnvironments(dir string, legacy map[string]interface{}, report *ProjectMigrationReport) error {
+	environments, _ := legacy["environments"].([]interface{})
+	if len(environments) == 0 {
+		return nil
+	}
+	var deploymentEnvironments []interface{}
+	for _, environment := range environments {
+		name, _ := environment.(string)
+		if name == "" {
+			continue
+		}
+		deploymentEnvironments = append(deploymentEnvironments, map[string]interface{}{
+			"displayOnDevportal":    true,
+			"deploymentEnvironment": migrateGatewayEnvironmentName(name, report),
+		})
+	}
+	report.change("created %s from the gateway environments of the API", utils.DeploymentEnvFile)
+	return writeProjectFile(filepath.Join(dir, utils.DeploymentEnvFile), utils.SchemaTypeDeploymentEnvironments,
+		report.TargetVersion, deploymentEnvironments)
+}
+
+// migrateGatewayEnvironmentName maps the default gateway environment of 3.x to the default one of 4.x
+func migrateGatewayEnvironmentName(name string, report *ProjectMigrationReport) string {
+	if name == utils.MigrateProjectLegacyGatewayEnvironment {
+		report.change("mapped gateway environment %q to %q", name, utils.MigrateProjectDefaultGatewayEnvironment)
+		return utils.MigrateProjectDefaultGatewayEnvironment
+	}
+	return name
+}
+
+// ConvertLegacyAPIDefinition converts the API definition of a project exported from APIM 3.x to the data of a 4.x
+// API definition. The inSequence, outSequence and faultSequence of the API are returned as mediation policies.
+// @param legacy : API definition of APIM 3.x
+// @param report : Report to add the changes and the lossy conversions to
+// @return data of the 4.x API definition
+func ConvertLegacyAPIDefinition(legacy map[string]interface{}, report *ProjectMigrationReport) map[string]interface{} {
+	data := map[string]interface{}{}
+	handled := map[string]bool{}
+	take := func(key string) (interface{}, bool) {
+		handled[key] = true
+		value, ok := legacy[key]
+		return value, ok && value != nil
+	}
+	rename := func(legacyKey, key string) {
+		if value, ok := take(legacyKey); ok {
+			data[key] = value
+		}
+	}
+
+	if value, ok := take("id"); ok {
+		identifier, _ := value.(map[string]interface{})
+		data["name"] = identifier["apiName"]
+		data["version"] = identifier["version"]
+		data["provider"] = identifier["providerName"]
+	}
+	rename("uuid", "id")
+	for _, key := range []string{"description", "type", "isDefaultVersion", "enableSchemaValidation",
+		"authorizationHeader", "cacheTimeout", "corsConfiguration", "wsdlUrl", "tags", "keyManagers"} {
+		rename(key, key)
+	}
+	rename("status", "lifeCycleStatus")
+	rename("implementation", "endpointImplementationType")
+	rename("apiLevelPolicy", "apiThrottlingPolicy")
+
+	if value, ok := take("contextTemplate"); ok {
+		context, _ := value.(string)
+		data["context"] = strings.TrimSuffix(context, "/{version}")
+	} else if value, ok := take("context"); ok {
+		data["context"] = value
+	}
+	handled["context"] = true
+
+	if value, ok := take("transports"); ok {
+		data["transport"] = splitCommaSeparated(value)
+	}
+	if value, ok := take("apiSecurity"); ok {
+		data["securityScheme"] = splitCommaSeparated(value)
+	}
+	for _, key := range []string{"visibleRoles", "visibleTenants", "accessControlRoles", "subscriptionAvailableTenants"} {
+		if value, ok := take(key); ok {
+			data[key] = splitCommaSeparated(value)
+		}
+	}
+	if value, ok := take("visibility"); ok {
+		data["visibility"] = strings.ToUpper(fmt.Sprint(value))
+	}
+	if value, ok := take("subscriptionAvailability"); ok {
+		data["subscriptionAvailability"] = strings.ToUpper(fmt.Sprint(value))
+	}
+	if value, ok := take("accessControl"); ok {
+		if strings.EqualFold(fmt.Sprint(value), "restricted") {
+			data["accessControl"] = "RESTRICTED"
+		} else {
+			data["accessControl"] = "NONE"
+		}
+	}
+	if value, ok := take("responseCache"); ok {
+		data["responseCachingEnabled"] = strings.EqualFold(fmt.Sprint(value), "Enabled")
+	}
+	if value, ok := take("advertiseOnly"); ok {
+		data["advertiseInfo"] = map[string]interface{}{"advertised": value}
+	}
+	if value, ok := take("availableTiers"); ok {
+		var policies []interface{}
+		for _, tier := range toSlice(value) {
+			if tierMap, ok := tier.(map[string]interface{}); ok {
+				policies = append(policies, tierMap["name"])
+			} else {
+				policies = append(policies, tier)
+			}
+		}
+		data["policies"] = policies
+	}
+	if value, ok := take("additionalProperties"); ok {
+		data["additionalProperties"] = convertLegacyAdditionalProperties(value)
+	}
+
+	businessInformation := map[string]interface{}{}
+	for _, key := range []string{"businessOwner", "businessOwnerEmail", "technicalOwner", "technicalOwnerEmail"} {
+		if value, ok := take(key); ok {
+			businessInformation[key] = value
+		}
+	}
+	if len(businessInformation) > 0 {
+		data["businessInformation"] = businessInformation
+	}
+
+	maxTps := map[string]interface{}{}
+	if value, ok := take("productionMaxTps"); ok {
+		maxTps["production"] = value
+	}
+	if value, ok := take("sandboxMaxTps"); ok {
+		maxTps["sandbox"] = value
+	}
+	if len(maxTps) > 0 {
+		data["maxTps"] = maxTps
+	}
+
+	if value, ok := take("endpointConfig"); ok {
+		if endpointConfig, isString := value.(string); isString {
+			data["endpointConfig"] = convertEndpointConfigString(endpointConfig, report)
+		} else {
+			data["endpointConfig"] = value
+		}
+	}
+	convertLegacyEndpointSecurity(legacy, data, handled, report)
+
+	if value, ok := take("scopes"); ok {
+		data["scopes"] = convertLegacyScopes(value)
+	}
+	if value, ok := take("uriTemplates"); ok {
+		data["operations"] = convertLegacyURITemplates(value, report)
+	}
+
+	var mediationPolicies []interface{}
+	for _, sequence := range []struct{ key, policyType string }{
+		{"inSequence", "IN"}, {"outSequence", "OUT"}, {"faultSequence", "FAULT"},
+	} {
+		if value, ok := take(sequence.key); ok && fmt.Sprint(value) != "" {
+			mediationPolicies = append(mediationPolicies, map[string]interface{}{
+				"name": value, "type": sequence.policyType, "shared": false,
+			})
+		}
+	}
+	data["mediationPolicies"] = mediationPolicies
+
+	if value, ok := take("isMonetizationEnabled"); ok && value == true {
+		report.lossy("monetization of the API has to be configured again after importing it")
+	}
+	handled["monetizationProperties"] = true
+
+	var dropped []string
+	for key := range legacy {
+		if !handled[key] && !droppedLegacyAPIFields[key] {
+			dropped = append(dropped, key)
+		}
+	}
+	sort.Strings(dropped)
+	for _, key := range dropped {
+		report.lossy("field %s has no equivalent in the target schema and was dropped", key)
+	}
+	return data
+}
+
+// convertLegacyEndpointSecurity moves the endpoint security fields of a 3.x API into the endpoint configuration
+func convertLegacyEndpointSecurity(legacy, data map[string]interface{}, handled map[string]bool,
+	report *ProjectMigrationReport) {
+	for _, key := range []string{"endpointSecured", "endpointAuthDigest", "endpointUTUsername", "endpointUTPassword"} {
+		handled[key] = true
+	}
+	if secured, _ := legacy["endpointSecured"].(bool); !secured {
+		return
+	}
+	securityType := "BASIC"
+	if digest, _ := legacy["endpointAuthDigest"].(bool); digest {
+		securityType = "DIGEST"
+	}
+	username, _ := legacy["endpointUTUsername"].(string)
+	password, _ := legacy["endpointUTPassword"].(string)
+	if password == "" {
+		report.lossy("the endpoint security password is not part of the project. Provide it using the params file")
+	}
+
+	endpointConfig, _ := data["endpointConfig"].(map[string]interface{})
+	if endpointConfig == nil {
+		report.lossy("endpoint security was dropped since the API has no endpoint configuration")
+		return
+	}
+	security := map[string]interface{}{}
+	for _, endpointType := range []string{"production", "sandbox"} {
+		security[endpointType] = map[string]interface{}{
+			"enabled":  true,
+			"type":     securityType,
+			"username": username,
+			"password": password,
+		}
+	}
+	endpointConfig["endpoint_security"] = security
+	report.change("moved the endpoint security of the API into the endpoint configuration")
+}
+
+// convertLegacyScopes converts the scopes of a 3.x API to the scopes of the target schema
+func convertLegacyScopes(value interface{}) []interface{} {
+	scopes := []interface{}{}
+	for _, scope := range toSlice(value) {
+		scopeMap, ok := scope.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		scopes = append(scopes, map[string]interface{}{
+			"scope": map[string]interface{}{
+				"name":        scopeMap["key"],
+				"displayName": scopeMap["name"],
+				"description": scopeMap["description"],
+				"bindings":    splitCommaSeparated(scopeMap["roles"]),
+			},
+			"shared": false,
+		})
+	}
+	return scopes
+}
+
+// convertLegacyURITemplates converts the URI templates of a 3.x API to the operations of the target schema
+func convertLegacyURITemplates(value interface{}, report *ProjectMigrationReport) []interface{} {
+	operations := []interface{}{}
+	for _, uriTemplate := range toSlice(value) {
+		template, ok := uriTemplate.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		verb, _ := template["HTTPVerb"].(string)
+		if verb == "" {
+			verb, _ = template["httpVerb"].(string)
+		}
+		legacyAuthType, _ := template["authType"].(string)
+		authType, ok := legacyAuthTypes[legacyAuthType]
+		if !ok {
+			authType = legacyAuthTypes["Any"]
+			if legacyAuthType != "" {
+				report.lossy("auth type %s of %s %v is not supported and was set to %s", legacyAuthType, verb,
+					template["uriTemplate"], authType)
+			}
+		}
+		var scopes []interface{}
+		for _, scope := range toSlice(template["scopes"]) {
+			if scopeMap, ok := scope.(map[string]interface{}); ok {
+				scopes = append(scopes, scopeMap["key"])
+			}
+		}
+		if scope, ok := template["scope"].(map[string]interface{}); ok && len(scopes) == 0 {
+			scopes = append(scopes, scope["key"])
+		}
+		if scopes == nil {
+			scopes = []interface{}{}
+		}
+		operation := map[string]interface{}{
+			"target":           template["uriTemplate"],
+			"verb":             strings.ToUpper(verb),
+			"authType":         authType,
+			"throttlingPolicy": template["throttlingTier"],
+			"scopes":           scopes,
+		}
+		if script, ok := template["mediationScript"].(string); ok && script != "" {
+			report.lossy("the mediation script of %s %v was dropped. Use an operation policy instead", verb,
+				template["uriTemplate"])
+		}
+		operations = append(operations, operation)
+	}
+	return operations
+}
+
+// convertLegacyAdditionalProperties converts the additional properties map of a 3.x API to a list of properties
+func convertLegacyAdditionalProperties(value interface{}) []interface{} {
+	properties := []interface{}{}
+	propertyMap, ok := value.(map[string]interface{})
+	if !ok {
+		return append(properties, toSlice(value)...)
+	}
+	var names []string
+	for name := range propertyMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		properties = append(properties, map[string]interface{}{
+			"name":    name,
+			"value":   propertyMap[name],
+			"display": false,
+		})
+	}
+	return properties
+}
+
+// splitCommaSeparated splits a comma separated string to a list. Lists are returned as they are.
+func splitCommaSeparated(value interface{}) []interface{} {
+	values := []interface{}{}
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	text, _ := value.(string)
+	for _, item := range strings.Split(text, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func toSlice(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+// migrateParamsFile moves the environment configurations of a 3.x params file under configs. The order of the
+// params file is kept and the environment variables in it are not substituted.
+func migrateParamsFile(path string, report *ProjectMigrationReport) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var params yaml.MapSlice
+	if err = yaml.Unmarshal(content, &params); err != nil {
+		return errors.New("error reading " + path + ": " + err.Error())
+	}
+
+	changed := false
+	for i, item := range params {
+		if item.Key != "environments" {
+			continue
+		}
+		environments, _ := item.Value.([]interface{})
+		for j, environment := range environments {
+			environmentSlice, ok := environment.(yaml.MapSlice)
+			if !ok || mapSliceHasKey(environmentSlice, "configs") {
+				continue
+			}
+			environments[j] = convertLegacyParamsEnvironment(environmentSlice, report)
+			changed = true
+		}
+		params[i].Value = environments
+	}
+	if !changed {
+		return nil
+	}
+	content, err = yaml.Marshal(params)
+	if err != nil {
+		return err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Writing " + path)
+	return ioutil.WriteFile(path, content, os.ModePerm)
+}
+
+// convertLegacyParamsEnvironment converts an environment of a 3.x params file to the configs based format
+func convertLegacyParamsEnvironment(environment yaml.MapSlice, report *ProjectMigrationReport) yaml.MapSlice {
+	converted := yaml.MapSlice{}
+	configs := yaml.MapSlice{}
+	name := ""
+	for _, item := range environment {
+		key := fmt.Sprint(item.Key)
+		switch key {
+		case "name":
+			name = fmt.Sprint(item.Value)
+			converted = append(converted, item)
+		case "security":
+			configs = append(configs, yaml.MapItem{Key: key, Value: convertLegacyParamsSecurity(item.Value)})
+		case "gatewayEnvironments":
+			var deploymentEnvironments []interface{}
+			for _, gatewayEnvironment := range toSlice(item.Value) {
+				deploymentEnvironments = append(deploymentEnvironments, yaml.MapSlice{
+					{Key: "displayOnDevportal", Value: true},
+					{Key: "deploymentEnvironment",
+						Value: migrateGatewayEnvironmentName(fmt.Sprint(gatewayEnvironment), report)},
+				})
+			}
+			configs = append(configs, yaml.MapItem{Key: "deploymentEnvironments", Value: deploymentEnvironments})
+		default:
+			configs = append(configs, item)
+		}
+	}
+	report.change("moved the configurations of environment %s in %s under configs", name, utils.ParamFile)
+	return append(converted, yaml.MapItem{Key: "configs", Value: configs})
+}
+
+// convertLegacyParamsSecurity applies the endpoint security of a 3.x params file to both production and sandbox
+func convertLegacyParamsSecurity(security interface{}) interface{} {
+	securitySlice, ok := security.(yaml.MapSlice)
+	if !ok || mapSliceHasKey(securitySlice, "production") || mapSliceHasKey(securitySlice, "sandbox") {
+		return security
+	}
+	return yaml.MapSlice{
+		{Key: "production", Value: securitySlice},
+		{Key: "sandbox", Value: securitySlice},
+	}
+}
+
+func mapSliceHasKey(slice yaml.MapSlice, key string) bool {
+	for _, item := range slice {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintProjectMigrationReports prints the changes and the lossy conversions of the migrated projects
+// @param reports : Reports of the migrated projects
+// @param dryRun : Whether the changes were written or not
+// @return number of projects which failed to migrate
+func PrintProjectMigrationReports(reports []*ProjectMigrationReport, dryRun bool) int {
+	migrated, upToDate, failed, lossy := 0, 0, 0, 0
+	for _, report := range reports {
+		switch {
+		case report.Err != nil:
+			failed++
+			fmt.Printf("%s: migration failed: %s\n", report.Path, report.Err.Error())
+			continue
+		case !report.Migrated():
+			upToDate++
+			fmt.Printf("%s: already in schema version %s\n", report.Path, report.TargetVersion)
+			continue
+		}
+		migrated++
+		lossy += len(report.LossyChanges)
+		fmt.Printf("%s: migrated from %s to %s\n", report.Path, report.SourceVersion, report.TargetVersion)
+		for _, change := range report.Changes {
+			fmt.Println("    " + change)
+		}
+		for _, lossyChange := range report.LossyChanges {
+			fmt.Println("    WARNING: " + lossyChange)
+		}
+	}
+
+	summary := fmt.Sprintf("Migrated %d project(s), %d already up to date, %d failed. %d lossy conversion(s) "+
+		"reported.", migrated, upToDate, failed, lossy)
+	if dryRun {
+		summary += " No files were changed (dry run)."
+	}
+	fmt.Println(summary)
+	return failed
+}
@@ -0,0 +1,215 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const legacyAPIDefinition = `id:
+  providerName: admin
+  apiName: PizzaShackAPI
+  version: 1.0.0
+uuid: 6b1e5e2c-5d1a-4e2b-9d3a-2b3c4d5e6f70
+context: /pizzashack/1.0.0
+contextTemplate: /pizzashack/{version}
+status: PUBLISHED
+type: HTTP
+transports: http,https
+visibility: public
+availableTiers:
+  - name: Unlimited
+apiSecurity: oauth2,oauth_basic_auth_api_key_mandatory
+endpointConfig: '{"endpoint_type":"http","production_endpoints":{"url":"https://localhost:9443/pizzashack"}}'
+endpointSecured: true
+endpointUTUsername: admin
+inSequence: addHeader
+environments:
+  - Production and Sandbox
+uriTemplates:
+  - uriTemplate: /menu
+    HTTPVerb: GET
+    authType: Any
+    throttlingTier: Unlimited
+lastUpdated: Apr 5, 2021
+customField: value
+`
+
+const legacyParams = `environments:
+  - name: dev
+    endpoints:
+      production:
+        url: ${PROD_URL}
+    security:
+      enabled: true
+      type: basic
+      username: admin
+      password: ${PASSWORD}
+    gatewayEnvironments:
+      - Production and Sandbox
+`
+
+func writeTestFile(t *testing.T, path, content string) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+}
+
+func TestResolveMigrationTargetVersion(t *testing.T) {
+	for target, expected := range map[string]string{
+		"4.x":    utils.MigrateProjectLatestSchemaVersion,
+		"4":      utils.MigrateProjectLatestSchemaVersion,
+		"4.2.0":  "v4.2.0",
+		"v4.1":   "v4.1.0",
+		"V4.3.0": "v4.3.0",
+	} {
+		version, err := ResolveMigrationTargetVersion(target)
+		assert.Nil(t, err, target)
+		assert.Equal(t, expected, version, target)
+	}
+	for _, target := range []string{"3.2.0", "5.x", "4.a", "v9.0.0", "4.99.0"} {
+		_, err := ResolveMigrationTargetVersion(target)
+		assert.NotNil(t, err, target)
+	}
+}
+
+func TestMigrateLegacyAPIProject(t *testing.T) {
+	project := filepath.Join(t.TempDir(), "PizzaShackAPI-1.0.0")
+	writeTestFile(t, filepath.Join(project, "Meta-information", "api.yaml"), legacyAPIDefinition)
+	writeTestFile(t, filepath.Join(project, "Meta-information", "swagger.yaml"), "swagger: '2.0'\n")
+	writeTestFile(t, filepath.Join(project, "Sequences", "in-sequence", "Custom", "addHeader.xml"), "<sequence/>")
+	writeTestFile(t, filepath.Join(project, "params.yaml"), legacyParams)
+
+	reports, err := MigrateProjects(project, "v4.5.0", false)
+	assert.Nil(t, err)
+	assert.Len(t, reports, 1)
+	report := reports[0]
+	assert.Nil(t, report.Err)
+	assert.Equal(t, "3.x", report.SourceVersion)
+	assert.Contains(t, report.LossyChanges, "field customField has no equivalent in the target schema and was dropped")
+	assert.Contains(t, report.LossyChanges,
+		"the endpoint security password is not part of the project. Provide it using the params file")
+
+	file, err := readProjectFile(filepath.Join(project, utils.APIDefinitionFileYaml))
+	assert.Nil(t, err)
+	assert.Equal(t, "api", file["type"])
+	assert.Equal(t, "v4.5.0", file["version"])
+	data := file["data"].(map[string]interface{})
+	assert.Equal(t, "PizzaShackAPI", data["name"])
+	assert.Equal(t, "/pizzashack", data["context"])
+	assert.Equal(t, "PUBLIC", data["visibility"])
+	assert.Equal(t, []interface{}{"http", "https"}, data["transport"])
+	assert.Equal(t, []interface{}{"Unlimited"}, data["policies"])
+	assert.Equal(t, []interface{}{}, data["mediationPolicies"])
+	endpointConfig := data["endpointConfig"].(map[string]interface{})
+	assert.NotNil(t, endpointConfig["endpoint_security"])
+	operation := data["operations"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Application & Application User", operation["authType"])
+	request := operation["operationPolicies"].(map[string]interface{})["request"].([]interface{})
+	assert.Equal(t, "addHeader", request[0].(map[string]interface{})["policyName"])
+
+	assert.True(t, utils.IsFileExist(filepath.Join(project, "Definitions", "swagger.yaml")))
+	assert.True(t, utils.IsFileExist(filepath.Join(project, "Policies", "addHeader_v1.yaml")))
+	assert.True(t, utils.IsFileExist(filepath.Join(project, "Policies", "addHeader_v1.j2")))
+	assert.False(t, utils.IsFileExist(filepath.Join(project, "Meta-information")))
+	assert.False(t, utils.IsFileExist(filepath.Join(project, "Sequences")))
+
+	deploymentEnvironments, err := readProjectFile(filepath.Join(project, utils.DeploymentEnvFile))
+	assert.Nil(t, err)
+	environment := deploymentEnvironments["data"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Default", environment["deploymentEnvironment"])
+
+	params, err := ioutil.ReadFile(filepath.Join(project, utils.ParamFile))
+	assert.Nil(t, err)
+	assert.Contains(t, string(params), "configs:")
+	assert.Contains(t, string(params), "password: ${PASSWORD}")
+	assert.Contains(t, string(params), "deploymentEnvironment: Default")
+}
+
+func TestMigrateAPIProjectArchiveWithMediationPolicies(t *testing.T) {
+	dir := t.TempDir()
+	project := filepath.Join(dir, "source", "SampleAPI-1.0.0")
+	writeTestFile(t, filepath.Join(project, utils.APIDefinitionFileYaml), `type: api
+version: v4.0.0
+data:
+  name: SampleAPI
+  version: 1.0.0
+  mediationPolicies:
+    - name: logResponse
+      type: OUT
+      shared: false
+    - name: globalFault
+      type: FAULT
+      shared: true
+  operations:
+    - target: /items
+      verb: GET
+`)
+	writeTestFile(t, filepath.Join(project, "Sequences", "out-sequence", "Custom", "logResponse.xml"), "<sequence/>")
+	writeTestFile(t, filepath.Join(project, utils.DeploymentEnvFile), "type: deployment_environments\n"+
+		"version: v4.0.0\ndata:\n  - deploymentEnvironment: Default\n")
+	exportDir := filepath.Join(dir, "export", "apis")
+	assert.Nil(t, os.MkdirAll(exportDir, os.ModePerm))
+	archive := filepath.Join(exportDir, "SampleAPI_1.0.0.zip")
+	assert.Nil(t, utils.Zip(project, archive))
+
+	reports, err := MigrateProjects(filepath.Join(dir, "export"), "v4.5.0", true)
+	assert.Nil(t, err)
+	assert.Len(t, reports, 1)
+	assert.Equal(t, "v4.0.0", reports[0].SourceVersion)
+	assert.True(t, reports[0].Migrated())
+	assert.Len(t, reports[0].LossyChanges, 1)
+	unchanged, _ := utils.GetSHA256HashOfFile(archive)
+
+	reports, err = MigrateProjects(archive, "v4.5.0", false)
+	assert.Nil(t, err)
+	assert.True(t, reports[0].Migrated())
+	migratedHash, _ := utils.GetSHA256HashOfFile(archive)
+	assert.NotEqual(t, unchanged, migratedHash)
+
+	extracted, err := utils.GetTempCloneFromDirOrZip(archive)
+	assert.Nil(t, err)
+	defer os.RemoveAll(filepath.Dir(extracted))
+	file, err := readProjectFile(filepath.Join(extracted, utils.APIDefinitionFileYaml))
+	assert.Nil(t, err)
+	assert.Equal(t, "v4.5.0", file["version"])
+	assert.True(t, utils.IsFileExist(filepath.Join(extracted, "Policies", "logResponse_v1.j2")))
+	deploymentEnvironments, err := readProjectFile(filepath.Join(extracted, utils.DeploymentEnvFile))
+	assert.Nil(t, err)
+	assert.Equal(t, "v4.5.0", deploymentEnvironments["version"])
+
+	reports, err = MigrateProjects(archive, "v4.5.0", false)
+	assert.Nil(t, err)
+	assert.False(t, reports[0].Migrated())
+}
+
+func TestMigrateProjectRejectsDowngrade(t *testing.T) {
+	project := t.TempDir()
+	writeTestFile(t, filepath.Join(project, utils.APIDefinitionFileYaml), "type: api\nversion: v4.5.0\ndata:\n"+
+		"  name: SampleAPI\n")
+
+	reports, err := MigrateProjects(project, "v4.2.0", false)
+	assert.Nil(t, err)
+	assert.NotNil(t, reports[0].Err)
+}
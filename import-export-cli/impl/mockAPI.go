@@ -0,0 +1,596 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/wso2/product-apim-tooling/import-export-cli/specs/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Maximum depth of nested schemas used when generating mock responses
+const mockSchemaMaxDepth = 6
+
+// mockAPIDefinition holds the fields of the API definition used by the mock server
+type mockAPIDefinition struct {
+	Data struct {
+		Name                string                `json:"name"`
+		Context             string                `json:"context"`
+		Version             string                `json:"version"`
+		Type                string                `json:"type"`
+		AuthorizationHeader string                `json:"authorizationHeader"`
+		ApiKeyHeader        string                `json:"apiKeyHeader"`
+		SecurityScheme      []string              `json:"securityScheme"`
+		CorsConfiguration   *v2.CorsConfiguration `json:"corsConfiguration"`
+		Operations          []interface{}         `json:"operations"`
+	} `json:"data"`
+}
+
+// MockServer serves the operations of an API project with responses generated from its definition
+type MockServer struct {
+	API        *mockAPIDefinition
+	BasePath   string
+	operations []*mockOperation
+	graphQL    *mockGraphQLSchema
+	cors       *v2.CorsConfiguration
+}
+
+// mockOperation is an operation of the API definition and the response returned for it
+type mockOperation struct {
+	Method      string
+	Path        string
+	pattern     *regexp.Regexp
+	Status      int
+	ContentType string
+	Body        interface{}
+	Secured     bool
+}
+
+var mockPathParameterRegex = regexp.MustCompile(`\{[^/}]+\}`)
+
+// LoadMockServer loads the API definition of a project and prepares the operations to be served
+// @param projectPath : Path to the API project directory or archive
+// @return MockServer of the API
+// @return error
+func LoadMockServer(projectPath string) (*MockServer, error) {
+	if info, err := os.Stat(projectPath); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		clone, err := utils.GetTempCloneFromDirOrZip(projectPath)
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(filepath.Dir(clone))
+		projectPath = clone
+	}
+
+	_, content, err := resolveYamlOrJSON(filepath.Join(projectPath, "api"))
+	if err != nil {
+		return nil, errors.New(projectPath + " is not an API project: " + err.Error())
+	}
+	definition := &mockAPIDefinition{}
+	if err = json.Unmarshal(content, definition); err != nil {
+		return nil, err
+	}
+
+	server := &MockServer{API: definition}
+	server.BasePath = getMockBasePath(definition.Data.Context, definition.Data.Version)
+	if cors := definition.Data.CorsConfiguration; cors != nil && cors.CorsConfigurationEnabled {
+		server.cors = cors
+	}
+
+	if strings.EqualFold(server.API.Data.Type, "GRAPHQL") {
+		schema, err := ioutil.ReadFile(filepath.Join(projectPath, filepath.FromSlash(utils.InitProjectDefinitionsGraphQLSchema)))
+		if err != nil {
+			return nil, err
+		}
+		server.graphQL, err = parseMockGraphQLSchema(string(schema))
+		return server, err
+	}
+
+	swaggerPath := filepath.Join(projectPath, utils.InitProjectDefinitions, "swagger")
+	_, content, err = resolveYamlOrJSON(swaggerPath)
+	if err != nil {
+		return nil, err
+	}
+	openAPI := map[string]interface{}{}
+	if err = json.Unmarshal(content, &openAPI); err != nil {
+		return nil, err
+	}
+	server.operations = loadMockOperations(openAPI, definition.Data.Operations)
+	return server, nil
+}
+
+// getMockBasePath returns the path the gateway exposes an API with
+func getMockBasePath(context, version string) string {
+	context = "/" + strings.Trim(context, "/")
+	if strings.Contains(context, "{version}") {
+		return strings.Replace(context, "{version}", version, 1)
+	}
+	if version == "" {
+		return context
+	}
+	return context + "/" + version
+}
+
+// loadMockOperations reads the operations of an OpenAPI 2 or 3 definition and generates their responses
+func loadMockOperations(definition map[string]interface{}, apiOperations []interface{}) []*mockOperation {
+	var operations []*mockOperation
+	paths, _ := definition["paths"].(map[string]interface{})
+	for path, pathItem := range paths {
+		methods, _ := pathItem.(map[string]interface{})
+		for method, operationValue := range methods {
+			operation, ok := operationValue.(map[string]interface{})
+			if !ok || !isHTTPMethod(method) {
+				continue
+			}
+			mockOp := &mockOperation{
+				Method:  strings.ToUpper(method),
+				Path:    path,
+				pattern: getMockPathPattern(path),
+				Secured: isMockOperationSecured(operation, path, method, apiOperations),
+			}
+			mockOp.Status, mockOp.ContentType, mockOp.Body = generateMockResponse(definition, operation)
+			operations = append(operations, mockOp)
+		}
+	}
+	// Paths with fewer parameters are matched first so that /pets/mine wins over /pets/{id}
+	sort.SliceStable(operations, func(i, j int) bool {
+		first := len(mockPathParameterRegex.FindAllString(operations[i].Path, -1))
+		second := len(mockPathParameterRegex.FindAllString(operations[j].Path, -1))
+		if first != second {
+			return first < second
+		}
+		if operations[i].Path != operations[j].Path {
+			return operations[i].Path < operations[j].Path
+		}
+		return operations[i].Method < operations[j].Method
+	})
+	return operations
+}
+
+// getMockPathPattern converts a path template (eg: /pets/{petId}) to a regular expression
+func getMockPathPattern(path string) *regexp.Regexp {
+	var literals []string
+	for _, literal := range mockPathParameterRegex.Split(path, -1) {
+		literals = append(literals, regexp.QuoteMeta(literal))
+	}
+	return regexp.MustCompile("^" + strings.Join(literals, "[^/]+") + "$")
+}
+
+func isHTTPMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodHead,
+		http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isMockOperationSecured checks the auth type of an operation in the definition and in the API operations
+func isMockOperationSecured(operation map[string]interface{}, path, method string, apiOperations []interface{}) bool {
+	if authType, ok := operation["x-auth-type"].(string); ok {
+		return !strings.EqualFold(authType, "None")
+	}
+	for _, apiOperation := range apiOperations {
+		operationMap, _ := apiOperation.(map[string]interface{})
+		if operationMap["target"] == path && strings.EqualFold(fmt.Sprint(operationMap["verb"]), method) {
+			return !strings.EqualFold(fmt.Sprint(operationMap["authType"]), "None")
+		}
+	}
+	return true
+}
+
+// generateMockResponse picks the first successful response of an operation and generates its body from the
+// examples or the schema
+func generateMockResponse(definition, operation map[string]interface{}) (int, string, interface{}) {
+	responses, _ := operation["responses"].(map[string]interface{})
+	var codes []string
+	for code := range responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	status, selected := http.StatusOK, ""
+	for _, code := range codes {
+		if number, err := strconv.Atoi(code); err == nil && number >= 200 && number < 300 {
+			status, selected = number, code
+			break
+		}
+	}
+	if selected == "" {
+		if _, ok := responses["default"]; !ok {
+			return status, "", nil
+		}
+		selected = "default"
+	}
+	response, _ := resolveMockRef(definition, responses[selected]).(map[string]interface{})
+
+	// OpenAPI 2 responses have a schema and examples keyed by the media type
+	if examples, ok := response["examples"].(map[string]interface{}); ok && len(examples) > 0 {
+		mediaType := pickMockMediaType(examples)
+		return status, mediaType, examples[mediaType]
+	}
+	if schema, ok := response["schema"]; ok {
+		return status, "application/json", generateMockValue(definition, schema, 0)
+	}
+
+	// OpenAPI 3 responses have the examples and the schema under content
+	content, _ := response["content"].(map[string]interface{})
+	mediaType := pickMockMediaType(content)
+	if mediaType == "" {
+		return status, "", nil
+	}
+	media, _ := content[mediaType].(map[string]interface{})
+	if example, ok := media["example"]; ok {
+		return status, mediaType, example
+	}
+	if examples, ok := media["examples"].(map[string]interface{}); ok {
+		var names []string
+		for name := range examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) > 0 {
+			example, _ := resolveMockRef(definition, examples[names[0]]).(map[string]interface{})
+			return status, mediaType, example["value"]
+		}
+	}
+	return status, mediaType, generateMockValue(definition, media["schema"], 0)
+}
+
+// pickMockMediaType picks the JSON media type of a response if there is one
+func pickMockMediaType(mediaTypes map[string]interface{}) string {
+	var candidates []string
+	for candidate := range mediaTypes {
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+	for _, candidate := range candidates {
+		if strings.Contains(candidate, "json") {
+			return candidate
+		}
+	}
+	if len(candidates) > 0 {
+		return candidates[0]
+	}
+	return ""
+}
+
+// resolveMockRef resolves a local $ref (eg: #/components/schemas/Pet) of the definition
+func resolveMockRef(definition map[string]interface{}, value interface{}) interface{} {
+	for i := 0; i < mockSchemaMaxDepth; i++ {
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		ref, ok := valueMap["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#/") {
+			return value
+		}
+		var current interface{} = definition
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			part = strings.Replace(strings.Replace(part, "~1", "/", -1), "~0", "~", -1)
+			currentMap, _ := current.(map[string]interface{})
+			current = currentMap[part]
+		}
+		value = current
+	}
+	return value
+}
+
+// generateMockValue generates a value which conforms to a schema
+func generateMockValue(definition map[string]interface{}, schemaValue interface{}, depth int) interface{} {
+	schema, ok := resolveMockRef(definition, schemaValue).(map[string]interface{})
+	if !ok || depth > mockSchemaMaxDepth {
+		return nil
+	}
+	if example, ok := schema["example"]; ok {
+		return example
+	}
+	if defaultValue, ok := schema["default"]; ok {
+		return defaultValue
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		merged := map[string]interface{}{}
+		for _, part := range allOf {
+			if partValue, ok := generateMockValue(definition, part, depth+1).(map[string]interface{}); ok {
+				for key, value := range partValue {
+					merged[key] = value
+				}
+			}
+		}
+		return merged
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if options, ok := schema[key].([]interface{}); ok && len(options) > 0 {
+			return generateMockValue(definition, options[0], depth+1)
+		}
+	}
+
+	schemaType, _ := schema["type"].(string)
+	if schemaType == "" {
+		if _, ok := schema["properties"]; ok {
+			schemaType = "object"
+		} else if _, ok := schema["items"]; ok {
+			schemaType = "array"
+		}
+	}
+	switch schemaType {
+	case "object":
+		value := map[string]interface{}{}
+		properties, _ := schema["properties"].(map[string]interface{})
+		for name, property := range properties {
+			value[name] = generateMockValue(definition, property, depth+1)
+		}
+		return value
+	case "array":
+		if depth >= mockSchemaMaxDepth {
+			return []interface{}{}
+		}
+		return []interface{}{generateMockValue(definition, schema["items"], depth+1)}
+	case "integer":
+		if minimum, ok := schema["minimum"].(float64); ok {
+			return int64(minimum)
+		}
+		return 0
+	case "number":
+		if minimum, ok := schema["minimum"].(float64); ok {
+			return minimum
+		}
+		return 0.0
+	case "boolean":
+		return true
+	case "string":
+		return generateMockString(fmt.Sprint(schema["format"]))
+	}
+	return nil
+}
+
+func generateMockString(format string) string {
+	switch format {
+	case "date":
+		return "2021-01-01"
+	case "date-time":
+		return "2021-01-01T00:00:00Z"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "uri", "url":
+		return "https://example.com"
+	case "byte":
+		return "c3RyaW5n"
+	}
+	return "string"
+}
+
+// ServeHTTP serves a request with the mock response of the matching operation
+func (server *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recorder := &mockResponseRecorder{ResponseWriter: w, status: http.StatusOK}
+	start := time.Now()
+	server.serve(recorder, r)
+	fmt.Printf("%s %s %s -> %d (%s)\n", start.Format(time.RFC3339), r.Method, r.URL.RequestURI(), recorder.status,
+		time.Since(start).Round(time.Microsecond))
+}
+
+func (server *MockServer) serve(w http.ResponseWriter, r *http.Request) {
+	if server.applyCORS(w, r) {
+		return
+	}
+	path := r.URL.Path
+	if path == server.BasePath || strings.HasPrefix(path, server.BasePath+"/") {
+		path = "/" + strings.TrimPrefix(strings.TrimPrefix(path, server.BasePath), "/")
+	}
+
+	if server.graphQL != nil {
+		if path != "/" || (r.Method != http.MethodGet && r.Method != http.MethodPost) {
+			writeMockError(w, http.StatusNotFound, 404, "Not Found", "No matching resource found for "+r.URL.Path)
+			return
+		}
+		if server.isSecured() && !server.isAuthenticated(r) {
+			writeMockMissingCredentials(w)
+			return
+		}
+		server.serveGraphQL(w, r)
+		return
+	}
+
+	pathMatched := false
+	for _, operation := range server.operations {
+		if !operation.pattern.MatchString(path) {
+			continue
+		}
+		pathMatched = true
+		if operation.Method != r.Method {
+			continue
+		}
+		if operation.Secured && !server.isAuthenticated(r) {
+			writeMockMissingCredentials(w)
+			return
+		}
+		writeMockBody(w, operation.Status, operation.ContentType, operation.Body)
+		return
+	}
+	if pathMatched {
+		writeMockError(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed",
+			"Method not allowed for given API resource")
+		return
+	}
+	writeMockError(w, http.StatusNotFound, 404, "Not Found", "No matching resource found for "+r.URL.Path)
+}
+
+// applyCORS adds the CORS headers configured for the API and answers the preflight requests
+// @return whether the request was a preflight request which is already answered
+func (server *MockServer) applyCORS(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if server.cors == nil || origin == "" {
+		return false
+	}
+	allowed, wildcard := false, false
+	for _, allowedOrigin := range server.cors.AccessControlAllowOrigins {
+		wildcard = wildcard || allowedOrigin == "*"
+		allowed = allowed || wildcard || allowedOrigin == origin
+	}
+	if !allowed {
+		return false
+	}
+	if server.cors.AccessControlAllowCredentials {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		w.Header().Add("Vary", "Origin")
+	} else if wildcard {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+	}
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(server.cors.AccessControlAllowMethods, ","))
+	w.Header().Set("Access-Control-Allow-Headers", strings.Join(server.cors.AccessControlAllowHeaders, ","))
+
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		w.WriteHeader(http.StatusOK)
+		return true
+	}
+	return false
+}
+
+// isSecured checks whether any operation of the API requires authentication
+func (server *MockServer) isSecured() bool {
+	if len(server.API.Data.Operations) == 0 {
+		return true
+	}
+	for _, operation := range server.API.Data.Operations {
+		operationMap, _ := operation.(map[string]interface{})
+		if !strings.EqualFold(fmt.Sprint(operationMap["authType"]), "None") {
+			return true
+		}
+	}
+	return false
+}
+
+// isAuthenticated accepts any credential of the security schemes of the API. Tokens and keys are not validated.
+func (server *MockServer) isAuthenticated(r *http.Request) bool {
+	schemes := server.API.Data.SecurityScheme
+	if len(schemes) == 0 {
+		schemes = []string{"oauth2"}
+	}
+	authorizationHeader := server.API.Data.AuthorizationHeader
+	if authorizationHeader == "" {
+		authorizationHeader = utils.HeaderAuthorization
+	}
+	apiKeyHeader := server.API.Data.ApiKeyHeader
+	if apiKeyHeader == "" {
+		apiKeyHeader = "apikey"
+	}
+	authorization := strings.TrimSpace(r.Header.Get(authorizationHeader))
+	for _, scheme := range schemes {
+		switch scheme {
+		case "oauth2":
+			if len(authorization) > len("Bearer ") && strings.EqualFold(authorization[:7], "Bearer ") {
+				return true
+			}
+		case "basic_auth":
+			if len(authorization) > len("Basic ") && strings.EqualFold(authorization[:6], "Basic ") {
+				return true
+			}
+		case "api_key":
+			if r.Header.Get(apiKeyHeader) != "" || r.URL.Query().Get(apiKeyHeader) != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeMockMissingCredentials(w http.ResponseWriter) {
+	writeMockError(w, http.StatusUnauthorized, 900902, "Missing Credentials",
+		"Invalid Credentials. Make sure your API invocation call has a header: 'Authorization : Bearer "+
+			"ACCESS_TOKEN' or 'Authorization : Basic ACCESS_TOKEN' or 'apikey: API_KEY'")
+}
+
+func writeMockError(w http.ResponseWriter, status, code int, message, description string) {
+	writeMockBody(w, status, "application/json", map[string]interface{}{
+		"code":        code,
+		"message":     message,
+		"description": description,
+	})
+}
+
+func writeMockBody(w http.ResponseWriter, status int, contentType string, body interface{}) {
+	if body == nil || status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+	var content []byte
+	if text, ok := body.(string); ok && !strings.Contains(contentType, "json") {
+		content = []byte(text)
+	} else {
+		content, _ = json.MarshalIndent(body, "", "  ")
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(content)
+}
+
+// mockResponseRecorder keeps the status code written to a response to log it
+type mockResponseRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (recorder *mockResponseRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+// RunMockServer starts a mock server for an API project and serves requests until the process is stopped
+// @param projectPath : Path to the API project directory or archive
+// @param port : Port to listen on
+// @return error
+func RunMockServer(projectPath string, port int) error {
+	server, err := LoadMockServer(projectPath)
+	if err != nil {
+		return err
+	}
+	address := fmt.Sprintf("http://localhost:%d%s", port, server.BasePath)
+	fmt.Printf("Mocking %s %s at %s\n", server.API.Data.Name, server.API.Data.Version, address)
+	if server.graphQL != nil {
+		fmt.Println("GET, POST " + address)
+	}
+	for _, operation := range server.operations {
+		fmt.Printf("%s %s%s\n", operation.Method, address, operation.Path)
+	}
+	fmt.Println("Press Ctrl+C to stop the server")
+	return http.ListenAndServe(fmt.Sprintf(":%d", port), server)
+}
@@ -0,0 +1,186 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func sendMockRequest(t *testing.T, server *httptest.Server, method, path string, headers map[string]string,
+	body string) (*http.Response, string) {
+	request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	assert.Nil(t, err)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	response, err := http.DefaultClient.Do(request)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	content, _ := ioutil.ReadAll(response.Body)
+	return response, string(content)
+}
+
+func TestMockServerServesOpenAPIOperations(t *testing.T) {
+	mockServer, err := LoadMockServer(utils.GetRelativeTestDataPathFromImpl() + "PizzaShackAPI-1.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, "/pizzashack/1.0.0", mockServer.BasePath)
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	response, _ := sendMockRequest(t, server, http.MethodGet, "/pizzashack/1.0.0/menu", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	bearer := map[string]string{"Authorization": "Bearer any-token"}
+	response, body := sendMockRequest(t, server, http.MethodGet, "/pizzashack/1.0.0/menu", bearer, "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var menu []map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(body), &menu))
+	assert.Len(t, menu, 1)
+	assert.Equal(t, "string", menu[0]["name"])
+
+	response, _ = sendMockRequest(t, server, http.MethodGet, "/pizzashack/1.0.0/order/123", bearer, "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response, _ = sendMockRequest(t, server, http.MethodPost, "/pizzashack/1.0.0/order", bearer, "{}")
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	response, _ = sendMockRequest(t, server, http.MethodPatch, "/pizzashack/1.0.0/menu", bearer, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	response, _ = sendMockRequest(t, server, http.MethodGet, "/pizzashack/1.0.0/unknown", bearer, "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestMockServerAppliesCORSAndAPIKeys(t *testing.T) {
+	project := t.TempDir()
+	writeTestFile(t, filepath.Join(project, utils.APIDefinitionFileYaml), `type: api
+version: v4.5.0
+data:
+  name: Petstore
+  context: /pets
+  version: 1.0.0
+  type: HTTP
+  securityScheme:
+    - api_key
+  apiKeyHeader: X-API-Key
+  corsConfiguration:
+    corsConfigurationEnabled: true
+    accessControlAllowOrigins:
+      - http://localhost:3000
+    accessControlAllowHeaders:
+      - X-API-Key
+    accessControlAllowMethods:
+      - GET
+`)
+	writeTestFile(t, filepath.Join(project, "Definitions", "swagger.yaml"), `swagger: "2.0"
+paths:
+  /pets/{id}:
+    get:
+      responses:
+        "200":
+          schema:
+            $ref: "#/definitions/Pet"
+  /pets/mine:
+    get:
+      x-auth-type: None
+      responses:
+        "200":
+          examples:
+            application/json:
+              name: Rex
+definitions:
+  Pet:
+    type: object
+    properties:
+      id:
+        type: integer
+      born:
+        type: string
+        format: date
+`)
+	mockServer, err := LoadMockServer(project)
+	assert.Nil(t, err)
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	response, _ := sendMockRequest(t, server, http.MethodOptions, "/pets/1.0.0/pets/1", map[string]string{
+		"Origin": "http://localhost:3000", "Access-Control-Request-Method": "GET"}, "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "http://localhost:3000", response.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-API-Key", response.Header.Get("Access-Control-Allow-Headers"))
+
+	response, body := sendMockRequest(t, server, http.MethodGet, "/pets/1.0.0/pets/mine", nil, "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.JSONEq(t, `{"name": "Rex"}`, body)
+
+	response, _ = sendMockRequest(t, server, http.MethodGet, "/pets/1.0.0/pets/1", map[string]string{
+		"Authorization": "Bearer token"}, "")
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	response, body = sendMockRequest(t, server, http.MethodGet, "/pets/1.0.0/pets/1", map[string]string{
+		"X-API-Key": "key", "Origin": "http://localhost:3000"}, "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "http://localhost:3000", response.Header.Get("Access-Control-Allow-Origin"))
+	assert.JSONEq(t, `{"id": 0, "born": "2021-01-01"}`, body)
+}
+
+func TestMockServerServesGraphQLQueries(t *testing.T) {
+	project := t.TempDir()
+	writeTestFile(t, filepath.Join(project, utils.APIDefinitionFileYaml), `type: api
+version: v4.5.0
+data:
+  name: Starwars
+  context: /swapi/{version}
+  version: 1.0.0
+  type: GRAPHQL
+`)
+	writeTestFile(t, filepath.Join(project, "Definitions", "schema.graphql"), `
+schema { query: Root }
+"""A character"""
+type Character {
+  name: String!
+  episodes: [Episode!]!
+  friends(first: Int = 10): [Character]
+}
+enum Episode { NEWHOPE EMPIRE }
+type Root {
+  hero(episode: Episode): Character
+}
+`)
+	mockServer, err := LoadMockServer(project)
+	assert.Nil(t, err)
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	query := `{"query": "query Hero { hero(episode: EMPIRE) { name ...Friends } } ` +
+		`fragment Friends on Character { friends { n: name } }"}`
+	response, body := sendMockRequest(t, server, http.MethodPost, "/swapi/1.0.0", map[string]string{
+		"Authorization": "Bearer token", "Content-Type": "application/json"}, query)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.JSONEq(t, `{"data": {"hero": {"name": "string", "friends": [{"n": "string"}]}}}`, body)
+
+	response, _ = sendMockRequest(t, server, http.MethodPost, "/swapi/1.0.0", map[string]string{
+		"Authorization": "Bearer token"}, `{"query": "{ hero { name "}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
@@ -0,0 +1,471 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// mockGraphQLField is a field of a GraphQL object type with its named type
+type mockGraphQLField struct {
+	Type string
+	List bool
+}
+
+// mockGraphQLSchema holds the parts of a GraphQL schema needed to generate mock responses
+type mockGraphQLSchema struct {
+	types      map[string]map[string]*mockGraphQLField
+	enums      map[string][]string
+	unions     map[string][]string
+	rootTypes  map[string]string
+	fragments  map[string][]*mockGraphQLSelection
+	tokens     []string
+	position   int
+	parseError error
+}
+
+// mockGraphQLSelection is a field, a fragment spread or an inline fragment of a query
+type mockGraphQLSelection struct {
+	Alias      string
+	Name       string
+	Fragment   string
+	Selections []*mockGraphQLSelection
+}
+
+var mockGraphQLScalars = map[string]interface{}{
+	"Int":     1,
+	"Float":   1.5,
+	"String":  "string",
+	"Boolean": true,
+	"ID":      "1",
+}
+
+// tokenizeGraphQL splits a GraphQL document to names, punctuators, numbers and strings. Comments and commas are
+// skipped.
+func tokenizeGraphQL(source string) []string {
+	var tokens []string
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '#':
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(source[i:], `"""`):
+			end := strings.Index(source[i+3:], `"""`)
+			if end < 0 {
+				end = len(source) - i - 3
+			}
+			tokens = append(tokens, `"`)
+			i += end + 6
+		case c == '"':
+			j := i + 1
+			for j < len(source) && source[j] != '"' && source[j] != '\n' {
+				if source[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			tokens = append(tokens, `"`)
+			i = j + 1
+		case strings.HasPrefix(source[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case isGraphQLNameChar(c) || c == '-':
+			j := i + 1
+			for j < len(source) && (isGraphQLNameChar(source[j]) || source[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, source[i:j])
+			i = j
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (schema *mockGraphQLSchema) peek() string {
+	if schema.position < len(schema.tokens) {
+		return schema.tokens[schema.position]
+	}
+	return ""
+}
+
+func (schema *mockGraphQLSchema) next() string {
+	token := schema.peek()
+	schema.position++
+	return token
+}
+
+func (schema *mockGraphQLSchema) expect(token string) {
+	if actual := schema.next(); actual != token && schema.parseError == nil {
+		schema.parseError = errors.New("syntax error: expected " + token + " but found " + actual)
+	}
+}
+
+func (schema *mockGraphQLSchema) done() bool {
+	return schema.position >= len(schema.tokens) || schema.parseError != nil
+}
+
+// skipBalanced skips a group starting with the open token (eg: arguments or default values)
+func (schema *mockGraphQLSchema) skipBalanced(open, close string) {
+	if schema.peek() != open {
+		return
+	}
+	depth := 0
+	for !schema.done() {
+		token := schema.next()
+		if token == open {
+			depth++
+		} else if token == close {
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (schema *mockGraphQLSchema) skipDirectives() {
+	for schema.peek() == "@" {
+		schema.next()
+		schema.next()
+		schema.skipBalanced("(", ")")
+	}
+}
+
+// parseTypeReference parses a type reference (eg: [Pet!]!) and returns the named type and whether it is a list
+func (schema *mockGraphQLSchema) parseTypeReference() (string, bool) {
+	if schema.peek() == "[" {
+		schema.next()
+		name, _ := schema.parseTypeReference()
+		schema.expect("]")
+		if schema.peek() == "!" {
+			schema.next()
+		}
+		return name, true
+	}
+	name := schema.next()
+	if schema.peek() == "!" {
+		schema.next()
+	}
+	return name, false
+}
+
+// parseMockGraphQLSchema parses the type definitions of a GraphQL SDL schema
+func parseMockGraphQLSchema(source string) (*mockGraphQLSchema, error) {
+	schema := &mockGraphQLSchema{
+		types:     map[string]map[string]*mockGraphQLField{},
+		enums:     map[string][]string{},
+		unions:    map[string][]string{},
+		rootTypes: map[string]string{"query": "Query", "mutation": "Mutation", "subscription": "Subscription"},
+		tokens:    tokenizeGraphQL(source),
+	}
+	for !schema.done() {
+		switch schema.next() {
+		case "schema":
+			schema.skipDirectives()
+			schema.expect("{")
+			for !schema.done() && schema.peek() != "}" {
+				operation := schema.next()
+				schema.expect(":")
+				schema.rootTypes[operation] = schema.next()
+			}
+			schema.expect("}")
+		case "directive":
+			schema.expect("@")
+			schema.next()
+			schema.skipBalanced("(", ")")
+		case "type", "interface", "input":
+			schema.parseObjectType()
+		case "enum":
+			name := schema.next()
+			schema.skipDirectives()
+			if schema.peek() != "{" {
+				continue
+			}
+			schema.next()
+			for !schema.done() && schema.peek() != "}" {
+				if value := schema.next(); value != `"` {
+					schema.enums[name] = append(schema.enums[name], value)
+					schema.skipDirectives()
+				}
+			}
+			schema.expect("}")
+		case "union":
+			name := schema.next()
+			schema.skipDirectives()
+			if schema.peek() != "=" {
+				continue
+			}
+			schema.next()
+			for !schema.done() {
+				if schema.peek() == "|" {
+					schema.next()
+				}
+				schema.unions[name] = append(schema.unions[name], schema.next())
+				if schema.peek() != "|" {
+					break
+				}
+			}
+		}
+	}
+	if schema.parseError != nil {
+		return nil, errors.New("error parsing the GraphQL schema: " + schema.parseError.Error())
+	}
+	return schema, nil
+}
+
+// parseObjectType parses the fields of an object, interface or input type. Extensions add fields to the type.
+func (schema *mockGraphQLSchema) parseObjectType() {
+	name := schema.next()
+	for !schema.done() && schema.peek() != "{" {
+		switch schema.peek() {
+		case "type", "interface", "input", "enum", "union", "scalar", "schema", "extend", "directive", `"`:
+			return
+		case "@":
+			schema.skipDirectives()
+		default:
+			schema.next()
+		}
+	}
+	if schema.done() {
+		return
+	}
+	schema.next()
+	if schema.types[name] == nil {
+		schema.types[name] = map[string]*mockGraphQLField{}
+	}
+	for !schema.done() && schema.peek() != "}" {
+		fieldName := schema.next()
+		if fieldName == `"` {
+			continue
+		}
+		schema.skipBalanced("(", ")")
+		schema.expect(":")
+		fieldType, list := schema.parseTypeReference()
+		schema.types[name][fieldName] = &mockGraphQLField{Type: fieldType, List: list}
+		if schema.peek() == "=" {
+			schema.next()
+			switch schema.peek() {
+			case "{":
+				schema.skipBalanced("{", "}")
+			case "[":
+				schema.skipBalanced("[", "]")
+			default:
+				schema.next()
+			}
+		}
+		schema.skipDirectives()
+	}
+	schema.expect("}")
+}
+
+// parseMockGraphQLQuery parses a query document and returns the operation type and the selections of the operation
+func (schema *mockGraphQLSchema) parseMockGraphQLQuery(query, operationName string) (string, []*mockGraphQLSelection,
+	error) {
+	parser := &mockGraphQLSchema{tokens: tokenizeGraphQL(query)}
+	schema.fragments = map[string][]*mockGraphQLSelection{}
+	operationType, found := "", false
+	var selections []*mockGraphQLSelection
+	for !parser.done() {
+		token := parser.peek()
+		if token == "fragment" {
+			parser.next()
+			name := parser.next()
+			parser.expect("on")
+			parser.next()
+			parser.skipDirectives()
+			schema.fragments[name] = parser.parseSelectionSet()
+			continue
+		}
+		currentType, currentName := "query", ""
+		if token != "{" {
+			currentType = parser.next()
+			if parser.peek() != "{" && parser.peek() != "(" && parser.peek() != "@" {
+				currentName = parser.next()
+			}
+			parser.skipBalanced("(", ")")
+			parser.skipDirectives()
+		}
+		currentSelections := parser.parseSelectionSet()
+		if !found && (operationName == "" || operationName == currentName) {
+			operationType, selections, found = currentType, currentSelections, true
+		}
+	}
+	if parser.parseError != nil {
+		return "", nil, parser.parseError
+	}
+	if !found {
+		return "", nil, errors.New("operation " + operationName + " was not found in the query")
+	}
+	return operationType, selections, nil
+}
+
+func (schema *mockGraphQLSchema) parseSelectionSet() []*mockGraphQLSelection {
+	var selections []*mockGraphQLSelection
+	schema.expect("{")
+	for !schema.done() && schema.peek() != "}" {
+		selection := &mockGraphQLSelection{}
+		if schema.peek() == "..." {
+			schema.next()
+			if schema.peek() == "on" || schema.peek() == "{" || schema.peek() == "@" {
+				if schema.peek() == "on" {
+					schema.next()
+					schema.next()
+				}
+				schema.skipDirectives()
+				selection.Selections = schema.parseSelectionSet()
+			} else {
+				selection.Fragment = schema.next()
+				schema.skipDirectives()
+			}
+			selections = append(selections, selection)
+			continue
+		}
+		selection.Name = schema.next()
+		selection.Alias = selection.Name
+		if schema.peek() == ":" {
+			schema.next()
+			selection.Name = schema.next()
+		}
+		schema.skipBalanced("(", ")")
+		schema.skipDirectives()
+		if schema.peek() == "{" {
+			selection.Selections = schema.parseSelectionSet()
+		}
+		selections = append(selections, selection)
+	}
+	schema.expect("}")
+	return selections
+}
+
+// resolveSelections generates the values of the selected fields of a type
+func (schema *mockGraphQLSchema) resolveSelections(typeName string, selections []*mockGraphQLSelection,
+	depth int) map[string]interface{} {
+	result := map[string]interface{}{}
+	if depth > mockSchemaMaxDepth*4 {
+		return result
+	}
+	for _, selection := range selections {
+		var nested map[string]interface{}
+		if selection.Fragment != "" {
+			nested = schema.resolveSelections(typeName, schema.fragments[selection.Fragment], depth+1)
+		} else if selection.Name == "" {
+			nested = schema.resolveSelections(typeName, selection.Selections, depth+1)
+		}
+		if selection.Name == "" {
+			for key, value := range nested {
+				result[key] = value
+			}
+			continue
+		}
+		if selection.Name == "__typename" {
+			result[selection.Alias] = typeName
+			continue
+		}
+		field, ok := schema.types[typeName][selection.Name]
+		if !ok {
+			result[selection.Alias] = nil
+			continue
+		}
+		value := schema.resolveType(field.Type, selection.Selections, depth+1)
+		if field.List {
+			result[selection.Alias] = []interface{}{value}
+		} else {
+			result[selection.Alias] = value
+		}
+	}
+	return result
+}
+
+func (schema *mockGraphQLSchema) resolveType(typeName string, selections []*mockGraphQLSelection,
+	depth int) interface{} {
+	if value, ok := mockGraphQLScalars[typeName]; ok {
+		return value
+	}
+	if values, ok := schema.enums[typeName]; ok && len(values) > 0 {
+		return values[0]
+	}
+	if members, ok := schema.unions[typeName]; ok && len(members) > 0 {
+		typeName = members[0]
+	}
+	if _, ok := schema.types[typeName]; ok {
+		return schema.resolveSelections(typeName, selections, depth)
+	}
+	// Custom scalars
+	return "string"
+}
+
+// serveGraphQL answers a GraphQL request sent as a query parameter, a JSON body or an application/graphql body
+func (server *MockServer) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	query, operationName := r.URL.Query().Get("query"), r.URL.Query().Get("operationName")
+	if r.Method == http.MethodPost {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeMockGraphQLError(w, err)
+			return
+		}
+		if strings.Contains(r.Header.Get("Content-Type"), "application/graphql") {
+			query = string(body)
+		} else {
+			request := struct {
+				Query         string `json:"query"`
+				OperationName string `json:"operationName"`
+			}{}
+			if err = json.Unmarshal(body, &request); err != nil {
+				writeMockGraphQLError(w, err)
+				return
+			}
+			query, operationName = request.Query, request.OperationName
+		}
+	}
+	if strings.TrimSpace(query) == "" {
+		writeMockGraphQLError(w, errors.New("the request does not contain a query"))
+		return
+	}
+
+	operationType, selections, err := server.graphQL.parseMockGraphQLQuery(query, operationName)
+	if err != nil {
+		writeMockGraphQLError(w, err)
+		return
+	}
+	rootType := server.graphQL.rootTypes[operationType]
+	writeMockBody(w, http.StatusOK, "application/json", map[string]interface{}{
+		"data": server.graphQL.resolveSelections(rootType, selections, 0),
+	})
+}
+
+func writeMockGraphQLError(w http.ResponseWriter, err error) {
+	writeMockBody(w, http.StatusBadRequest, "application/json", map[string]interface{}{
+		"errors": []interface{}{map[string]interface{}{"message": err.Error()}},
+	})
+}
@@ -0,0 +1,416 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// NewAPIVersionOptions holds how a new version of an API is created
+type NewAPIVersionOptions struct {
+	NewVersion     string
+	DefaultVersion bool
+	// Remove the documents copied from the existing version
+	SkipDocuments bool
+	// Remove the operation policies copied from the existing version
+	SkipOperationPolicies bool
+	// Publish the new version
+	Publish bool
+	// Deprecate the existing version after publishing the new version
+	DeprecateOld bool
+	// Directory to search the local project of the API in. The git repository of the working directory is used if
+	// this is empty and the local project is not updated if the working directory is not in a git repository.
+	ProjectPath string
+}
+
+// NewAPIVersionResult holds the outcome of creating a new version of an API
+type NewAPIVersionResult struct {
+	APIId    string
+	NewAPIId string
+	// Whether the existing version was the default version before the new version was created
+	WasDefaultVersion bool
+	// Steps performed in the environment
+	Steps []string
+	// Local projects created for the new version
+	Projects []string
+}
+
+// CreateNewAPIVersion creates a new version of an API with the copy API operation of the publisher. Publishing the
+// new version and deprecating the existing one are rolled back if a later step fails, and the existing version is
+// made the default version again if the new version took it over.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API
+// @param name : Name of the API
+// @param version : Existing version of the API
+// @param provider : Provider of the API
+// @param options : Options of the new version
+// @return result, error
+func CreateNewAPIVersion(accessToken, environment, name, version, provider string,
+	options NewAPIVersionOptions) (*NewAPIVersionResult, error) {
+	apiId, err := GetAPIId(accessToken, environment, name, version, provider)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath))
+	result, err := createNewAPIVersion(accessToken, endpoint, apiId, options)
+	if err != nil {
+		return result, err
+	}
+	projectPath := options.ProjectPath
+	if projectPath == "" {
+		workingDir, _ := os.Getwd()
+		projectPath = findGitRepoBaseDir(workingDir)
+	}
+	if projectPath != "" {
+		result.Projects, err = createNewVersionProjects(projectPath, name, version, options)
+		if err != nil {
+			return result, errors.New("the new version was created in " + environment +
+				" but updating the local project failed: " + err.Error())
+		}
+	}
+	return result, nil
+}
+
+func createNewAPIVersion(accessToken, apiListEndpoint, apiId string, options NewAPIVersionOptions) (
+	*NewAPIVersionResult, error) {
+	result := &NewAPIVersionResult{APIId: apiId}
+	if options.DefaultVersion {
+		// The publisher moves the default version to the new version, which has to be reverted on rollback
+		api, err := getAPIPayload(accessToken, apiListEndpoint, apiId)
+		if err != nil {
+			return result, err
+		}
+		result.WasDefaultVersion, _ = api["isDefaultVersion"].(bool)
+	}
+	newAPIId, err := copyAPIVersion(accessToken, apiListEndpoint, apiId, options.NewVersion, options.DefaultVersion)
+	if err != nil {
+		return result, err
+	}
+	result.NewAPIId = newAPIId
+	result.Steps = append(result.Steps, "Created version "+options.NewVersion)
+
+	fail := func(step string, err error) (*NewAPIVersionResult, error) {
+		reason := step + " failed: " + err.Error()
+		if rollbackErr := deleteAPIById(accessToken, apiListEndpoint, newAPIId); rollbackErr != nil {
+			return result, errors.New(reason + ". Deleting version " + options.NewVersion + " failed: " +
+				rollbackErr.Error())
+		}
+		result.Steps = append(result.Steps, "Deleted version "+options.NewVersion)
+		if result.WasDefaultVersion {
+			if rollbackErr := setDefaultAPIVersion(accessToken, apiListEndpoint, apiId); rollbackErr != nil {
+				return result, errors.New(reason + ". Version " + options.NewVersion + " was deleted but making " +
+					"the existing version the default version again failed: " + rollbackErr.Error())
+			}
+			result.Steps = append(result.Steps, "Made the existing version the default version again")
+		}
+		return result, errors.New(reason + ". Version " + options.NewVersion + " was deleted")
+	}
+
+	if options.SkipDocuments {
+		if err := deleteAPIDocuments(accessToken, apiListEndpoint, newAPIId); err != nil {
+			return fail("removing the documents", err)
+		}
+		result.Steps = append(result.Steps, "Removed the documents of version "+options.NewVersion)
+	}
+	if options.SkipOperationPolicies {
+		if err := removeAPIOperationPolicies(accessToken, apiListEndpoint, newAPIId); err != nil {
+			return fail("removing the operation policies", err)
+		}
+		result.Steps = append(result.Steps, "Removed the operation policies of version "+options.NewVersion)
+	}
+	if options.Publish {
+		if err := changeAPIStatusById(accessToken, apiListEndpoint, newAPIId, "Publish"); err != nil {
+			return fail("publishing version "+options.NewVersion, err)
+		}
+		result.Steps = append(result.Steps, "Published version "+options.NewVersion)
+	}
+	if options.DeprecateOld {
+		if err := changeAPIStatusById(accessToken, apiListEndpoint, apiId, "Deprecate"); err != nil {
+			return fail("deprecating the existing version", err)
+		}
+		result.Steps = append(result.Steps, "Deprecated the existing version")
+	}
+	return result, nil
+}
+
+// createNewVersionProjects copies the local projects of the existing version found in a directory to sibling
+// directories of the new version and updates the definitions of both versions
+func createNewVersionProjects(searchPath, name, version string, options NewAPIVersionOptions) ([]string, error) {
+	projects, err := findAPIProjects(searchPath, name, version)
+	if err != nil {
+		return nil, err
+	}
+	var created []string
+	for _, project := range projects {
+		newProject := filepath.Join(filepath.Dir(project), name+"-"+options.NewVersion)
+		if utils.IsFileExist(newProject) {
+			return created, errors.New(newProject + " already exists")
+		}
+		if err := utils.CopyDir(project, newProject); err != nil {
+			return created, err
+		}
+		lifeCycleStatus := "CREATED"
+		if options.Publish {
+			lifeCycleStatus = "PUBLISHED"
+		}
+		err := updateProjectDefinition(newProject, func(data map[string]interface{}) {
+			data["version"] = options.NewVersion
+			data["lifeCycleStatus"] = lifeCycleStatus
+			if options.DefaultVersion {
+				data["isDefaultVersion"] = true
+			}
+		})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, newProject)
+		if options.DeprecateOld || options.DefaultVersion {
+			err = updateProjectDefinition(project, func(data map[string]interface{}) {
+				if options.DeprecateOld {
+					data["lifeCycleStatus"] = "DEPRECATED"
+				}
+				if options.DefaultVersion {
+					data["isDefaultVersion"] = false
+				}
+			})
+			if err != nil {
+				return created, err
+			}
+		}
+	}
+	return created, nil
+}
+
+// findAPIProjects returns the API project directories of the given API version found in a directory
+func findAPIProjects(searchPath, name, version string) ([]string, error) {
+	var projects []string
+	definition, _, _ := resolveYamlOrJSON(filepath.Join(searchPath, "api"))
+	if definition != "" {
+		file, err := readProjectFile(definition)
+		if err != nil {
+			return nil, err
+		}
+		data, _ := file["data"].(map[string]interface{})
+		if data != nil && data["name"] == name && data["version"] == version {
+			projects = append(projects, searchPath)
+		}
+		return projects, nil
+	}
+	entries, err := ioutil.ReadDir(searchPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		found, err := findAPIProjects(filepath.Join(searchPath, entry.Name()), name, version)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, found...)
+	}
+	return projects, nil
+}
+
+// updateProjectDefinition applies an update to the data of the API definition of a project
+func updateProjectDefinition(project string, update func(data map[string]interface{})) error {
+	definition, _, err := resolveYamlOrJSON(filepath.Join(project, "api"))
+	if err != nil {
+		return err
+	}
+	file, err := readProjectFile(definition)
+	if err != nil {
+		return err
+	}
+	data, _ := file["data"].(map[string]interface{})
+	if data == nil {
+		return errors.New("definition " + definition + " has no data")
+	}
+	update(data)
+	fileType, _ := file["type"].(string)
+	schemaVersion, _ := file["version"].(string)
+	return writeProjectFile(definition, fileType, schemaVersion, data)
+}
+
+// findGitRepoBaseDir returns the base directory of the git repository a directory is in, or an empty string
+func findGitRepoBaseDir(dir string) string {
+	for dir != "" {
+		if utils.IsFileExist(filepath.Join(dir, ".git")) {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+	return ""
+}
+
+func getNewVersionHeaders(accessToken string) map[string]string {
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	return headers
+}
+
+// copyAPIVersion creates a new version of an API with the copy API operation and returns the id of the new version
+func copyAPIVersion(accessToken, apiListEndpoint, apiId, newVersion string, defaultVersion bool) (string, error) {
+	queryParams := map[string]string{"apiId": apiId, "newVersion": newVersion,
+		"defaultVersion": fmt.Sprint(defaultVersion)}
+	resp, err := utils.InvokePOSTRequestWithQueryParam(queryParams, apiListEndpoint+"copy-api",
+		getNewVersionHeaders(accessToken), "")
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return "", getPublisherResponseError(resp, "creating version "+newVersion)
+	}
+	api := &utils.API{}
+	if err := json.Unmarshal(resp.Body(), api); err != nil {
+		return "", err
+	}
+	return api.ID, nil
+}
+
+// deleteAPIDocuments deletes all the documents of an API
+func deleteAPIDocuments(accessToken, apiListEndpoint, apiId string) error {
+	headers := getNewVersionHeaders(accessToken)
+	resp, err := utils.InvokeGETRequest(apiListEndpoint+apiId+"/documents?limit=1000", headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "retrieving the documents")
+	}
+	documents := &struct {
+		List []struct {
+			DocumentId string `json:"documentId"`
+		} `json:"list"`
+	}{}
+	if err := json.Unmarshal(resp.Body(), documents); err != nil {
+		return err
+	}
+	for _, document := range documents.List {
+		resp, err := utils.InvokeDELETERequest(apiListEndpoint+apiId+"/documents/"+document.DocumentId, headers)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+			return getPublisherResponseError(resp, "deleting the document "+document.DocumentId)
+		}
+	}
+	return nil
+}
+
+// removeAPIOperationPolicies clears the API level and operation level policies of an API
+func removeAPIOperationPolicies(accessToken, apiListEndpoint, apiId string) error {
+	api, err := getAPIPayload(accessToken, apiListEndpoint, apiId)
+	if err != nil {
+		return err
+	}
+	removeOperationPoliciesFromAPI(api)
+	return updateAPIPayload(accessToken, apiListEndpoint, apiId, api)
+}
+
+// setDefaultAPIVersion makes a version of an API the default version
+func setDefaultAPIVersion(accessToken, apiListEndpoint, apiId string) error {
+	api, err := getAPIPayload(accessToken, apiListEndpoint, apiId)
+	if err != nil {
+		return err
+	}
+	api["isDefaultVersion"] = true
+	return updateAPIPayload(accessToken, apiListEndpoint, apiId, api)
+}
+
+// getAPIPayload retrieves an API as it is returned by the publisher, so that it can be updated as a whole
+func getAPIPayload(accessToken, apiListEndpoint, apiId string) (map[string]interface{}, error) {
+	resp, err := utils.InvokeGETRequest(apiListEndpoint+apiId, getNewVersionHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the API")
+	}
+	api := map[string]interface{}{}
+	err = json.Unmarshal(resp.Body(), &api)
+	return api, err
+}
+
+// updateAPIPayload replaces an API with the given payload
+func updateAPIPayload(accessToken, apiListEndpoint, apiId string, api map[string]interface{}) error {
+	resp, err := utils.InvokePUTRequestWithoutQueryParams(apiListEndpoint+apiId, getNewVersionHeaders(accessToken),
+		api)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "updating the API")
+	}
+	return nil
+}
+
+// deleteAPIById deletes an API
+func deleteAPIById(accessToken, apiListEndpoint, apiId string) error {
+	resp, err := utils.InvokeDELETERequest(apiListEndpoint+apiId, getNewVersionHeaders(accessToken))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return getPublisherResponseError(resp, "deleting the API")
+	}
+	return nil
+}
+
+// removeOperationPoliciesFromAPI clears the API level and operation level policies of an API payload
+func removeOperationPoliciesFromAPI(api map[string]interface{}) {
+	emptyPolicies := func() map[string]interface{} {
+		return map[string]interface{}{"request": []interface{}{}, "response": []interface{}{},
+			"fault": []interface{}{}}
+	}
+	if _, ok := api["apiPolicies"]; ok {
+		api["apiPolicies"] = emptyPolicies()
+	}
+	operations, _ := api["operations"].([]interface{})
+	for _, operation := range operations {
+		if operationMap, ok := operation.(map[string]interface{}); ok {
+			operationMap["operationPolicies"] = emptyPolicies()
+		}
+	}
+}
+
+// PrintNewAPIVersionResult prints the steps performed to create a new version of an API
+func PrintNewAPIVersionResult(result *NewAPIVersionResult) {
+	for _, step := range result.Steps {
+		fmt.Println(step)
+	}
+	for _, project := range result.Projects {
+		fmt.Println("Created the local project " + project)
+	}
+}
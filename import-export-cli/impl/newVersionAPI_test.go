@@ -0,0 +1,203 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNewAPIVersion(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "POST /apis/copy-api":
+			assert.Equal(t, "old-id", r.URL.Query().Get("apiId"))
+			assert.Equal(t, "2.0.0", r.URL.Query().Get("newVersion"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "new-id", "name": "PizzaShackAPI", "version": "2.0.0"}`))
+		case "GET /apis/new-id/documents":
+			w.Write([]byte(`{"count": 1, "list": [{"documentId": "doc-1"}]}`))
+		case "POST /apis/change-lifecycle":
+			call += " " + r.URL.Query().Get("action") + " " + r.URL.Query().Get("apiId")
+		case "DELETE /apis/new-id/documents/doc-1":
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls = append(calls, call)
+	}))
+	defer server.Close()
+
+	result, err := createNewAPIVersion("access-token", server.URL+"/apis/", "old-id", NewAPIVersionOptions{
+		NewVersion: "2.0.0", SkipDocuments: true, Publish: true, DeprecateOld: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "new-id", result.NewAPIId)
+	assert.Equal(t, []string{"POST /apis/copy-api", "GET /apis/new-id/documents",
+		"DELETE /apis/new-id/documents/doc-1", "POST /apis/change-lifecycle Publish new-id",
+		"POST /apis/change-lifecycle Deprecate old-id"}, calls)
+}
+
+func TestCreateNewAPIVersionRollsBack(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "POST /apis/copy-api":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "new-id"}`))
+		case "POST /apis/change-lifecycle":
+			call += " " + r.URL.Query().Get("action")
+			if r.URL.Query().Get("action") == "Deprecate" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case "DELETE /apis/new-id":
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls = append(calls, call)
+	}))
+	defer server.Close()
+
+	_, err := createNewAPIVersion("access-token", server.URL+"/apis/", "old-id", NewAPIVersionOptions{
+		NewVersion: "2.0.0", Publish: true, DeprecateOld: true})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "was deleted")
+	assert.Equal(t, []string{"POST /apis/copy-api", "POST /apis/change-lifecycle Publish",
+		"POST /apis/change-lifecycle Deprecate", "DELETE /apis/new-id"}, calls)
+}
+
+func TestCreateNewAPIVersionRestoresDefaultVersion(t *testing.T) {
+	isDefaultVersion := true
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /apis/old-id":
+			w.Write([]byte(`{"id": "old-id", "version": "1.0.0", "isDefaultVersion": ` +
+				strconv.FormatBool(isDefaultVersion) + `}`))
+		case "POST /apis/copy-api":
+			assert.Equal(t, "true", r.URL.Query().Get("defaultVersion"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "new-id"}`))
+		case "POST /apis/change-lifecycle":
+			w.WriteHeader(http.StatusBadRequest)
+		case "DELETE /apis/new-id":
+		case "PUT /apis/old-id":
+			api := map[string]interface{}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&api))
+			assert.Equal(t, true, api["isDefaultVersion"])
+			assert.Equal(t, "1.0.0", api["version"])
+		default:
+			t.Errorf("Unexpected request %s", call)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls = append(calls, call)
+	}))
+	defer server.Close()
+	options := NewAPIVersionOptions{NewVersion: "2.0.0", DefaultVersion: true, Publish: true}
+
+	result, err := createNewAPIVersion("access-token", server.URL+"/apis/", "old-id", options)
+	assert.NotNil(t, err)
+	assert.True(t, result.WasDefaultVersion)
+	assert.Equal(t, []string{"GET /apis/old-id", "POST /apis/copy-api", "POST /apis/change-lifecycle",
+		"DELETE /apis/new-id", "GET /apis/old-id", "PUT /apis/old-id"}, calls)
+
+	// The default version is not touched if the existing version was not the default version
+	isDefaultVersion = false
+	calls = nil
+	result, err = createNewAPIVersion("access-token", server.URL+"/apis/", "old-id", options)
+	assert.NotNil(t, err)
+	assert.False(t, result.WasDefaultVersion)
+	assert.Equal(t, []string{"GET /apis/old-id", "POST /apis/copy-api", "POST /apis/change-lifecycle",
+		"DELETE /apis/new-id"}, calls)
+}
+
+func TestCreateNewVersionProjects(t *testing.T) {
+	repo := t.TempDir()
+	writeTestFile(t, filepath.Join(repo, "apis", "PizzaShackAPI-1.0.0", "api.yaml"), `type: api
+version: v4.2.0
+data:
+  name: PizzaShackAPI
+  version: 1.0.0
+  lifeCycleStatus: PUBLISHED
+  isDefaultVersion: true
+`)
+	writeTestFile(t, filepath.Join(repo, "apis", "OtherAPI-1.0.0", "api.yaml"), `type: api
+version: v4.2.0
+data:
+  name: OtherAPI
+  version: 1.0.0
+`)
+
+	projects, err := createNewVersionProjects(repo, "PizzaShackAPI", "1.0.0", NewAPIVersionOptions{
+		NewVersion: "2.0.0", DefaultVersion: true, Publish: true, DeprecateOld: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{filepath.Join(repo, "apis", "PizzaShackAPI-2.0.0")}, projects)
+
+	newProject, err := readProjectFile(filepath.Join(projects[0], "api.yaml"))
+	assert.Nil(t, err)
+	newData := newProject["data"].(map[string]interface{})
+	assert.Equal(t, "2.0.0", newData["version"])
+	assert.Equal(t, "PUBLISHED", newData["lifeCycleStatus"])
+	assert.Equal(t, "v4.2.0", newProject["version"])
+
+	oldProject, err := readProjectFile(filepath.Join(repo, "apis", "PizzaShackAPI-1.0.0", "api.yaml"))
+	assert.Nil(t, err)
+	oldData := oldProject["data"].(map[string]interface{})
+	assert.Equal(t, "DEPRECATED", oldData["lifeCycleStatus"])
+	assert.Equal(t, false, oldData["isDefaultVersion"])
+
+	_, err = createNewVersionProjects(repo, "PizzaShackAPI", "1.0.0", NewAPIVersionOptions{NewVersion: "2.0.0"})
+	assert.NotNil(t, err, "an existing project of the new version should not be overwritten")
+}
+
+func TestRemoveOperationPoliciesFromAPI(t *testing.T) {
+	api := map[string]interface{}{
+		"apiPolicies": map[string]interface{}{"request": []interface{}{"policy"}},
+		"operations": []interface{}{
+			map[string]interface{}{"target": "/menu", "operationPolicies": map[string]interface{}{
+				"request": []interface{}{"addHeader"}}},
+		},
+	}
+	removeOperationPoliciesFromAPI(api)
+	assert.Empty(t, api["apiPolicies"].(map[string]interface{})["request"])
+	operation := api["operations"].([]interface{})[0].(map[string]interface{})
+	assert.Empty(t, operation["operationPolicies"].(map[string]interface{})["request"])
+}
+
+func TestFindGitRepoBaseDir(t *testing.T) {
+	repo := t.TempDir()
+	nested := filepath.Join(repo, "apis", "PizzaShackAPI")
+	assert.Nil(t, os.MkdirAll(filepath.Join(repo, ".git"), os.ModePerm))
+	assert.Nil(t, os.MkdirAll(nested, os.ModePerm))
+	assert.Equal(t, repo, findGitRepoBaseDir(nested))
+}
@@ -0,0 +1,376 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// OCIProjectInfo holds the information of a project which is stored in an OCI registry
+type OCIProjectInfo struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// getOCIArtifactType returns the OCI artifact type of a project type
+func getOCIArtifactType(projectType string) (string, error) {
+	switch projectType {
+	case utils.ProjectTypeApi:
+		return utils.OCIAPIArtifactType, nil
+	case utils.ProjectTypeApiProduct:
+		return utils.OCIAPIProductArtifactType, nil
+	case utils.ProjectTypeApplication:
+		return utils.OCIApplicationArtifactType, nil
+	}
+	return "", errors.New("projects of type " + projectType + " cannot be stored in an OCI registry")
+}
+
+// getProjectTypeOfOCIArtifactType returns the project type of an OCI artifact type
+func getProjectTypeOfOCIArtifactType(artifactType string) string {
+	switch artifactType {
+	case utils.OCIAPIArtifactType:
+		return utils.ProjectTypeApi
+	case utils.OCIAPIProductArtifactType:
+		return utils.ProjectTypeApiProduct
+	case utils.OCIApplicationArtifactType:
+		return utils.ProjectTypeApplication
+	}
+	return utils.ProjectTypeNone
+}
+
+// GetProjectTypeOfDirectory decides the project type by the definition file inside the project directory
+func GetProjectTypeOfDirectory(projectDir string) string {
+	if _, _, err := resolveYamlOrJSON(filepath.Join(projectDir, "api")); err == nil {
+		return utils.ProjectTypeApi
+	}
+	if _, _, err := resolveYamlOrJSON(filepath.Join(projectDir, "api_product")); err == nil {
+		return utils.ProjectTypeApiProduct
+	}
+	if _, _, err := resolveYamlOrJSON(filepath.Join(projectDir, "application")); err == nil {
+		return utils.ProjectTypeApplication
+	}
+	return utils.ProjectTypeNone
+}
+
+// readOCIProjectInfo reads the name, version and provider of a project from its meta file and definition
+func readOCIProjectInfo(projectDir, projectType string) (*OCIProjectInfo, error) {
+	info := &OCIProjectInfo{Type: projectType}
+	var definitionName, metaFileName string
+	switch projectType {
+	case utils.ProjectTypeApi:
+		definitionName, metaFileName = "api", utils.MetaFileAPI
+	case utils.ProjectTypeApiProduct:
+		definitionName, metaFileName = "api_product", utils.MetaFileAPIProduct
+	case utils.ProjectTypeApplication:
+		definitionName, metaFileName = "application", utils.MetaFileApplication
+	}
+
+	_, jsonContent, err := resolveYamlOrJSON(filepath.Join(projectDir, definitionName))
+	if err != nil {
+		return nil, err
+	}
+	definition := struct {
+		Data struct {
+			Name            string `json:"name"`
+			Version         string `json:"version"`
+			Provider        string `json:"provider"`
+			ApplicationInfo struct {
+				Name  string `json:"name"`
+				Owner string `json:"owner"`
+			} `json:"applicationInfo"`
+		} `json:"data"`
+	}{}
+	err = json.Unmarshal(jsonContent, &definition)
+	if err != nil {
+		return nil, err
+	}
+	if projectType == utils.ProjectTypeApplication {
+		info.Name = definition.Data.ApplicationInfo.Name
+		info.Provider = definition.Data.ApplicationInfo.Owner
+	} else {
+		info.Name = definition.Data.Name
+		info.Version = definition.Data.Version
+		info.Provider = definition.Data.Provider
+	}
+
+	// Values in the meta file take precedence over the definition
+	metaFilePath := filepath.Join(projectDir, metaFileName)
+	if utils.IsFileExist(metaFilePath) {
+		metaData, err := LoadMetaInfoFromFile(metaFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if metaData.Name != "" {
+			info.Name = metaData.Name
+		}
+		if metaData.Version != "" {
+			info.Version = metaData.Version
+		}
+		if metaData.Owner != "" {
+			info.Provider = metaData.Owner
+		}
+	}
+	if info.Name == "" {
+		return nil, errors.New("name of the " + projectType + " could not be found in " + projectDir)
+	}
+	return info, nil
+}
+
+// annotations returns the annotations to be added to the manifest of the project
+func (info *OCIProjectInfo) annotations(title string) map[string]string {
+	annotations := map[string]string{
+		utils.OCIAnnotationTitle:       title,
+		utils.OCIAnnotationProjectType: info.Type,
+		utils.OCIAnnotationName:        info.Name,
+	}
+	if info.Version != "" {
+		annotations[utils.OCIAnnotationVersion] = info.Version
+		annotations[utils.OCIAnnotationVersionOfProject] = info.Version
+	}
+	if info.Provider != "" {
+		annotations[utils.OCIAnnotationProvider] = info.Provider
+	}
+	return annotations
+}
+
+// bundleName returns the name of the bundle of the project, without the extension
+func (info *OCIProjectInfo) bundleName() string {
+	if info.Version == "" {
+		return info.Name
+	}
+	return info.Name + "_" + info.Version
+}
+
+// getOCIRegistryCredentials returns the given credentials, or the credentials from the environment variables
+func getOCIRegistryCredentials(username, password string) (string, string) {
+	if username == "" {
+		username = os.Getenv(utils.OCIUsernameEnvVariable)
+	}
+	if password == "" {
+		password = os.Getenv(utils.OCIPasswordEnvVariable)
+	}
+	return username, password
+}
+
+// PushProjectToOCIRegistry pushes an API, API Product or Application project (directory or archive) to an OCI
+// registry as an artifact
+// @param projectPath : Path to the project directory or archive
+// @param projectType : Expected type of the project
+// @param reference : OCI reference to push to. eg: oci://localhost:5000/apis/petstore:1.0.0
+// @param username : Username of the registry
+// @param password : Password of the registry
+// @param plainHTTP : Whether to connect to the registry with plain HTTP
+// @return digest of the pushed manifest
+// @return error
+func PushProjectToOCIRegistry(projectPath, projectType, reference, username, password string,
+	plainHTTP bool) (string, error) {
+	ref, err := utils.ParseOCIReference(reference)
+	if err != nil {
+		return "", err
+	}
+	if ref.Digest != "" {
+		return "", errors.New("a tag should be given instead of a digest when pushing to " + reference)
+	}
+	artifactType, err := getOCIArtifactType(projectType)
+	if err != nil {
+		return "", err
+	}
+
+	tmpProjectDir, err := utils.GetTempCloneFromDirOrZip(projectPath)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(filepath.Dir(tmpProjectDir))
+
+	if actualType := GetProjectTypeOfDirectory(tmpProjectDir); actualType != projectType {
+		return "", fmt.Errorf("%s is not a valid %s project", projectPath, projectType)
+	}
+	info, err := readOCIProjectInfo(tmpProjectDir, projectType)
+	if err != nil {
+		return "", err
+	}
+
+	// Directories are bundled reproducibly, so that the same project always results in the same digest
+	var bundle []byte
+	if stat, err := os.Stat(projectPath); err == nil && stat.IsDir() {
+		bundlePath := filepath.Join(filepath.Dir(tmpProjectDir), info.bundleName()+utils.ZipFileSuffix)
+		err = utils.Zip(projectPath, bundlePath)
+		if err != nil {
+			return "", err
+		}
+		bundle, err = ioutil.ReadFile(bundlePath)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		bundle, err = ioutil.ReadFile(projectPath)
+		if err != nil {
+			return "", err
+		}
+	}
+	config, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+
+	username, password = getOCIRegistryCredentials(username, password)
+	client := utils.NewOCIRegistryClient(ref, username, password, plainHTTP)
+	utils.Logln(utils.LogPrefixInfo+"Pushing the config of", info.Name, "to", ref.String())
+	configDigest, err := client.PushBlob(config)
+	if err != nil {
+		return "", err
+	}
+	utils.Logln(utils.LogPrefixInfo+"Pushing the project", info.Name, "to", ref.String())
+	layerDigest, err := client.PushBlob(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	title := info.bundleName() + utils.ZipFileSuffix
+	manifest := &utils.OCIManifest{
+		SchemaVersion: 2,
+		MediaType:     utils.OCIManifestMediaType,
+		ArtifactType:  artifactType,
+		Config: utils.OCIDescriptor{
+			MediaType: utils.OCIConfigMediaType,
+			Digest:    configDigest,
+			Size:      int64(len(config)),
+		},
+		Layers: []utils.OCIDescriptor{{
+			MediaType:   artifactType + utils.OCIProjectLayerMediaTypeSuffix,
+			Digest:      layerDigest,
+			Size:        int64(len(bundle)),
+			Annotations: map[string]string{utils.OCIAnnotationTitle: title},
+		}},
+		Annotations: info.annotations(title),
+	}
+	return client.PushManifest(manifest)
+}
+
+// PullProjectFromOCIRegistry pulls a project stored as an artifact from an OCI registry and writes its archive to
+// the destination directory
+// @param reference : OCI reference to pull from. eg: oci://localhost:5000/apis/petstore:1.0.0
+// @param destination : Directory to write the archive of the project
+// @param username : Username of the registry
+// @param password : Password of the registry
+// @param plainHTTP : Whether to connect to the registry with plain HTTP
+// @return path to the archive of the project
+// @return information of the project
+// @return error
+func PullProjectFromOCIRegistry(reference, destination, username, password string, plainHTTP bool) (string,
+	*OCIProjectInfo, error) {
+	ref, err := utils.ParseOCIReference(reference)
+	if err != nil {
+		return "", nil, err
+	}
+	username, password = getOCIRegistryCredentials(username, password)
+	client := utils.NewOCIRegistryClient(ref, username, password, plainHTTP)
+
+	utils.Logln(utils.LogPrefixInfo+"Fetching the manifest of", ref.String())
+	manifest, err := client.FetchManifest()
+	if err != nil {
+		return "", nil, err
+	}
+	projectType := getProjectTypeOfOCIArtifactType(manifest.ArtifactType)
+	if projectType == utils.ProjectTypeNone {
+		// Registries which drop the artifact type keep the project type annotation
+		switch annotatedType := manifest.Annotations[utils.OCIAnnotationProjectType]; annotatedType {
+		case utils.ProjectTypeApi, utils.ProjectTypeApiProduct, utils.ProjectTypeApplication:
+			projectType = annotatedType
+		}
+	}
+	var layer *utils.OCIDescriptor
+	for i := range manifest.Layers {
+		if strings.HasSuffix(manifest.Layers[i].MediaType, utils.OCIProjectLayerMediaTypeSuffix) {
+			layer = &manifest.Layers[i]
+			break
+		}
+	}
+	if projectType == utils.ProjectTypeNone || layer == nil {
+		return "", nil, errors.New(ref.String() + " is not an API, API Product or Application project")
+	}
+
+	info := &OCIProjectInfo{
+		Type:     projectType,
+		Name:     manifest.Annotations[utils.OCIAnnotationName],
+		Version:  manifest.Annotations[utils.OCIAnnotationVersionOfProject],
+		Provider: manifest.Annotations[utils.OCIAnnotationProvider],
+	}
+	title := filepath.Base(layer.Annotations[utils.OCIAnnotationTitle])
+	if title == "" || title == "." || title == string(filepath.Separator) {
+		title = info.bundleName() + utils.ZipFileSuffix
+	}
+
+	utils.Logln(utils.LogPrefixInfo+"Fetching the project", title, "from", ref.String())
+	bundle, err := client.FetchBlob(*layer)
+	if err != nil {
+		return "", nil, err
+	}
+	err = utils.CreateDirIfNotExist(destination)
+	if err != nil {
+		return "", nil, err
+	}
+	bundlePath := filepath.Join(destination, title)
+	err = ioutil.WriteFile(bundlePath, bundle, 0644)
+	if err != nil {
+		return "", nil, err
+	}
+	return bundlePath, info, nil
+}
+
+// resolveOCIImportPath pulls the project to a temporary directory when the import path is an OCI reference
+// @param importPath : Path or OCI reference of the project to be imported
+// @param projectType : Expected type of the project
+// @return path to the project to be imported
+// @return func() to cleanup the pulled project, nil if nothing was pulled
+// @return error
+func resolveOCIImportPath(importPath, projectType string) (string, func(), error) {
+	if !utils.IsOCIReference(importPath) {
+		return importPath, nil, nil
+	}
+	tmpDir, err := ioutil.TempDir("", "apictl-oci")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		utils.Logln(utils.LogPrefixInfo+"Deleting", tmpDir)
+		_ = os.RemoveAll(tmpDir)
+	}
+	bundlePath, info, err := PullProjectFromOCIRegistry(importPath, tmpDir, "", "", false)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	if info.Type != projectType {
+		cleanup()
+		return "", nil, fmt.Errorf("%s is a %s project, not a %s project", importPath, info.Type, projectType)
+	}
+	utils.Logln(utils.LogPrefixInfo+"Pulled", importPath, "to", bundlePath)
+	return bundlePath, cleanup, nil
+}
@@ -0,0 +1,128 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// newInMemoryOCIRegistry starts a minimal OCI distribution API which keeps blobs and manifests in memory
+func newInMemoryOCIRegistry() *httptest.Server {
+	var lock sync.Mutex
+	blobs := map[string][]byte{}
+	manifests := map[string][]byte{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		path := r.URL.Path
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(path, "/blobs/uploads/"):
+			w.Header().Set("Location", path+"upload-1")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPut && strings.Contains(path, "/blobs/uploads/"):
+			content, _ := ioutil.ReadAll(r.Body)
+			blobs[r.URL.Query().Get("digest")] = content
+			w.WriteHeader(http.StatusCreated)
+		case strings.Contains(path, "/blobs/"):
+			content, ok := blobs[path[strings.LastIndex(path, "/")+1:]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(content)
+		case r.Method == http.MethodPut && strings.Contains(path, "/manifests/"):
+			content, _ := ioutil.ReadAll(r.Body)
+			manifests[path] = content
+			w.WriteHeader(http.StatusCreated)
+		case strings.Contains(path, "/manifests/"):
+			content, ok := manifests[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestPushAndPullAPIProjectWithOCIRegistry(t *testing.T) {
+	registry := newInMemoryOCIRegistry()
+	defer registry.Close()
+	reference := utils.OCIReferencePrefix + strings.TrimPrefix(registry.URL, "http://") + "/apis/pizzashack:1.0.0"
+	projectPath := filepath.Join(utils.GetRelativeTestDataPathFromImpl(), "PizzaShackAPI-1.0.0")
+
+	digest, err := PushProjectToOCIRegistry(projectPath, utils.ProjectTypeApi, reference, "", "", false)
+	assert.Nil(t, err, "Should push the API project")
+	secondDigest, err := PushProjectToOCIRegistry(projectPath, utils.ProjectTypeApi, reference, "", "", false)
+	assert.Nil(t, err, "Should push the API project again")
+	assert.Equal(t, digest, secondDigest, "Pushing the same project should result in the same digest")
+
+	bundlePath, info, err := PullProjectFromOCIRegistry(reference, t.TempDir(), "", "", false)
+	assert.Nil(t, err, "Should pull the API project")
+	assert.Equal(t, utils.ProjectTypeApi, info.Type)
+	assert.Equal(t, "PizzaShackAPI", info.Name)
+	assert.Equal(t, "1.0.0", info.Version)
+	assert.Equal(t, "admin", info.Provider)
+	assert.Equal(t, "PizzaShackAPI_1.0.0.zip", filepath.Base(bundlePath))
+
+	_, cleanup, err := resolveOCIImportPath(reference, utils.ProjectTypeApiProduct)
+	assert.NotNil(t, err, "Should not import an API project as an API Product")
+	assert.Nil(t, cleanup)
+}
+
+func TestPullProjectWithoutArtifactType(t *testing.T) {
+	registry := newInMemoryOCIRegistry()
+	defer registry.Close()
+	reference := utils.OCIReferencePrefix + strings.TrimPrefix(registry.URL, "http://") + "/apis/pizzashack:1.0.0"
+	projectPath := filepath.Join(utils.GetRelativeTestDataPathFromImpl(), "PizzaShackAPI-1.0.0")
+	_, err := PushProjectToOCIRegistry(projectPath, utils.ProjectTypeApi, reference, "", "", false)
+	assert.Nil(t, err, "Should push the API project")
+
+	// Replace the manifest with one without the artifact type as some registries drop it
+	ref, err := utils.ParseOCIReference(reference)
+	assert.Nil(t, err)
+	client := utils.NewOCIRegistryClient(ref, "", "", false)
+	manifest, err := client.FetchManifest()
+	assert.Nil(t, err)
+	manifest.ArtifactType = ""
+	_, err = client.PushManifest(manifest)
+	assert.Nil(t, err)
+
+	_, info, err := PullProjectFromOCIRegistry(reference, t.TempDir(), "", "", false)
+	assert.Nil(t, err, "Should pull the API project by its project type annotation")
+	assert.Equal(t, utils.ProjectTypeApi, info.Type)
+}
+
+func TestPushProjectOfWrongType(t *testing.T) {
+	projectPath := filepath.Join(utils.GetRelativeTestDataPathFromImpl(), "PizzaShackAPI-1.0.0")
+	_, err := PushProjectToOCIRegistry(projectPath, utils.ProjectTypeApiProduct,
+		"oci://localhost:5000/products/pizzashack:1.0.0", "", "", false)
+	assert.NotNil(t, err, "Should not push an API project as an API Product")
+}
@@ -0,0 +1,176 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// validateSchemaValue validates a value against a schema of the definition
+// @param definition : OpenAPI definition which contains the referenced schemas
+// @param schemaValue : Schema or a reference to a schema
+// @param value : Value decoded from JSON
+// @param path : Path of the value to be used in the violations (eg: $.items[0])
+// @return violations of the schema
+func validateSchemaValue(definition map[string]interface{}, schemaValue, value interface{}, path string,
+	depth int) []string {
+	schema, ok := resolveMockRef(definition, schemaValue).(map[string]interface{})
+	if !ok || depth > mockSchemaMaxDepth*2 {
+		return nil
+	}
+	if value == nil {
+		if schema["nullable"] == true || schema["x-nullable"] == true || schema["type"] == nil {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: expected %v but was null", path, schema["type"])}
+	}
+
+	var violations []string
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, part := range allOf {
+			violations = append(violations, validateSchemaValue(definition, part, value, path, depth+1)...)
+		}
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		options, ok := schema[key].([]interface{})
+		if !ok {
+			continue
+		}
+		matched := false
+		for _, option := range options {
+			if len(validateSchemaValue(definition, option, value, path, depth+1)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			violations = append(violations, fmt.Sprintf("%s: does not match any of the schemas in %s", path, key))
+		}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		matched := false
+		for _, allowed := range enum {
+			matched = matched || fmt.Sprint(allowed) == fmt.Sprint(value)
+		}
+		if !matched {
+			violations = append(violations, fmt.Sprintf("%s: %v is not one of %v", path, value, enum))
+		}
+	}
+
+	schemaType, _ := schema["type"].(string)
+	if schemaType == "" {
+		if _, ok := schema["properties"]; ok {
+			schemaType = "object"
+		}
+	}
+	switch schemaType {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return append(violations, fmt.Sprintf("%s: expected object but was %s", path, getJSONType(value)))
+		}
+		for _, required := range toSlice(schema["required"]) {
+			if _, ok := object[fmt.Sprint(required)]; !ok {
+				violations = append(violations, fmt.Sprintf("%s: missing required property %v", path, required))
+			}
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		var names []string
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			propertyPath := path + "." + name
+			if property, ok := properties[name]; ok {
+				violations = append(violations, validateSchemaValue(definition, property, object[name],
+					propertyPath, depth+1)...)
+			} else if schema["additionalProperties"] == false {
+				violations = append(violations, fmt.Sprintf("%s: property is not allowed", propertyPath))
+			} else if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+				violations = append(violations, validateSchemaValue(definition, additional, object[name],
+					propertyPath, depth+1)...)
+			}
+		}
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return append(violations, fmt.Sprintf("%s: expected array but was %s", path, getJSONType(value)))
+		}
+		for i, item := range array {
+			violations = append(violations, validateSchemaValue(definition, schema["items"], item,
+				fmt.Sprintf("%s[%d]", path, i), depth+1)...)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return append(violations, fmt.Sprintf("%s: expected string but was %s", path, getJSONType(value)))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if expression, err := regexp.Compile(pattern); err == nil && !expression.MatchString(text) {
+				violations = append(violations, fmt.Sprintf("%s: %q does not match the pattern %s", path, text,
+					pattern))
+			}
+		}
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return append(violations, fmt.Sprintf("%s: expected %s but was %s", path, schemaType,
+				getJSONType(value)))
+		}
+		if schemaType == "integer" && number != math.Trunc(number) {
+			violations = append(violations, fmt.Sprintf("%s: expected integer but was %v", path, number))
+		}
+		if minimum, ok := schema["minimum"].(float64); ok && number < minimum {
+			violations = append(violations, fmt.Sprintf("%s: %v is less than the minimum %v", path, number, minimum))
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && number > maximum {
+			violations = append(violations, fmt.Sprintf("%s: %v is greater than the maximum %v", path, number,
+				maximum))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			violations = append(violations, fmt.Sprintf("%s: expected boolean but was %s", path, getJSONType(value)))
+		}
+	}
+	return violations
+}
+
+// getJSONType returns the JSON type name of a decoded value
+func getJSONType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return strings.ToLower(fmt.Sprintf("%T", value))
+}
@@ -0,0 +1,477 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Gateways supported by operation policies and the extensions of their templates
+const (
+	PolicyGatewaySynapse       = "Synapse"
+	PolicyGatewayChoreoConnect = "ChoreoConnect"
+)
+
+var policyTemplateExtensions = map[string]string{
+	PolicyGatewaySynapse:       ".j2",
+	PolicyGatewayChoreoConnect: ".gotmpl",
+}
+
+var policyApplicableFlows = []string{"request", "response", "fault"}
+var policyAttributeTypes = []string{"String", "Integer", "Long", "Double", "Boolean", "Enum", "Map"}
+var policyAttributeNameRegex = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+
+// OperationPolicy is an operation policy directory with its specification
+type OperationPolicy struct {
+	Dir  string
+	Name string
+	Spec operationPolicyDefinition
+}
+
+// operationPolicyDefinitionFile is the specification file of an operation policy
+type operationPolicyDefinitionFile struct {
+	Type    string                    `json:"type" yaml:"type"`
+	Version string                    `json:"version" yaml:"version"`
+	Data    operationPolicyDefinition `json:"data" yaml:"data"`
+}
+
+type operationPolicyDefinition struct {
+	Category          string                     `json:"category" yaml:"category"`
+	Name              string                     `json:"name" yaml:"name"`
+	Version           string                     `json:"version" yaml:"version"`
+	DisplayName       string                     `json:"displayName" yaml:"displayName"`
+	Description       string                     `json:"description" yaml:"description"`
+	ApplicableFlows   []string                   `json:"applicableFlows" yaml:"applicableFlows"`
+	SupportedGateways []string                   `json:"supportedGateways" yaml:"supportedGateways"`
+	SupportedApiTypes []string                   `json:"supportedApiTypes" yaml:"supportedApiTypes"`
+	PolicyAttributes  []operationPolicyAttribute `json:"policyAttributes" yaml:"policyAttributes"`
+}
+
+type operationPolicyAttribute struct {
+	Name            string      `json:"name" yaml:"name"`
+	DisplayName     string      `json:"displayName" yaml:"displayName"`
+	Description     string      `json:"description" yaml:"description"`
+	ValidationRegex string      `json:"validationRegex,omitempty" yaml:"validationRegex,omitempty"`
+	Type            string      `json:"type" yaml:"type"`
+	AllowedValues   []string    `json:"allowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	DefaultValue    interface{} `json:"defaultValue,omitempty" yaml:"defaultValue,omitempty"`
+	Required        bool        `json:"required" yaml:"required"`
+}
+
+// InitOperationPolicy scaffolds an operation policy with its specification, templates and sample parameters. The
+// name of the policy is the name of the directory.
+// @param dir : Directory of the policy
+// @param gateways : Gateways supported by the policy
+// @param flows : Flows the policy is applicable to
+// @param force : Overwrite the files if the directory already exists
+// @return error
+func InitOperationPolicy(dir string, gateways, flows []string, force bool) error {
+	name := filepath.Base(filepath.Clean(dir))
+	if utils.IsFileExist(dir) && !force {
+		return errors.New(dir + " already exists. Use --force to overwrite it")
+	}
+	spec := operationPolicyDefinition{
+		Category:          "Mediation",
+		Name:              name,
+		Version:           utils.MigrateProjectPolicyVersion,
+		DisplayName:       name,
+		Description:       "Sets a header with the given name and value",
+		ApplicableFlows:   flows,
+		SupportedGateways: gateways,
+		SupportedApiTypes: []string{"HTTP"},
+		PolicyAttributes: []operationPolicyAttribute{
+			{Name: "headerName", DisplayName: "Header Name", Description: "Name of the header to be set",
+				ValidationRegex: `^([a-zA-Z_][a-zA-Z\d_\-]*)$`, Type: "String", Required: true},
+			{Name: "headerValue", DisplayName: "Header Value", Description: "Value of the header",
+				Type: "String", Required: true},
+		},
+	}
+	if issues := validateOperationPolicySpec(spec); len(issues) > 0 {
+		return errors.New(strings.Join(issues, "\n"))
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(operationPolicyDefinitionFile{Type: utils.SchemaTypeOperationPolicySpecification,
+		Version: utils.MigrateProjectLatestSchemaVersion, Data: spec})
+	if err != nil {
+		return err
+	}
+	files := map[string]string{
+		name + ".yaml":               string(content),
+		utils.PolicySampleParamsFile: "headerName: X-Sample-Header\nheaderValue: sample\n",
+	}
+	for _, gateway := range gateways {
+		switch gateway {
+		case PolicyGatewaySynapse:
+			files[name+".j2"] = "<property action=\"set\" name=\"{{ headerName }}\" value=\"{{ headerValue }}\" " +
+				"scope=\"transport\"/>\n"
+		case PolicyGatewayChoreoConnect:
+			files[name+".gotmpl"] = "definition:\n  action: SET_HEADER\n  parameters:\n" +
+				"    headerName: {{ .headerName }}\n    headerValue: {{ .headerValue }}\n"
+		}
+	}
+	for fileName, fileContent := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, fileName), []byte(fileContent), os.ModePerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadOperationPolicy reads the specification of an operation policy directory
+// @param dir : Directory of the policy
+// @return operation policy, error
+func LoadOperationPolicy(dir string) (*OperationPolicy, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New(dir + " is not a directory")
+	}
+	name := filepath.Base(filepath.Clean(dir))
+	for _, extension := range []string{".yaml", ".yml", ".json"} {
+		path := filepath.Join(dir, name+extension)
+		if !utils.IsFileExist(path) {
+			continue
+		}
+		file, err := readProjectFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if fileType, _ := file["type"].(string); fileType != utils.SchemaTypeOperationPolicySpecification {
+			return nil, fmt.Errorf("type of %s should be %s", path, utils.SchemaTypeOperationPolicySpecification)
+		}
+		content, err := json.Marshal(file["data"])
+		if err != nil {
+			return nil, err
+		}
+		policy := &OperationPolicy{Dir: dir, Name: name}
+		if err = json.Unmarshal(content, &policy.Spec); err != nil {
+			return nil, errors.New("error reading " + path + ": " + err.Error())
+		}
+		return policy, nil
+	}
+	return nil, errors.New("specification file " + name + ".yaml of the policy is not found in " + dir)
+}
+
+// ValidateOperationPolicy validates the specification and the templates of an operation policy
+// @param dir : Directory of the policy
+// @return issues found in the policy, error
+func ValidateOperationPolicy(dir string) ([]string, error) {
+	policy, err := LoadOperationPolicy(dir)
+	if err != nil {
+		return nil, err
+	}
+	issues := validateOperationPolicySpec(policy.Spec)
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		extension := filepath.Ext(file.Name())
+		isPolicyFile := extension == ".yaml" || extension == ".yml" || extension == ".json" || extension == ".j2" ||
+			extension == ".gotmpl"
+		if isPolicyFile && file.Name() != utils.PolicySampleParamsFile && file.Name() != policy.Name+extension {
+			issues = append(issues, file.Name()+" should be named after the policy directory "+policy.Name)
+		}
+	}
+
+	declared := make(map[string]bool)
+	for _, attribute := range policy.Spec.PolicyAttributes {
+		declared[attribute.Name] = true
+	}
+	for _, gateway := range policy.Spec.SupportedGateways {
+		extension, ok := policyTemplateExtensions[gateway]
+		if !ok {
+			continue
+		}
+		templateName := policy.Name + extension
+		variables, err := policyTemplateVariables(filepath.Join(dir, templateName), extension)
+		if os.IsNotExist(err) {
+			issues = append(issues, fmt.Sprintf("template %s of the supported gateway %s is not found", templateName,
+				gateway))
+			continue
+		}
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("template %s is not valid: %s", templateName, err.Error()))
+			continue
+		}
+		for _, variable := range variables {
+			if !declared[variable] {
+				issues = append(issues, fmt.Sprintf("template %s refers to the undeclared parameter %s",
+					templateName, variable))
+			}
+		}
+	}
+	return issues, nil
+}
+
+// RenderOperationPolicy renders the templates of an operation policy with the given parameters
+// @param dir : Directory of the policy
+// @param paramsPath : YAML or JSON file with the values of the parameters
+// @param gateway : Gateway to render the template of. The templates of all the supported gateways are rendered
+// if this is empty.
+// @return rendered templates by the gateway, error
+func RenderOperationPolicy(dir, paramsPath, gateway string) (map[string]string, error) {
+	policy, err := LoadOperationPolicy(dir)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	if paramsPath != "" {
+		content, err := ioutil.ReadFile(paramsPath)
+		if err != nil {
+			return nil, err
+		}
+		if content, err = utils.YamlToJson(content); err != nil {
+			return nil, errors.New("error reading " + paramsPath + ": " + err.Error())
+		}
+		if err = json.Unmarshal(content, &values); err != nil {
+			return nil, errors.New("error reading " + paramsPath + ": " + err.Error())
+		}
+	}
+	scope, err := resolveOperationPolicyParams(policy.Spec, values)
+	if err != nil {
+		return nil, err
+	}
+
+	gateways := policy.Spec.SupportedGateways
+	if gateway != "" {
+		if !containsString(gateways, gateway) {
+			return nil, errors.New("gateway " + gateway + " is not supported by the policy " + policy.Name)
+		}
+		gateways = []string{gateway}
+	}
+	rendered := make(map[string]string)
+	for _, gateway := range gateways {
+		extension, ok := policyTemplateExtensions[gateway]
+		if !ok {
+			return nil, errors.New("unknown gateway " + gateway)
+		}
+		templateName := policy.Name + extension
+		content, err := ioutil.ReadFile(filepath.Join(dir, templateName))
+		if err != nil {
+			return nil, err
+		}
+		if extension == ".j2" {
+			nodes, err := parseJinjaTemplate(string(content))
+			if err != nil {
+				return nil, errors.New(templateName + ": " + err.Error())
+			}
+			rendered[gateway], err = renderJinjaTemplate(nodes, scope)
+			if err != nil {
+				return nil, errors.New(templateName + ": " + err.Error())
+			}
+		} else {
+			rendered[gateway], err = renderGoTemplate(templateName, string(content), scope)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rendered, nil
+}
+
+// validateOperationPolicySpec validates a policy specification against the operation policy schema
+func validateOperationPolicySpec(spec operationPolicyDefinition) []string {
+	var issues []string
+	if spec.Name == "" {
+		issues = append(issues, "name of the policy is not defined")
+	}
+	if spec.Version == "" {
+		issues = append(issues, "version of the policy is not defined")
+	}
+	if len(spec.ApplicableFlows) == 0 {
+		issues = append(issues, "applicableFlows of the policy is not defined")
+	}
+	for _, flow := range spec.ApplicableFlows {
+		if !containsString(policyApplicableFlows, flow) {
+			issues = append(issues, fmt.Sprintf("unknown applicable flow %s. Supported flows: %s", flow,
+				strings.Join(policyApplicableFlows, ", ")))
+		}
+	}
+	if len(spec.SupportedGateways) == 0 {
+		issues = append(issues, "supportedGateways of the policy is not defined")
+	}
+	for _, gateway := range spec.SupportedGateways {
+		if _, ok := policyTemplateExtensions[gateway]; !ok {
+			issues = append(issues, fmt.Sprintf("unknown supported gateway %s. Supported gateways: %s, %s", gateway,
+				PolicyGatewaySynapse, PolicyGatewayChoreoConnect))
+		}
+	}
+	names := make(map[string]bool)
+	for i, attribute := range spec.PolicyAttributes {
+		if !policyAttributeNameRegex.MatchString(attribute.Name) {
+			issues = append(issues, fmt.Sprintf("name '%s' of policy attribute %d is not a valid identifier",
+				attribute.Name, i+1))
+			continue
+		}
+		if names[attribute.Name] {
+			issues = append(issues, "policy attribute "+attribute.Name+" is defined more than once")
+		}
+		names[attribute.Name] = true
+		if !containsString(policyAttributeTypes, attribute.Type) {
+			issues = append(issues, fmt.Sprintf("unknown type %s of policy attribute %s. Supported types: %s",
+				attribute.Type, attribute.Name, strings.Join(policyAttributeTypes, ", ")))
+			continue
+		}
+		if attribute.Type == "Enum" && len(attribute.AllowedValues) == 0 {
+			issues = append(issues, "allowedValues of the Enum policy attribute "+attribute.Name+" is not defined")
+		}
+		if attribute.ValidationRegex != "" {
+			if _, err := regexp.Compile(attribute.ValidationRegex); err != nil {
+				issues = append(issues, fmt.Sprintf("validationRegex of policy attribute %s is not valid: %s",
+					attribute.Name, err.Error()))
+				continue
+			}
+		}
+		if attribute.DefaultValue != nil {
+			if err := checkOperationPolicyParam(attribute, attribute.DefaultValue); err != nil {
+				issues = append(issues, "defaultValue of policy attribute "+attribute.Name+" is not valid: "+
+					err.Error())
+			}
+		}
+	}
+	return issues
+}
+
+// resolveOperationPolicyParams checks the given parameter values against the policy attributes and fills the
+// default values
+func resolveOperationPolicyParams(spec operationPolicyDefinition, values map[string]interface{}) (
+	map[string]interface{}, error) {
+	resolved := make(map[string]interface{})
+	declared := make(map[string]bool)
+	for _, attribute := range spec.PolicyAttributes {
+		declared[attribute.Name] = true
+		value, ok := values[attribute.Name]
+		if !ok || value == nil {
+			if attribute.DefaultValue != nil {
+				resolved[attribute.Name] = attribute.DefaultValue
+			} else if attribute.Required {
+				return nil, errors.New("value of the required parameter " + attribute.Name + " is not given")
+			}
+			continue
+		}
+		if err := checkOperationPolicyParam(attribute, value); err != nil {
+			return nil, errors.New("value of the parameter " + attribute.Name + " is not valid: " + err.Error())
+		}
+		resolved[attribute.Name] = value
+	}
+	var undeclared []string
+	for name := range values {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) > 0 {
+		sort.Strings(undeclared)
+		return nil, errors.New("parameters not declared in the policy: " + strings.Join(undeclared, ", "))
+	}
+	return resolved, nil
+}
+
+// checkOperationPolicyParam checks whether a value matches the type and the validation regex of a policy attribute
+func checkOperationPolicyParam(attribute operationPolicyAttribute, value interface{}) error {
+	switch attribute.Type {
+	case "Integer", "Long":
+		number, ok := toFloat(value)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("%v is not an integer", value)
+		}
+	case "Double":
+		if _, ok := toFloat(value); !ok {
+			return fmt.Errorf("%v is not a number", value)
+		}
+	case "Boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v is not a boolean", value)
+		}
+	case "Enum":
+		if !containsString(attribute.AllowedValues, fmt.Sprint(value)) {
+			return fmt.Errorf("%v is not one of %s", value, strings.Join(attribute.AllowedValues, ", "))
+		}
+	case "Map":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("%v is not a map", value)
+		}
+	}
+	if attribute.ValidationRegex != "" {
+		regex, err := regexp.Compile(attribute.ValidationRegex)
+		if err != nil {
+			return err
+		}
+		if text := jinjaString(value); !regex.MatchString(text) {
+			return fmt.Errorf("%s does not match %s", text, attribute.ValidationRegex)
+		}
+	}
+	return nil
+}
+
+// policyTemplateVariables returns the names of the variables a policy template refers to
+func policyTemplateVariables(path, extension string) ([]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if extension == ".j2" {
+		nodes, err := parseJinjaTemplate(string(content))
+		if err != nil {
+			return nil, err
+		}
+		return jinjaTemplateVariables(nodes), nil
+	}
+	return goTemplateVariables(filepath.Base(path), string(content))
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case json.Number:
+		number, err := v.Float64()
+		return number, err == nil
+	}
+	return 0, false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
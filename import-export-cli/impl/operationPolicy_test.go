@@ -0,0 +1,154 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestInitOperationPolicyIsValidAndRenders(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "AddHeader")
+	err := InitOperationPolicy(dir, []string{PolicyGatewaySynapse, PolicyGatewayChoreoConnect},
+		[]string{"request", "response"}, false)
+	assert.Nil(t, err)
+	assert.NotNil(t, InitOperationPolicy(dir, []string{PolicyGatewaySynapse}, []string{"request"}, false))
+
+	issues, err := ValidateOperationPolicy(dir)
+	assert.Nil(t, err)
+	assert.Empty(t, issues)
+
+	rendered, err := RenderOperationPolicy(dir, filepath.Join(dir, utils.PolicySampleParamsFile), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "<property action=\"set\" name=\"X-Sample-Header\" value=\"sample\" scope=\"transport\"/>\n",
+		rendered[PolicyGatewaySynapse])
+	assert.Contains(t, rendered[PolicyGatewayChoreoConnect], "headerName: X-Sample-Header")
+}
+
+func TestValidateOperationPolicyReportsIssues(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Broken")
+	writeTestFile(t, filepath.Join(dir, "Broken.yaml"), `type: operation_policy_specification
+version: v4.5.0
+data:
+  name: Broken
+  version: v1
+  applicableFlows: [request, outbound]
+  supportedGateways: [Synapse, ChoreoConnect, Envoy]
+  policyAttributes:
+    - name: level
+      type: Enum
+      allowedValues: [INFO, DEBUG]
+      defaultValue: TRACE
+    - name: count
+      type: Number
+`)
+	writeTestFile(t, filepath.Join(dir, "Broken.j2"), "{% if level %}<log level=\"{{ level }}\">{{ message }}</log>{% endif %}")
+	writeTestFile(t, filepath.Join(dir, "other.j2"), "")
+
+	issues, err := ValidateOperationPolicy(dir)
+	assert.Nil(t, err)
+	assert.Contains(t, issues, "unknown applicable flow outbound. Supported flows: request, response, fault")
+	assert.Contains(t, issues, "unknown supported gateway Envoy. Supported gateways: Synapse, ChoreoConnect")
+	assert.Contains(t, issues, "defaultValue of policy attribute level is not valid: TRACE is not one of INFO, DEBUG")
+	assert.Contains(t, issues, "unknown type Number of policy attribute count. Supported types: String, Integer, "+
+		"Long, Double, Boolean, Enum, Map")
+	assert.Contains(t, issues, "other.j2 should be named after the policy directory Broken")
+	assert.Contains(t, issues, "template Broken.j2 refers to the undeclared parameter message")
+	assert.Contains(t, issues, "template Broken.gotmpl of the supported gateway ChoreoConnect is not found")
+}
+
+func TestJinjaTemplate(t *testing.T) {
+	nodes, err := parseJinjaTemplate(`{# headers #}{% for header in headers -%}
+<header name="{{ header.name }}" value="{{ header.value | default('none') | upper }}"/>
+{% endfor %}{% if mode == 'strict' and not lenient %}strict{% elif mode is defined %}{{ mode }}{% else %}off{% endif %}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"headers", "lenient", "mode"}, jinjaTemplateVariables(nodes))
+
+	scope := map[string]interface{}{
+		"headers": []interface{}{map[string]interface{}{"name": "a", "value": "x"}, map[string]interface{}{"name": "b"}},
+		"mode":    "strict",
+		"lenient": false,
+	}
+	rendered, err := renderJinjaTemplate(nodes, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "<header name=\"a\" value=\"X\"/>\n<header name=\"b\" value=\"NONE\"/>\nstrict", rendered)
+
+	scope["headers"] = []interface{}{}
+	scope["mode"] = "relaxed"
+	rendered, err = renderJinjaTemplate(nodes, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "relaxed", rendered)
+
+	delete(scope, "mode")
+	rendered, err = renderJinjaTemplate(nodes, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "off", rendered)
+
+	_, err = parseJinjaTemplate("{% macro x() %}{% endmacro %}")
+	assert.NotNil(t, err)
+	_, err = parseJinjaTemplate("{% if a %}")
+	assert.NotNil(t, err)
+}
+
+func TestJinjaTemplateAssignmentsAndRawBlocks(t *testing.T) {
+	nodes, err := parseJinjaTemplate(`{% set prefix = header_prefix | default('X-') %}{% for name in names -%}
+{{ loop.index }}:{{ prefix }}{{ name }}{% if not loop.last %},{% endif %}
+{%- endfor %} {% raw %}{{ not_a_variable }}{% endraw %}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"header_prefix", "names"}, jinjaTemplateVariables(nodes))
+
+	scope := map[string]interface{}{"names": []interface{}{"a", "b"}}
+	rendered, err := renderJinjaTemplate(nodes, scope)
+	assert.Nil(t, err)
+	assert.Equal(t, "1:X-a,2:X-b {{ not_a_variable }}", rendered)
+	assert.NotContains(t, scope, "prefix")
+
+	_, err = parseJinjaTemplate("{% raw %}{{ a }}")
+	assert.NotNil(t, err)
+}
+
+func TestGoTemplateVariables(t *testing.T) {
+	variables, err := goTemplateVariables("policy.gotmpl",
+		"{{ .name }}{{ range .items }}{{ .value }}{{ $.prefix }}{{ end }}{{ if .enabled }}{{ upper .mode }}{{ end }}")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"enabled", "items", "mode", "name", "prefix"}, variables)
+}
+
+func TestResolveOperationPolicyParams(t *testing.T) {
+	spec := operationPolicyDefinition{PolicyAttributes: []operationPolicyAttribute{
+		{Name: "port", Type: "Integer", Required: true},
+		{Name: "enabled", Type: "Boolean", DefaultValue: true},
+		{Name: "name", Type: "String", ValidationRegex: "^[a-z]+$"},
+	}}
+	resolved, err := resolveOperationPolicyParams(spec, map[string]interface{}{"port": float64(8080)})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"port": float64(8080), "enabled": true}, resolved)
+
+	_, err = resolveOperationPolicyParams(spec, map[string]interface{}{})
+	assert.NotNil(t, err)
+	_, err = resolveOperationPolicyParams(spec, map[string]interface{}{"port": 1.5})
+	assert.NotNil(t, err)
+	_, err = resolveOperationPolicyParams(spec, map[string]interface{}{"port": float64(1), "name": "ABC"})
+	assert.NotNil(t, err)
+	_, err = resolveOperationPolicyParams(spec, map[string]interface{}{"port": float64(1), "other": "x"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,701 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// The synapse templates of operation policies are Jinja2 templates which are rendered by the server. apictl supports
+// the subset of Jinja2 used by policy templates to validate and render them locally: variable output with filters,
+// if/elif/else conditions, for loops, variable assignments, raw blocks and comments.
+
+type jinjaNode interface{}
+
+type jinjaTextNode struct {
+	text string
+}
+
+type jinjaOutputNode struct {
+	expr string
+}
+
+type jinjaIfBranch struct {
+	condition string
+	body      []jinjaNode
+}
+
+type jinjaIfNode struct {
+	branches []jinjaIfBranch
+	elseBody []jinjaNode
+}
+
+type jinjaForNode struct {
+	variable string
+	iterable string
+	body     []jinjaNode
+}
+
+type jinjaSetNode struct {
+	variable string
+	expr     string
+}
+
+type jinjaToken struct {
+	kind  string
+	value string
+	line  int
+}
+
+const (
+	jinjaTokenText    = "text"
+	jinjaTokenOutput  = "output"
+	jinjaTokenTag     = "tag"
+	jinjaTokenComment = "comment"
+)
+
+var jinjaForTagRegex = regexp.MustCompile(`^for\s+([A-Za-z_]\w*)\s+in\s+(.+)$`)
+var jinjaSetTagRegex = regexp.MustCompile(`^set\s+([A-Za-z_]\w*)\s*=\s*(.+)$`)
+var jinjaEndRawTagRegex = regexp.MustCompile(`\{%(-?)\s*endraw\s*(-?)%\}`)
+var jinjaPathRegex = regexp.MustCompile(`^[A-Za-z_]\w*(\.[A-Za-z_]\w*)*$`)
+var jinjaIdentifierRegex = regexp.MustCompile(`(\|\s*)?([A-Za-z_]\w*)(\.[A-Za-z_]\w*)*`)
+var jinjaDefinedTestRegex = regexp.MustCompile(`^(.+?)\s+is\s+(not\s+)?defined$`)
+var jinjaStringLiteralRegex = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+
+var jinjaKeywords = map[string]bool{"and": true, "or": true, "not": true, "is": true, "defined": true, "in": true,
+	"true": true, "false": true, "none": true, "True": true, "False": true, "None": true}
+
+// jinjaGlobals are the global functions of Jinja2, which are not variables of a template
+var jinjaGlobals = map[string]bool{"range": true, "dict": true, "lipsum": true, "cycler": true, "joiner": true,
+	"namespace": true}
+
+// parseJinjaTemplate parses the supported subset of Jinja2
+func parseJinjaTemplate(content string) ([]jinjaNode, error) {
+	tokens, err := tokenizeJinjaTemplate(content)
+	if err != nil {
+		return nil, err
+	}
+	nodes, pos, end, err := parseJinjaBody(tokens, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if end != "" {
+		return nil, fmt.Errorf("line %d: unexpected {%% %s %%}", tokens[pos-1].line, end)
+	}
+	return nodes, nil
+}
+
+func tokenizeJinjaTemplate(content string) ([]jinjaToken, error) {
+	var tokens []jinjaToken
+	line := 1
+	trimNextText := false
+	for len(content) > 0 {
+		start := -1
+		var closing, kind string
+		for i := 0; i+1 < len(content); i++ {
+			if content[i] != '{' {
+				continue
+			}
+			switch content[i+1] {
+			case '{':
+				closing, kind = "}}", jinjaTokenOutput
+			case '%':
+				closing, kind = "%}", jinjaTokenTag
+			case '#':
+				closing, kind = "#}", jinjaTokenComment
+			default:
+				continue
+			}
+			start = i
+			break
+		}
+		text := content
+		if start >= 0 {
+			text = content[:start]
+		}
+		if trimNextText {
+			text = strings.TrimLeft(text, " \t\r\n")
+			trimNextText = false
+		}
+		if start < 0 {
+			tokens = append(tokens, jinjaToken{kind: jinjaTokenText, value: text, line: line})
+			break
+		}
+		end := strings.Index(content[start+2:], closing)
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: %s is not closed", line+strings.Count(content[:start], "\n"),
+				content[start:start+2])
+		}
+		value := content[start+2 : start+2+end]
+		if strings.HasPrefix(value, "-") {
+			text = strings.TrimRight(text, " \t\r\n")
+			value = value[1:]
+		}
+		if strings.HasSuffix(value, "-") {
+			trimNextText = true
+			value = value[:len(value)-1]
+		}
+		if text != "" {
+			tokens = append(tokens, jinjaToken{kind: jinjaTokenText, value: text, line: line})
+		}
+		line += strings.Count(content[:start], "\n")
+		if kind == jinjaTokenTag && strings.TrimSpace(value) == "raw" {
+			// The content of a raw block is output as it is until the endraw tag
+			line += strings.Count(content[start:start+2+end+2], "\n")
+			content = content[start+2+end+2:]
+			endRaw := jinjaEndRawTagRegex.FindStringSubmatchIndex(content)
+			if endRaw == nil {
+				return nil, fmt.Errorf("line %d: {%% raw %%} is not closed", line)
+			}
+			raw := content[:endRaw[0]]
+			if trimNextText {
+				raw = strings.TrimLeft(raw, " \t\r\n")
+			}
+			if endRaw[3] > endRaw[2] {
+				raw = strings.TrimRight(raw, " \t\r\n")
+			}
+			trimNextText = endRaw[5] > endRaw[4]
+			if raw != "" {
+				tokens = append(tokens, jinjaToken{kind: jinjaTokenText, value: raw, line: line})
+			}
+			line += strings.Count(content[:endRaw[1]], "\n")
+			content = content[endRaw[1]:]
+			continue
+		}
+		tokens = append(tokens, jinjaToken{kind: kind, value: strings.TrimSpace(value), line: line})
+		line += strings.Count(content[start:start+2+end+2], "\n")
+		content = content[start+2+end+2:]
+	}
+	return tokens, nil
+}
+
+// parseJinjaBody parses tokens until one of the terminating tags is found. Returns the nodes, the position after the
+// terminating tag and the terminating tag.
+func parseJinjaBody(tokens []jinjaToken, pos int, terminators []string) ([]jinjaNode, int, string, error) {
+	var nodes []jinjaNode
+	for pos < len(tokens) {
+		token := tokens[pos]
+		pos++
+		switch token.kind {
+		case jinjaTokenText:
+			nodes = append(nodes, jinjaTextNode{text: token.value})
+		case jinjaTokenOutput:
+			nodes = append(nodes, jinjaOutputNode{expr: token.value})
+		case jinjaTokenTag:
+			keyword := strings.Fields(token.value + " ")[0]
+			for _, terminator := range terminators {
+				if keyword == terminator {
+					return nodes, pos, token.value, nil
+				}
+			}
+			switch keyword {
+			case "if":
+				node, next, err := parseJinjaIf(tokens, pos, strings.TrimSpace(token.value[2:]))
+				if err != nil {
+					return nil, pos, "", err
+				}
+				nodes = append(nodes, node)
+				pos = next
+			case "for":
+				matches := jinjaForTagRegex.FindStringSubmatch(token.value)
+				if matches == nil {
+					return nil, pos, "", fmt.Errorf("line %d: unsupported for loop {%% %s %%}", token.line, token.value)
+				}
+				body, next, end, err := parseJinjaBody(tokens, pos, []string{"endfor"})
+				if err != nil {
+					return nil, pos, "", err
+				}
+				if end == "" {
+					return nil, pos, "", fmt.Errorf("line %d: {%% %s %%} is not closed", token.line, token.value)
+				}
+				nodes = append(nodes, jinjaForNode{variable: matches[1], iterable: matches[2], body: body})
+				pos = next
+			case "set":
+				matches := jinjaSetTagRegex.FindStringSubmatch(token.value)
+				if matches == nil {
+					return nil, pos, "", fmt.Errorf("line %d: unsupported assignment {%% %s %%}", token.line,
+						token.value)
+				}
+				nodes = append(nodes, jinjaSetNode{variable: matches[1], expr: strings.TrimSpace(matches[2])})
+			default:
+				return nil, pos, "", fmt.Errorf("line %d: unsupported tag {%% %s %%}", token.line, token.value)
+			}
+		}
+	}
+	return nodes, pos, "", nil
+}
+
+func parseJinjaIf(tokens []jinjaToken, pos int, condition string) (jinjaIfNode, int, error) {
+	node := jinjaIfNode{}
+	line := tokens[pos-1].line
+	for {
+		body, next, end, err := parseJinjaBody(tokens, pos, []string{"elif", "else", "endif"})
+		if err != nil {
+			return node, next, err
+		}
+		node.branches = append(node.branches, jinjaIfBranch{condition: condition, body: body})
+		pos = next
+		switch {
+		case end == "":
+			return node, pos, fmt.Errorf("line %d: {%% if %%} is not closed", line)
+		case end == "endif":
+			return node, pos, nil
+		case end == "else":
+			elseBody, next, end, err := parseJinjaBody(tokens, pos, []string{"endif"})
+			if err != nil {
+				return node, next, err
+			}
+			if end == "" {
+				return node, next, fmt.Errorf("line %d: {%% if %%} is not closed", line)
+			}
+			node.elseBody = elseBody
+			return node, next, nil
+		default:
+			condition = strings.TrimSpace(strings.TrimPrefix(end, "elif"))
+		}
+	}
+}
+
+// renderJinjaTemplate renders a parsed template with the given variables
+func renderJinjaTemplate(nodes []jinjaNode, scope map[string]interface{}) (string, error) {
+	var out strings.Builder
+	// The variables assigned by the template are kept in a copy of the given variables
+	templateScope := make(map[string]interface{}, len(scope))
+	for key, value := range scope {
+		templateScope[key] = value
+	}
+	if err := renderJinjaNodes(&out, nodes, templateScope); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func renderJinjaNodes(out *strings.Builder, nodes []jinjaNode, scope map[string]interface{}) error {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case jinjaTextNode:
+			out.WriteString(n.text)
+		case jinjaOutputNode:
+			value, defined, err := evaluateJinjaExpression(n.expr, scope)
+			if err != nil {
+				return err
+			}
+			if defined {
+				out.WriteString(jinjaString(value))
+			}
+		case jinjaIfNode:
+			rendered := false
+			for _, branch := range n.branches {
+				matched, err := evaluateJinjaCondition(branch.condition, scope)
+				if err != nil {
+					return err
+				}
+				if matched {
+					if err = renderJinjaNodes(out, branch.body, scope); err != nil {
+						return err
+					}
+					rendered = true
+					break
+				}
+			}
+			if !rendered {
+				if err := renderJinjaNodes(out, n.elseBody, scope); err != nil {
+					return err
+				}
+			}
+		case jinjaSetNode:
+			value, defined, err := evaluateJinjaExpression(n.expr, scope)
+			if err != nil {
+				return err
+			}
+			if defined {
+				scope[n.variable] = value
+			} else {
+				delete(scope, n.variable)
+			}
+		case jinjaForNode:
+			value, _, err := evaluateJinjaExpression(n.iterable, scope)
+			if err != nil {
+				return err
+			}
+			var items []interface{}
+			switch iterable := value.(type) {
+			case []interface{}:
+				items = iterable
+			case map[string]interface{}:
+				for key := range iterable {
+					items = append(items, key)
+				}
+				sort.Slice(items, func(i, j int) bool { return items[i].(string) < items[j].(string) })
+			case nil:
+			default:
+				return fmt.Errorf("%s is not iterable", n.iterable)
+			}
+			for i, item := range items {
+				loopScope := make(map[string]interface{}, len(scope)+2)
+				for key, value := range scope {
+					loopScope[key] = value
+				}
+				loopScope[n.variable] = item
+				loopScope["loop"] = map[string]interface{}{
+					"index":     float64(i + 1),
+					"index0":    float64(i),
+					"revindex":  float64(len(items) - i),
+					"revindex0": float64(len(items) - i - 1),
+					"first":     i == 0,
+					"last":      i == len(items)-1,
+					"length":    float64(len(items)),
+				}
+				if err := renderJinjaNodes(out, n.body, loopScope); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// evaluateJinjaExpression evaluates an operand followed by filters. Returns whether the value is defined.
+func evaluateJinjaExpression(expr string, scope map[string]interface{}) (interface{}, bool, error) {
+	parts := splitOutsideQuotes(expr, "|")
+	value, defined, err := evaluateJinjaOperand(strings.TrimSpace(parts[0]), scope)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, filter := range parts[1:] {
+		filter = strings.TrimSpace(filter)
+		name, argument := filter, ""
+		if open := strings.Index(filter, "("); open > 0 && strings.HasSuffix(filter, ")") {
+			name, argument = strings.TrimSpace(filter[:open]), strings.TrimSpace(filter[open+1:len(filter)-1])
+		}
+		switch name {
+		case "default", "d":
+			if !defined {
+				if value, _, err = evaluateJinjaOperand(argument, scope); err != nil {
+					return nil, false, err
+				}
+				defined = true
+			}
+		case "lower":
+			value = strings.ToLower(jinjaString(value))
+		case "upper":
+			value = strings.ToUpper(jinjaString(value))
+		case "trim":
+			value = strings.TrimSpace(jinjaString(value))
+		case "string":
+			value = jinjaString(value)
+		case "length":
+			switch v := value.(type) {
+			case []interface{}:
+				value = float64(len(v))
+			case map[string]interface{}:
+				value = float64(len(v))
+			default:
+				value = float64(len(jinjaString(v)))
+			}
+		case "tojson":
+			content, err := json.Marshal(value)
+			if err != nil {
+				return nil, false, err
+			}
+			value = string(content)
+		default:
+			return nil, false, errors.New("unsupported filter " + name + " in {{ " + expr + " }}")
+		}
+	}
+	return value, defined, nil
+}
+
+func evaluateJinjaOperand(operand string, scope map[string]interface{}) (interface{}, bool, error) {
+	if len(operand) >= 2 && (operand[0] == '\'' || operand[0] == '"') && operand[len(operand)-1] == operand[0] {
+		return operand[1 : len(operand)-1], true, nil
+	}
+	switch operand {
+	case "true", "True":
+		return true, true, nil
+	case "false", "False":
+		return false, true, nil
+	case "none", "None":
+		return nil, true, nil
+	}
+	if number, err := strconv.ParseFloat(operand, 64); err == nil {
+		return number, true, nil
+	}
+	if !jinjaPathRegex.MatchString(operand) {
+		return nil, false, errors.New("unsupported expression " + operand)
+	}
+	var value interface{} = scope
+	for _, key := range strings.Split(operand, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false, nil
+		}
+		if value, ok = object[key]; !ok {
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// evaluateJinjaCondition evaluates conditions made of and, or, not, ==, != and is (not) defined
+func evaluateJinjaCondition(condition string, scope map[string]interface{}) (bool, error) {
+	for _, alternative := range splitOutsideQuotes(condition, " or ") {
+		matched := true
+		for _, term := range splitOutsideQuotes(alternative, " and ") {
+			result, err := evaluateJinjaTerm(strings.TrimSpace(term), scope)
+			if err != nil {
+				return false, err
+			}
+			if !result {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func evaluateJinjaTerm(term string, scope map[string]interface{}) (bool, error) {
+	if strings.HasPrefix(term, "not ") {
+		result, err := evaluateJinjaTerm(strings.TrimSpace(term[4:]), scope)
+		return !result, err
+	}
+	if matches := jinjaDefinedTestRegex.FindStringSubmatch(term); matches != nil {
+		_, defined, err := evaluateJinjaExpression(matches[1], scope)
+		return defined != (matches[2] != ""), err
+	}
+	for _, operator := range []string{"==", "!="} {
+		sides := splitOutsideQuotes(term, operator)
+		if len(sides) != 2 {
+			continue
+		}
+		left, _, err := evaluateJinjaExpression(strings.TrimSpace(sides[0]), scope)
+		if err != nil {
+			return false, err
+		}
+		right, _, err := evaluateJinjaExpression(strings.TrimSpace(sides[1]), scope)
+		if err != nil {
+			return false, err
+		}
+		return (jinjaString(left) == jinjaString(right)) == (operator == "=="), nil
+	}
+	value, defined, err := evaluateJinjaExpression(term, scope)
+	if err != nil || !defined {
+		return false, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		return v != "", nil
+	case float64:
+		return v != 0, nil
+	case []interface{}:
+		return len(v) > 0, nil
+	case map[string]interface{}:
+		return len(v) > 0, nil
+	}
+	return true, nil
+}
+
+// jinjaString converts a value to a string the way Jinja2 does
+func jinjaString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "None"
+	case string:
+		return v
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
+
+// jinjaTemplateVariables returns the names of the variables a parsed template refers to
+func jinjaTemplateVariables(nodes []jinjaNode) []string {
+	variables := make(map[string]bool)
+	collectJinjaVariables(nodes, map[string]bool{}, variables)
+	return sortedKeys(variables)
+}
+
+func collectJinjaVariables(nodes []jinjaNode, locals, variables map[string]bool) {
+	addExpression := func(expr string) {
+		expr = jinjaStringLiteralRegex.ReplaceAllString(expr, "''")
+		for _, match := range jinjaIdentifierRegex.FindAllStringSubmatch(expr, -1) {
+			if match[1] != "" || jinjaKeywords[match[2]] || jinjaGlobals[match[2]] || locals[match[2]] {
+				continue
+			}
+			variables[match[2]] = true
+		}
+	}
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case jinjaOutputNode:
+			addExpression(n.expr)
+		case jinjaIfNode:
+			for _, branch := range n.branches {
+				addExpression(branch.condition)
+				collectJinjaVariables(branch.body, locals, variables)
+			}
+			collectJinjaVariables(n.elseBody, locals, variables)
+		case jinjaSetNode:
+			addExpression(n.expr)
+			// An assigned variable is local to the rest of the block, or the template at the top level
+			locals[n.variable] = true
+		case jinjaForNode:
+			addExpression(n.iterable)
+			loopLocals := map[string]bool{n.variable: true, "loop": true}
+			for local := range locals {
+				loopLocals[local] = true
+			}
+			collectJinjaVariables(n.body, loopLocals, variables)
+		}
+	}
+}
+
+// splitOutsideQuotes splits a string by a separator which is not inside a quoted string
+func splitOutsideQuotes(value, separator string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch {
+		case quote != 0:
+			if value[i] == quote {
+				quote = 0
+			}
+		case value[i] == '\'' || value[i] == '"':
+			quote = value[i]
+		case strings.HasPrefix(value[i:], separator):
+			parts = append(parts, value[start:i])
+			start = i + len(separator)
+			i += len(separator) - 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// goTemplateVariables returns the names of the top level fields a Go template refers to
+func goTemplateVariables(name, content string) ([]string, error) {
+	tree := parse.New(name)
+	tree.Mode = parse.SkipFuncCheck
+	treeSet := make(map[string]*parse.Tree)
+	if _, err := tree.Parse(content, "", "", treeSet); err != nil {
+		return nil, err
+	}
+	variables := make(map[string]bool)
+	for _, t := range treeSet {
+		collectGoTemplateVariables(t.Root, true, variables)
+	}
+	return sortedKeys(variables), nil
+}
+
+func collectGoTemplateVariables(node parse.Node, rootDot bool, variables map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectGoTemplateVariables(child, rootDot, variables)
+		}
+	case *parse.ActionNode:
+		collectGoTemplateVariables(n.Pipe, rootDot, variables)
+	case *parse.IfNode:
+		collectGoTemplateVariables(n.Pipe, rootDot, variables)
+		collectGoTemplateVariables(n.List, rootDot, variables)
+		collectGoTemplateVariables(n.ElseList, rootDot, variables)
+	case *parse.RangeNode:
+		collectGoTemplateVariables(n.Pipe, rootDot, variables)
+		collectGoTemplateVariables(n.List, false, variables)
+		collectGoTemplateVariables(n.ElseList, rootDot, variables)
+	case *parse.WithNode:
+		collectGoTemplateVariables(n.Pipe, rootDot, variables)
+		collectGoTemplateVariables(n.List, false, variables)
+		collectGoTemplateVariables(n.ElseList, rootDot, variables)
+	case *parse.TemplateNode:
+		collectGoTemplateVariables(n.Pipe, rootDot, variables)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, command := range n.Cmds {
+			collectGoTemplateVariables(command, rootDot, variables)
+		}
+	case *parse.CommandNode:
+		for _, argument := range n.Args {
+			collectGoTemplateVariables(argument, rootDot, variables)
+		}
+	case *parse.ChainNode:
+		collectGoTemplateVariables(n.Node, rootDot, variables)
+	case *parse.FieldNode:
+		if rootDot {
+			variables[n.Ident[0]] = true
+		}
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			variables[n.Ident[1]] = true
+		}
+	}
+}
+
+// renderGoTemplate renders a Go template with the given variables
+func renderGoTemplate(name, content string, scope map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err = tmpl.Execute(&out, scope); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,487 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// RecycleBinCommandDelete is the command of the entries backed up before deleting an artifact
+	RecycleBinCommandDelete = "delete"
+	// RecycleBinCommandUndeploy is the command of the entries backed up before undeploying a revision
+	RecycleBinCommandUndeploy = "undeploy"
+)
+
+const (
+	recycleBinIdHeader          = "ID"
+	recycleBinCommandHeader     = "COMMAND"
+	recycleBinTypeHeader        = "TYPE"
+	recycleBinNameHeader        = "NAME"
+	recycleBinVersionHeader     = "VERSION"
+	recycleBinEnvironmentHeader = "ENVIRONMENT"
+	recycleBinCreatedHeader     = "CREATED"
+
+	defaultRecycleBinTableFormat = "table {{.Id}}\t{{.Command}}\t{{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Environment}}\t{{.Created}}"
+
+	recycleBinFilePermission = 0600
+	recycleBinDirPermission  = 0700
+)
+
+// RecycleBinArtifact identifies the artifact affected by a destructive command
+type RecycleBinArtifact struct {
+	Type       string
+	Name       string
+	Version    string
+	Owner      string   // provider of an API or API Product, owner of an application
+	PolicyType string   // type of a throttling policy
+	Revision   string   // revision undeployed from the gateways
+	Gateways   []string // gateways the revision is undeployed from, all of them if empty
+}
+
+// RecycleBinEntry is an artifact backed up to the recycle bin before a destructive command
+type RecycleBinEntry struct {
+	ID          int                `yaml:"id"`
+	Command     string             `yaml:"command"`
+	Type        string             `yaml:"type"`
+	Name        string             `yaml:"name"`
+	Version     string             `yaml:"version,omitempty"`
+	Owner       string             `yaml:"owner,omitempty"`
+	PolicyType  string             `yaml:"policyType,omitempty"`
+	Revision    string             `yaml:"revision,omitempty"`
+	Environment string             `yaml:"environment"`
+	File        string             `yaml:"file,omitempty"`
+	Deployments []utils.Deployment `yaml:"deployments,omitempty"`
+	CreatedTime string             `yaml:"createdTime"`
+	Size        int64              `yaml:"size"`
+}
+
+// recycleBinEntry holds a recycle bin entry for outputting
+type recycleBinEntry struct {
+	entry RecycleBinEntry
+}
+
+func (e recycleBinEntry) Id() string {
+	return strconv.Itoa(e.entry.ID)
+}
+
+func (e recycleBinEntry) Command() string {
+	return e.entry.Command
+}
+
+func (e recycleBinEntry) Type() string {
+	return e.entry.Type
+}
+
+func (e recycleBinEntry) Name() string {
+	return e.entry.Name
+}
+
+func (e recycleBinEntry) Version() string {
+	if e.entry.Revision != "" {
+		return e.entry.Version + " (revision " + e.entry.Revision + ")"
+	}
+	return e.entry.Version
+}
+
+func (e recycleBinEntry) Owner() string {
+	return e.entry.Owner
+}
+
+func (e recycleBinEntry) Environment() string {
+	return e.entry.Environment
+}
+
+func (e recycleBinEntry) Created() string {
+	return e.entry.CreatedTime
+}
+
+func (e recycleBinEntry) Size() int64 {
+	return e.entry.Size
+}
+
+func (e *recycleBinEntry) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(e)
+}
+
+// BackupToRecycleBin saves the artifact affected by a destructive command to the recycle bin. Deleted artifacts are
+// exported along with the gateways they are deployed to, and undeployed revisions are recorded with their gateways.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the artifact
+// @param command : Destructive command (delete or undeploy)
+// @param artifact : Artifact affected by the command
+// @return created entry, error
+func BackupToRecycleBin(accessToken, environment, command string, artifact RecycleBinArtifact) (*RecycleBinEntry,
+	error) {
+	entry := &RecycleBinEntry{
+		Command:     command,
+		Type:        artifact.Type,
+		Name:        artifact.Name,
+		Version:     artifact.Version,
+		Owner:       artifact.Owner,
+		PolicyType:  artifact.PolicyType,
+		Revision:    artifact.Revision,
+		Environment: environment,
+	}
+	var content []byte
+	var err error
+	if command == RecycleBinCommandUndeploy {
+		var revisions []utils.Revisions
+		if revisions, err = getDeployedRevisions(accessToken, environment, artifact); err != nil {
+			return nil, err
+		}
+		if entry.Deployments, err = getUndeployedGateways(revisions, artifact); err != nil {
+			return nil, err
+		}
+	} else {
+		if entry.File, content, err = exportRecycleBinArtifact(accessToken, environment, artifact); err != nil {
+			return nil, err
+		}
+		if artifact.Type == utils.ProjectTypeApi || artifact.Type == utils.ProjectTypeApiProduct {
+			var revisions []utils.Revisions
+			if revisions, err = getDeployedRevisions(accessToken, environment, artifact); err != nil {
+				return nil, err
+			}
+			entry.Deployments = getDeployedGateways(revisions)
+		}
+	}
+	if err = addRecycleBinEntry(utils.DefaultRecycleBinDirPath, entry, content, getRecycleBinMaxSize()); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// RestoreFromRecycleBin reverts the destructive command of a recycle bin entry and removes the entry. Deleted
+// artifacts are imported and deployed to the gateways they were deployed to, and undeployed revisions are deployed
+// back to their gateways.
+// @param accessToken : Access Token for the environment of the entry
+// @param entry : Recycle bin entry to be restored
+// @return error
+func RestoreFromRecycleBin(accessToken string, entry *RecycleBinEntry) error {
+	entryDir := filepath.Join(utils.DefaultRecycleBinDirPath, strconv.Itoa(entry.ID))
+	if err := restoreRecycleBinEntry(accessToken, entry, entryDir); err != nil {
+		return err
+	}
+	return removeRecycleBinEntry(utils.DefaultRecycleBinDirPath, strconv.Itoa(entry.ID))
+}
+
+// ListRecycleBinEntries returns the entries of the recycle bin, oldest first
+func ListRecycleBinEntries() ([]RecycleBinEntry, error) {
+	return listRecycleBinEntries(utils.DefaultRecycleBinDirPath)
+}
+
+// GetRecycleBinEntry returns the entry of the recycle bin with the given id
+func GetRecycleBinEntry(id string) (*RecycleBinEntry, error) {
+	return getRecycleBinEntry(utils.DefaultRecycleBinDirPath, id)
+}
+
+// PrintRecycleBinEntries prints the entries of the recycle bin
+// @param entries : Entries of the recycle bin
+// @param format : Go template to format the output, or a table if empty
+func PrintRecycleBinEntries(entries []RecycleBinEntry, format string) {
+	if format == "" {
+		format = defaultRecycleBinTableFormat
+	}
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, entry := range entries {
+			if err := t.Execute(w, &recycleBinEntry{entry}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Id":          recycleBinIdHeader,
+		"Command":     recycleBinCommandHeader,
+		"Type":        recycleBinTypeHeader,
+		"Name":        recycleBinNameHeader,
+		"Version":     recycleBinVersionHeader,
+		"Owner":       apiProviderHeader,
+		"Environment": recycleBinEnvironmentHeader,
+		"Created":     recycleBinCreatedHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// exportRecycleBinArtifact exports a deleted artifact and returns the name and the content of the exported file
+func exportRecycleBinArtifact(accessToken, environment string, artifact RecycleBinArtifact) (string, []byte, error) {
+	var fileName string
+	var resp *resty.Response
+	var err error
+	switch artifact.Type {
+	case utils.ProjectTypeApi:
+		fileName = artifact.Name + "_" + artifact.Version + ".zip"
+		resp, err = ExportAPIFromEnv(accessToken, artifact.Name, artifact.Version, "", artifact.Owner,
+			utils.DefaultExportFormat, environment, true, false)
+	case utils.ProjectTypeApiProduct:
+		fileName = artifact.Name + "_" + artifact.Version + ".zip"
+		resp, err = ExportAPIProductFromEnv(accessToken, artifact.Name, artifact.Version, "", artifact.Owner,
+			utils.DefaultExportFormat, environment, false, true)
+	case utils.ProjectTypeApplication:
+		fileName = artifact.Owner + "_" + artifact.Name + ".zip"
+		resp, err = ExportAppFromEnv(accessToken, artifact.Name, artifact.Owner, utils.DefaultExportFormat, environment,
+			true)
+	case utils.ProjectTypeAPIPolicy:
+		fileName = artifact.Name + "_" + artifact.Version + ".zip"
+		resp, err = ExportAPIPolicyFromEnv(accessToken, environment, artifact.Name, artifact.Version,
+			utils.DefaultExportFormat)
+	case utils.ProjectTypeThrottlingPolicy:
+		fileName = artifact.Name + ".json"
+		resp, err = ExportThrottlingPolicyFromEnv(accessToken, environment, artifact.Name, artifact.PolicyType, "JSON")
+	default:
+		return "", nil, errors.New("unknown artifact type " + artifact.Type)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", nil, getPublisherResponseError(resp, "exporting "+artifact.Type+" "+artifact.Name)
+	}
+	return fileName, resp.Body(), nil
+}
+
+// restoreRecycleBinEntry reverts the destructive command of an entry stored in the given directory
+func restoreRecycleBinEntry(accessToken string, entry *RecycleBinEntry, entryDir string) error {
+	revisionArtifact := RevisionArtifact{Type: RevisionArtifactTypeAPI, Name: entry.Name, Version: entry.Version,
+		Provider: entry.Owner}
+	if entry.Type == utils.ProjectTypeApiProduct {
+		revisionArtifact.Type = RevisionArtifactTypeAPIProduct
+	}
+	if entry.Command == RecycleBinCommandUndeploy {
+		return DeployRevision(accessToken, entry.Environment, revisionArtifact, entry.Revision, entry.Deployments)
+	}
+
+	path := filepath.Join(entryDir, entry.File)
+	var err error
+	switch entry.Type {
+	case utils.ProjectTypeApi:
+		err = ImportAPIToEnv(accessToken, entry.Environment, path, "", false, true, false, false, true, false, "",
+			nil)
+	case utils.ProjectTypeApiProduct:
+		err = ImportAPIProductToEnv(accessToken, entry.Environment, path, "", false, false, false, true, false, false,
+			true, nil)
+	case utils.ProjectTypeApplication:
+		_, err = ImportApplicationToEnv(accessToken, entry.Environment, path, entry.Owner, false, true, false, false,
+			false, nil, nil)
+	case utils.ProjectTypeAPIPolicy:
+		err = ImportAPIPolicyToEnv(accessToken, entry.Environment, path)
+	case utils.ProjectTypeThrottlingPolicy:
+		err = ImportThrottlingPolicyToEnv(accessToken, entry.Environment, path, false)
+	default:
+		return errors.New("unknown artifact type " + entry.Type)
+	}
+	if err != nil || len(entry.Deployments) == 0 {
+		return err
+	}
+
+	// The revisions are not part of the exported artifact, so a new revision is deployed to the gateways
+	revision, err := CreateRevision(accessToken, entry.Environment, revisionArtifact, "Restored from the recycle bin")
+	if err != nil {
+		return err
+	}
+	return DeployRevision(accessToken, entry.Environment, revisionArtifact,
+		utils.GetRevisionNumFromRevisionName(revision.RevisionNumber), entry.Deployments)
+}
+
+// getDeployedRevisions returns the deployed revisions of an API or API Product
+func getDeployedRevisions(accessToken, environment string, artifact RecycleBinArtifact) ([]utils.Revisions, error) {
+	revisionArtifact := RevisionArtifact{Type: RevisionArtifactTypeAPI, Name: artifact.Name,
+		Version: artifact.Version, Provider: artifact.Owner}
+	if artifact.Type == utils.ProjectTypeApiProduct {
+		revisionArtifact.Type = RevisionArtifactTypeAPIProduct
+	}
+	revisionsEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, revisionArtifact)
+	if err != nil {
+		return nil, err
+	}
+	_, revisions, err := GetRevisionsList(accessToken, revisionsEndpoint+"/revisions?query=deployed:true")
+	return revisions, err
+}
+
+// getDeployedGateways returns the gateways any of the revisions is deployed to
+func getDeployedGateways(revisions []utils.Revisions) []utils.Deployment {
+	var deployments []utils.Deployment
+	deployed := make(map[string]bool)
+	for _, revision := range revisions {
+		for _, deployment := range revision.Deployments {
+			if !deployed[deployment.Name] {
+				deployed[deployment.Name] = true
+				deployments = append(deployments, deployment)
+			}
+		}
+	}
+	return deployments
+}
+
+// getUndeployedGateways returns the gateways the revision of the artifact is undeployed from
+func getUndeployedGateways(revisions []utils.Revisions, artifact RecycleBinArtifact) ([]utils.Deployment, error) {
+	for _, revision := range revisions {
+		if strconv.Itoa(getRevisionNumber(revision)) != artifact.Revision {
+			continue
+		}
+		if len(artifact.Gateways) == 0 {
+			return revision.Deployments, nil
+		}
+		var deployments []utils.Deployment
+		for _, deployment := range revision.Deployments {
+			if containsString(artifact.Gateways, deployment.Name) {
+				deployments = append(deployments, deployment)
+			}
+		}
+		if len(deployments) == 0 {
+			return nil, errors.New("revision " + artifact.Revision + " is not deployed to the given gateways")
+		}
+		return deployments, nil
+	}
+	return nil, errors.New("revision " + artifact.Revision + " is not deployed")
+}
+
+// getRecycleBinMaxSize returns the maximum size of the recycle bin in bytes
+func getRecycleBinMaxSize() int64 {
+	maxSize := utils.DefaultRecycleBinMaxSize
+	if mainConfig := utils.GetMainConfigFromFileSilently(utils.MainConfigFilePath); mainConfig != nil &&
+		mainConfig.Config.RecycleBinMaxSize > 0 {
+		maxSize = mainConfig.Config.RecycleBinMaxSize
+	}
+	return int64(maxSize) * 1024 * 1024
+}
+
+// addRecycleBinEntry stores an entry with the next id and removes the oldest entries until the recycle bin fits
+// into maxSize bytes. The new entry is always kept.
+func addRecycleBinEntry(binDir string, entry *RecycleBinEntry, content []byte, maxSize int64) error {
+	entries, err := listRecycleBinEntries(binDir)
+	if err != nil {
+		return err
+	}
+	entry.ID = 1
+	if len(entries) > 0 {
+		entry.ID = entries[len(entries)-1].ID + 1
+	}
+	entry.Size = int64(len(content))
+	if entry.CreatedTime == "" {
+		entry.CreatedTime = time.Now().Format(time.RFC3339)
+	}
+
+	entryDir := filepath.Join(binDir, strconv.Itoa(entry.ID))
+	if err = os.MkdirAll(entryDir, recycleBinDirPermission); err != nil {
+		return err
+	}
+	if entry.File != "" {
+		if err = ioutil.WriteFile(filepath.Join(entryDir, entry.File), content, recycleBinFilePermission); err != nil {
+			return err
+		}
+	}
+	data, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(filepath.Join(entryDir, utils.RecycleBinEntryFileName), data,
+		recycleBinFilePermission); err != nil {
+		return err
+	}
+
+	size := entry.Size
+	for _, existing := range entries {
+		size += existing.Size
+	}
+	for _, existing := range entries {
+		if size <= maxSize {
+			break
+		}
+		utils.Logln(utils.LogPrefixInfo + "Removing entry " + strconv.Itoa(existing.ID) + " from the recycle bin")
+		if err = removeRecycleBinEntry(binDir, strconv.Itoa(existing.ID)); err != nil {
+			return err
+		}
+		size -= existing.Size
+	}
+	return nil
+}
+
+// listRecycleBinEntries returns the entries stored in the recycle bin directory sorted by their ids
+func listRecycleBinEntries(binDir string) ([]RecycleBinEntry, error) {
+	files, err := ioutil.ReadDir(binDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var entries []RecycleBinEntry
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(file.Name()); err != nil {
+			continue
+		}
+		entry, err := getRecycleBinEntry(binDir, file.Name())
+		if err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Skipping invalid recycle bin entry "+file.Name()+":", err)
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// getRecycleBinEntry reads the entry with the given id from the recycle bin directory
+func getRecycleBinEntry(binDir, id string) (*RecycleBinEntry, error) {
+	if _, err := strconv.Atoi(id); err != nil {
+		return nil, errors.New("invalid recycle bin entry id " + id)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(binDir, id, utils.RecycleBinEntryFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("recycle bin entry " + id + " not found")
+		}
+		return nil, err
+	}
+	entry := &RecycleBinEntry{}
+	if err = yaml.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// removeRecycleBinEntry removes the entry with the given id from the recycle bin directory
+func removeRecycleBinEntry(binDir, id string) error {
+	return os.RemoveAll(filepath.Join(binDir, id))
+}
@@ -0,0 +1,142 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestAddRecycleBinEntryAssignsSequentialIds(t *testing.T) {
+	binDir := t.TempDir()
+	first := &RecycleBinEntry{Command: RecycleBinCommandDelete, Type: utils.ProjectTypeApi, Name: "PizzaAPI",
+		Version: "1.0.0", Environment: "dev", File: "PizzaAPI_1.0.0.zip"}
+	require.NoError(t, addRecycleBinEntry(binDir, first, []byte("api"), 1024))
+	second := &RecycleBinEntry{Command: RecycleBinCommandUndeploy, Type: utils.ProjectTypeApi, Name: "PizzaAPI",
+		Version: "1.0.0", Revision: "2", Environment: "dev",
+		Deployments: []utils.Deployment{{Name: "Default", Vhost: "localhost", DisplayOnDevportal: true}}}
+	require.NoError(t, addRecycleBinEntry(binDir, second, nil, 1024))
+
+	assert.Equal(t, 1, first.ID)
+	assert.Equal(t, 2, second.ID)
+	content, err := ioutil.ReadFile(filepath.Join(binDir, "1", "PizzaAPI_1.0.0.zip"))
+	require.NoError(t, err)
+	assert.Equal(t, "api", string(content))
+
+	entries, err := listRecycleBinEntries(binDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(3), entries[0].Size)
+	assert.Equal(t, "2", entries[1].Revision)
+	assert.Equal(t, second.Deployments, entries[1].Deployments)
+}
+
+func TestAddRecycleBinEntryEvictsOldestEntries(t *testing.T) {
+	binDir := t.TempDir()
+	for _, name := range []string{"First", "Second", "Third"} {
+		entry := &RecycleBinEntry{Command: RecycleBinCommandDelete, Type: utils.ProjectTypeApplication, Name: name,
+			Environment: "dev", File: name + ".zip"}
+		require.NoError(t, addRecycleBinEntry(binDir, entry, []byte("12345"), 10))
+	}
+
+	entries, err := listRecycleBinEntries(binDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Second", entries[0].Name)
+	assert.Equal(t, "Third", entries[1].Name)
+	_, err = os.Stat(filepath.Join(binDir, "1"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestAddRecycleBinEntryKeepsEntryLargerThanMaxSize(t *testing.T) {
+	binDir := t.TempDir()
+	entry := &RecycleBinEntry{Command: RecycleBinCommandDelete, Type: utils.ProjectTypeApi, Name: "LargeAPI",
+		Version: "1.0.0", Environment: "dev", File: "LargeAPI_1.0.0.zip"}
+	require.NoError(t, addRecycleBinEntry(binDir, entry, []byte("12345"), 1))
+
+	entries, err := listRecycleBinEntries(binDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "LargeAPI", entries[0].Name)
+}
+
+func TestListRecycleBinEntriesOfMissingDirectory(t *testing.T) {
+	entries, err := listRecycleBinEntries(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestGetRecycleBinEntry(t *testing.T) {
+	binDir := t.TempDir()
+	entry := &RecycleBinEntry{Command: RecycleBinCommandDelete, Type: utils.ProjectTypeThrottlingPolicy,
+		Name: "Gold", PolicyType: "sub", Environment: "dev", File: "Gold.json"}
+	require.NoError(t, addRecycleBinEntry(binDir, entry, []byte("{}"), 1024))
+
+	stored, err := getRecycleBinEntry(binDir, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Gold", stored.Name)
+	assert.Equal(t, "sub", stored.PolicyType)
+
+	_, err = getRecycleBinEntry(binDir, "2")
+	assert.EqualError(t, err, "recycle bin entry 2 not found")
+	_, err = getRecycleBinEntry(binDir, "../1")
+	assert.EqualError(t, err, "invalid recycle bin entry id ../1")
+
+	require.NoError(t, removeRecycleBinEntry(binDir, "1"))
+	_, err = getRecycleBinEntry(binDir, "1")
+	assert.Error(t, err)
+}
+
+func TestGetDeployedGateways(t *testing.T) {
+	revisions := []utils.Revisions{
+		{RevisionNumber: "Revision 1", Deployments: []utils.Deployment{{Name: "Default", Vhost: "localhost"}}},
+		{RevisionNumber: "Revision 3", Deployments: []utils.Deployment{{Name: "Default", Vhost: "localhost"},
+			{Name: "External", Vhost: "api.example.com"}}},
+	}
+	assert.Equal(t, []utils.Deployment{{Name: "Default", Vhost: "localhost"},
+		{Name: "External", Vhost: "api.example.com"}}, getDeployedGateways(revisions))
+	assert.Empty(t, getDeployedGateways(nil))
+}
+
+func TestGetUndeployedGateways(t *testing.T) {
+	revisions := []utils.Revisions{
+		{RevisionNumber: "Revision 2", Deployments: []utils.Deployment{{Name: "Default", Vhost: "localhost"},
+			{Name: "External", Vhost: "api.example.com"}}},
+	}
+
+	deployments, err := getUndeployedGateways(revisions, RecycleBinArtifact{Revision: "2"})
+	require.NoError(t, err)
+	assert.Len(t, deployments, 2)
+
+	deployments, err = getUndeployedGateways(revisions, RecycleBinArtifact{Revision: "2",
+		Gateways: []string{"External"}})
+	require.NoError(t, err)
+	assert.Equal(t, []utils.Deployment{{Name: "External", Vhost: "api.example.com"}}, deployments)
+
+	_, err = getUndeployedGateways(revisions, RecycleBinArtifact{Revision: "2", Gateways: []string{"Internal"}})
+	assert.EqualError(t, err, "revision 2 is not deployed to the given gateways")
+	_, err = getUndeployedGateways(revisions, RecycleBinArtifact{Revision: "1"})
+	assert.EqualError(t, err, "revision 1 is not deployed")
+}
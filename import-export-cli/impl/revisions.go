@@ -0,0 +1,288 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	// RevisionArtifactTypeAPI is used to manage the revisions of an API
+	RevisionArtifactTypeAPI = "api"
+	// RevisionArtifactTypeAPIProduct is used to manage the revisions of an API Product
+	RevisionArtifactTypeAPIProduct = "api-product"
+)
+
+// RevisionArtifact identifies the API or API Product whose revisions are managed
+type RevisionArtifact struct {
+	Type     string
+	Name     string
+	Version  string
+	Provider string
+}
+
+// String returns a human readable name of the artifact
+func (a RevisionArtifact) String() string {
+	if a.Type == RevisionArtifactTypeAPIProduct {
+		return utils.ProjectTypeApiProduct + " " + a.Name + "_" + a.Version
+	}
+	return utils.ProjectTypeApi + " " + a.Name + "_" + a.Version
+}
+
+// revisionRequest is the payload used to create a revision
+type revisionRequest struct {
+	Description string `json:"description,omitempty"`
+}
+
+// CreateRevision creates a new revision from the working copy of an API or API Product
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product to create the revision of
+// @param description : Description of the revision
+// @return created revision, error
+func CreateRevision(accessToken, environment string, artifact RevisionArtifact,
+	description string) (*utils.Revisions, error) {
+	revisionsEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(revisionRequest{Description: description})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := utils.InvokePOSTRequest(revisionsEndpoint+"/revisions", getRevisionHeaders(accessToken), string(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "creating a revision of "+artifact.String())
+	}
+	revision := &utils.Revisions{}
+	err = json.Unmarshal(resp.Body(), revision)
+	return revision, err
+}
+
+// DeployRevision deploys a revision of an API or API Product to the given gateway environments
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product to deploy the revision of
+// @param revisionNum : Revision number to be deployed
+// @param deployments : Gateway environments (and their vhosts) to deploy the revision to
+// @return error
+func DeployRevision(accessToken, environment string, artifact RevisionArtifact, revisionNum string,
+	deployments []utils.Deployment) error {
+	revisionsEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return err
+	}
+	revisionId, err := getRevisionIdByNumber(accessToken, revisionsEndpoint, revisionNum)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(deployments)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePOSTRequest(revisionsEndpoint+"/deploy-revision?revisionId="+revisionId,
+		getRevisionHeaders(accessToken), string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "deploying revision "+revisionNum+" of "+artifact.String())
+	}
+	return nil
+}
+
+// RestoreRevision restores the working copy of an API or API Product to the given revision
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product to restore
+// @param revisionNum : Revision number to be restored
+// @return error
+func RestoreRevision(accessToken, environment string, artifact RevisionArtifact, revisionNum string) error {
+	revisionsEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return err
+	}
+	revisionId, err := getRevisionIdByNumber(accessToken, revisionsEndpoint, revisionNum)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePOSTRequestWithoutBody(revisionsEndpoint+"/restore-revision?revisionId="+revisionId,
+		getRevisionHeaders(accessToken))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "restoring revision "+revisionNum+" of "+artifact.String())
+	}
+	return nil
+}
+
+// PruneRevisions deletes the older revisions of an API or API Product. Deployed revisions are never deleted.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the API or API Product
+// @param artifact : API or API Product to prune the revisions of
+// @param keep : Number of latest revisions to keep
+// @param dryRun : Only return the revisions that would be deleted without deleting them
+// @return deleted revisions, error
+func PruneRevisions(accessToken, environment string, artifact RevisionArtifact, keep int,
+	dryRun bool) ([]utils.Revisions, error) {
+	if keep < 0 {
+		return nil, errors.New("number of revisions to keep should not be negative")
+	}
+	revisionsEndpoint, err := getRevisionArtifactEndpoint(accessToken, environment, artifact)
+	if err != nil {
+		return nil, err
+	}
+	_, revisions, err := GetRevisionsList(accessToken, revisionsEndpoint+"/revisions")
+	if err != nil {
+		return nil, err
+	}
+	revisionsToDelete := selectRevisionsToPrune(revisions, keep)
+	if dryRun {
+		return revisionsToDelete, nil
+	}
+	var deleted []utils.Revisions
+	for _, revision := range revisionsToDelete {
+		utils.Logln(utils.LogPrefixInfo+"Deleting", revision.RevisionNumber, "of", artifact.String())
+		resp, err := utils.InvokeDELETERequest(revisionsEndpoint+"/revisions/"+revision.ID,
+			getRevisionHeaders(accessToken))
+		if err != nil {
+			return deleted, err
+		}
+		if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+			return deleted, getPublisherResponseError(resp, "deleting "+revision.RevisionNumber+" of "+
+				artifact.String())
+		}
+		deleted = append(deleted, revision)
+	}
+	return deleted, nil
+}
+
+// selectRevisionsToPrune returns the revisions that are older than the latest keep revisions and are not
+// deployed in any gateway environment. Deployed revisions are counted towards the kept revisions.
+func selectRevisionsToPrune(revisions []utils.Revisions, keep int) []utils.Revisions {
+	sorted := make([]utils.Revisions, len(revisions))
+	copy(sorted, revisions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return getRevisionNumber(sorted[i]) > getRevisionNumber(sorted[j])
+	})
+	var revisionsToDelete []utils.Revisions
+	for i, revision := range sorted {
+		if i < keep || len(revision.Deployments) > 0 {
+			continue
+		}
+		revisionsToDelete = append(revisionsToDelete, revision)
+	}
+	return revisionsToDelete
+}
+
+// getRevisionNumber returns the revision number of a revision, or -1 if it cannot be resolved
+func getRevisionNumber(revision utils.Revisions) int {
+	revisionNum, err := strconv.Atoi(utils.GetRevisionNumFromRevisionName(revision.RevisionNumber))
+	if err != nil {
+		return -1
+	}
+	return revisionNum
+}
+
+// getRevisionIdByNumber returns the UUID of the revision with the given revision number
+func getRevisionIdByNumber(accessToken, revisionsEndpoint, revisionNum string) (string, error) {
+	_, revisions, err := GetRevisionsList(accessToken, revisionsEndpoint+"/revisions")
+	if err != nil {
+		return "", err
+	}
+	return findRevisionId(revisions, revisionNum)
+}
+
+// findRevisionId returns the UUID of the revision with the given revision number from the revisions list
+func findRevisionId(revisions []utils.Revisions, revisionNum string) (string, error) {
+	for _, revision := range revisions {
+		if utils.GetRevisionNumFromRevisionName(revision.RevisionNumber) == revisionNum {
+			return revision.ID, nil
+		}
+	}
+	return "", errors.New("revision " + revisionNum + " not found")
+}
+
+// getRevisionArtifactEndpoint returns the publisher REST API resource of the API or API Product
+func getRevisionArtifactEndpoint(accessToken, environment string, artifact RevisionArtifact) (string, error) {
+	var id, endpoint string
+	var err error
+	switch artifact.Type {
+	case RevisionArtifactTypeAPI, "":
+		id, err = GetAPIId(accessToken, environment, artifact.Name, artifact.Version, artifact.Provider)
+		endpoint = utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	case RevisionArtifactTypeAPIProduct:
+		id, err = GetAPIProductId(accessToken, environment, artifact.Name, artifact.Version, artifact.Provider)
+		endpoint = utils.GetApiProductListEndpointOfEnv(environment, utils.MainConfigFilePath)
+	default:
+		return "", fmt.Errorf("invalid type %s. Supported types are %s and %s", artifact.Type,
+			RevisionArtifactTypeAPI, RevisionArtifactTypeAPIProduct)
+	}
+	if err != nil {
+		return "", err
+	}
+	return utils.AppendSlashToString(endpoint) + id, nil
+}
+
+func getRevisionHeaders(accessToken string) map[string]string {
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	return headers
+}
+
+// getPublisherResponseError creates the error of a publisher or admin REST API call that did not succeed. The
+// description of the error returned by the server is preferred over the raw response body.
+func getPublisherResponseError(resp *resty.Response, action string) error {
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return errors.New("authorization failed while " + action)
+	}
+	message := strings.TrimSpace(string(resp.Body()))
+	var errorResponse struct {
+		Message     string `json:"message"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(resp.Body(), &errorResponse); err == nil {
+		if errorResponse.Description != "" {
+			message = errorResponse.Description
+		} else if errorResponse.Message != "" {
+			message = errorResponse.Message
+		}
+	}
+	if message == "" {
+		return errors.New("error " + action + ". Status: " + resp.Status())
+	}
+	return errors.New("error " + action + ". Status: " + resp.Status() + ". " + message)
+}
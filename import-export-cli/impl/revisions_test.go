@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func getTestRevisions() []utils.Revisions {
+	return []utils.Revisions{
+		{ID: "rev-1", RevisionNumber: "Revision 1"},
+		{ID: "rev-2", RevisionNumber: "Revision 2", Deployments: []utils.Deployment{{Name: "Default"}}},
+		{ID: "rev-10", RevisionNumber: "Revision 10"},
+		{ID: "rev-3", RevisionNumber: "Revision 3"},
+		{ID: "rev-4", RevisionNumber: "Revision 4"},
+	}
+}
+
+func TestSelectRevisionsToPruneKeepsLatest(t *testing.T) {
+	revisions := selectRevisionsToPrune(getTestRevisions(), 2)
+	var ids []string
+	for _, revision := range revisions {
+		ids = append(ids, revision.ID)
+	}
+	assert.Equal(t, []string{"rev-3", "rev-1"}, ids)
+}
+
+func TestSelectRevisionsToPruneNeverDeletesDeployed(t *testing.T) {
+	revisions := selectRevisionsToPrune(getTestRevisions(), 0)
+	assert.Len(t, revisions, 4)
+	for _, revision := range revisions {
+		assert.NotEqual(t, "rev-2", revision.ID)
+	}
+}
+
+func TestSelectRevisionsToPruneKeepMoreThanAvailable(t *testing.T) {
+	assert.Empty(t, selectRevisionsToPrune(getTestRevisions(), 10))
+}
+
+func TestFindRevisionId(t *testing.T) {
+	id, err := findRevisionId(getTestRevisions(), "10")
+	assert.Nil(t, err)
+	assert.Equal(t, "rev-10", id)
+
+	_, err = findRevisionId(getTestRevisions(), "5")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,433 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	// RolloutStatusInProgress is the status of a rollout that has stages left to be rolled out
+	RolloutStatusInProgress = "IN_PROGRESS"
+	// RolloutStatusCompleted is the status of a rollout that is verified in all the stages
+	RolloutStatusCompleted = "COMPLETED"
+	// RolloutStatusRolledBack is the status of a rollout that was rolled back after a failure
+	RolloutStatusRolledBack = "ROLLED_BACK"
+	// RolloutStatusAborted is the status of a rollout that was aborted by the user
+	RolloutStatusAborted = "ABORTED"
+	// RolloutStatusRollbackFailed is the status of a rollout that could not be rolled back completely
+	RolloutStatusRollbackFailed = "ROLLBACK_FAILED"
+
+	rolloutStagePending    = "PENDING"
+	rolloutStageDeployed   = "DEPLOYED"
+	rolloutStageVerified   = "VERIFIED"
+	rolloutStageRolledBack = "ROLLED_BACK"
+
+	// Placeholder in the verification step that is replaced with the gateway environment of the stage
+	RolloutGatewayEnvPlaceholder = "{gatewayEnv}"
+	// Environment variable the gateway environment of the stage is exposed to the verification command with
+	RolloutGatewayEnvVariable = "APICTL_ROLLOUT_GATEWAY_ENV"
+)
+
+// RolloutStage holds the progress of rolling out a revision to a gateway environment
+type RolloutStage struct {
+	GatewayEnv       string `json:"gatewayEnv"`
+	Status           string `json:"status"`
+	PreviousRevision string `json:"previousRevision,omitempty"`
+	PreviousVhost    string `json:"previousVhost,omitempty"`
+	// AlreadyDeployed is set when the revision was deployed to the gateway environment before the rollout, so that
+	// it is left deployed there on rollback
+	AlreadyDeployed bool      `json:"alreadyDeployed,omitempty"`
+	Message         string    `json:"message,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// RolloutState is the persisted state of a rollout, used to resume or abort it
+type RolloutState struct {
+	Environment   string         `json:"environment"`
+	Name          string         `json:"name"`
+	Version       string         `json:"version"`
+	Provider      string         `json:"provider,omitempty"`
+	Revision      string         `json:"revision"`
+	Vhost         string         `json:"vhost,omitempty"`
+	Verify        string         `json:"verify,omitempty"`
+	Wait          time.Duration  `json:"wait"`
+	Status        string         `json:"status"`
+	Stages        []RolloutStage `json:"stages"`
+	StartedAt     time.Time      `json:"startedAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	FailureReason string         `json:"failureReason,omitempty"`
+}
+
+// NewRolloutState creates the state of a new rollout of an API revision
+// @param environment : Environment of the API
+// @param name : Name of the API
+// @param version : Version of the API
+// @param provider : Provider of the API
+// @param revisionNum : Revision number to be rolled out
+// @param stages : Gateway environments to roll out to, in order
+// @param vhost : Virtual host to deploy the revision with
+// @param verify : URL to probe or command to run after deploying to each stage
+// @param wait : Time to wait between the stages
+// @return rollout state
+func NewRolloutState(environment, name, version, provider, revisionNum string, stages []string, vhost,
+	verify string, wait time.Duration) *RolloutState {
+	now := time.Now().UTC()
+	state := &RolloutState{Environment: environment, Name: name, Version: version, Provider: provider,
+		Revision: revisionNum, Vhost: vhost, Verify: verify, Wait: wait, Status: RolloutStatusInProgress,
+		StartedAt: now, UpdatedAt: now}
+	for _, stage := range stages {
+		state.Stages = append(state.Stages, RolloutStage{GatewayEnv: stage, Status: rolloutStagePending, UpdatedAt: now})
+	}
+	return state
+}
+
+// GetRolloutStatePath returns the path of the file the state of the rollout of an API is persisted in
+func GetRolloutStatePath(environment, name, version string) string {
+	return filepath.Join(utils.ConfigDirPath, utils.RolloutsDirName, environment+"_"+name+"_"+version+".json")
+}
+
+// LoadRolloutState reads a persisted rollout state
+// @param statePath : Path of the rollout state file
+// @return rollout state, error
+func LoadRolloutState(statePath string) (*RolloutState, error) {
+	content, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("no rollout found at " + statePath)
+		}
+		return nil, err
+	}
+	state := &RolloutState{}
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// SaveRolloutState persists a rollout state
+// @param statePath : Path of the rollout state file
+// @param state : Rollout state
+// @return error
+func SaveRolloutState(statePath string, state *RolloutState) error {
+	state.UpdatedAt = time.Now().UTC()
+	if err := utils.CreateDirIfNotExist(filepath.Dir(statePath)); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(statePath, content, os.ModePerm)
+}
+
+// IsRolloutFinished returns whether no further action can be taken on the rollout
+func (state *RolloutState) IsRolloutFinished() bool {
+	return state.Status != RolloutStatusInProgress
+}
+
+// RunRollout deploys the revision stage by stage, verifying each stage and waiting between stages. The rollout is
+// rolled back if the deployment or the verification of a stage fails. Verified stages are skipped, so an
+// interrupted rollout can be resumed by running it again with the persisted state.
+// @param accessToken : Access Token for the environment
+// @param statePath : Path the rollout state is persisted to
+// @param state : Rollout state
+// @return error
+func RunRollout(accessToken, statePath string, state *RolloutState) error {
+	apiId, err := GetAPIId(accessToken, state.Environment, state.Name, state.Version, state.Provider)
+	if err != nil {
+		return err
+	}
+	apiListEndpoint := utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(state.Environment,
+		utils.MainConfigFilePath))
+	return runRollout(accessToken, apiListEndpoint, apiId, statePath, state)
+}
+
+// AbortRollout rolls back the stages that the revision was already rolled out to
+// @param accessToken : Access Token for the environment
+// @param statePath : Path the rollout state is persisted to
+// @param state : Rollout state
+// @return error
+func AbortRollout(accessToken, statePath string, state *RolloutState) error {
+	apiId, err := GetAPIId(accessToken, state.Environment, state.Name, state.Version, state.Provider)
+	if err != nil {
+		return err
+	}
+	apiListEndpoint := utils.AppendSlashToString(utils.GetApiListEndpointOfEnv(state.Environment,
+		utils.MainConfigFilePath))
+	return abortRollout(accessToken, apiListEndpoint, apiId, statePath, state)
+}
+
+func runRollout(accessToken, apiListEndpoint, apiId, statePath string, state *RolloutState) error {
+	if state.IsRolloutFinished() {
+		return errors.New("rollout of revision " + state.Revision + " is already " + state.Status)
+	}
+	if err := recordPreviousRevisions(accessToken, apiListEndpoint, apiId, state); err != nil {
+		return err
+	}
+	if err := SaveRolloutState(statePath, state); err != nil {
+		return err
+	}
+	for i := range state.Stages {
+		stage := &state.Stages[i]
+		if stage.Status == rolloutStageVerified {
+			continue
+		}
+		if stage.Status == rolloutStagePending && stage.AlreadyDeployed {
+			fmt.Println("Revision " + state.Revision + " is already deployed to " + stage.GatewayEnv)
+			setRolloutStageStatus(stage, rolloutStageDeployed, "")
+		} else if stage.Status == rolloutStagePending {
+			fmt.Println("Deploying revision " + state.Revision + " to " + stage.GatewayEnv)
+			err := deployRolloutRevision(accessToken, apiListEndpoint, apiId, state.Revision, stage.GatewayEnv,
+				state.Vhost)
+			if err != nil {
+				return failRollout(accessToken, apiListEndpoint, apiId, statePath, state, stage, "deploying to "+stage.GatewayEnv+" failed: "+
+					err.Error())
+			}
+			setRolloutStageStatus(stage, rolloutStageDeployed, "")
+			if err := SaveRolloutState(statePath, state); err != nil {
+				return err
+			}
+		}
+		if state.Verify != "" {
+			fmt.Println("Verifying revision " + state.Revision + " on " + stage.GatewayEnv)
+			if err := verifyRolloutStage(state.Verify, stage.GatewayEnv); err != nil {
+				return failRollout(accessToken, apiListEndpoint, apiId, statePath, state, stage, "verification on "+stage.GatewayEnv+" failed: "+
+					err.Error())
+			}
+		}
+		setRolloutStageStatus(stage, rolloutStageVerified, "")
+		if err := SaveRolloutState(statePath, state); err != nil {
+			return err
+		}
+		if i < len(state.Stages)-1 && state.Wait > 0 {
+			fmt.Println("Waiting " + state.Wait.String() + " before the next stage")
+			time.Sleep(state.Wait)
+		}
+	}
+	state.Status = RolloutStatusCompleted
+	return SaveRolloutState(statePath, state)
+}
+
+func abortRollout(accessToken, apiListEndpoint, apiId, statePath string, state *RolloutState) error {
+	if state.IsRolloutFinished() {
+		return errors.New("rollout of revision " + state.Revision + " is already " + state.Status)
+	}
+	state.FailureReason = "aborted by the user"
+	err := rollbackRollout(accessToken, apiListEndpoint, apiId, state)
+	if err != nil {
+		state.Status = RolloutStatusRollbackFailed
+	} else {
+		state.Status = RolloutStatusAborted
+	}
+	if saveErr := SaveRolloutState(statePath, state); saveErr != nil {
+		return saveErr
+	}
+	return err
+}
+
+// failRollout rolls back the rollout after a stage failed and persists the outcome
+func failRollout(accessToken, apiListEndpoint, apiId, statePath string, state *RolloutState, stage *RolloutStage,
+	reason string) error {
+	stage.Message = reason
+	state.FailureReason = reason
+	fmt.Println("Rollout failed: " + reason + ". Rolling back..")
+	if err := rollbackRollout(accessToken, apiListEndpoint, apiId, state); err != nil {
+		state.Status = RolloutStatusRollbackFailed
+		_ = SaveRolloutState(statePath, state)
+		return errors.New(reason + ". Rollback failed: " + err.Error())
+	}
+	state.Status = RolloutStatusRolledBack
+	if err := SaveRolloutState(statePath, state); err != nil {
+		return err
+	}
+	return errors.New(reason + ". Rolled back successfully")
+}
+
+// rollbackRollout undeploys the new revision and redeploys the previously deployed revision of each stage that
+// was rolled out, starting from the latest stage. Stages the revision was already deployed to before the rollout
+// are left as they are.
+func rollbackRollout(accessToken, apiListEndpoint, apiId string, state *RolloutState) error {
+	var failures []string
+	for i := len(state.Stages) - 1; i >= 0; i-- {
+		stage := &state.Stages[i]
+		if stage.Status != rolloutStageDeployed && stage.Status != rolloutStageVerified {
+			continue
+		}
+		if stage.AlreadyDeployed {
+			fmt.Println("Keeping revision " + state.Revision + " in " + stage.GatewayEnv +
+				" as it was deployed before the rollout")
+			setRolloutStageStatus(stage, rolloutStageRolledBack, stage.Message)
+			continue
+		}
+		fmt.Println("Undeploying revision " + state.Revision + " from " + stage.GatewayEnv)
+		if err := undeployRolloutRevision(accessToken, apiListEndpoint, apiId, state.Revision,
+			stage.GatewayEnv); err != nil {
+			failures = append(failures, stage.GatewayEnv+": "+err.Error())
+			continue
+		}
+		if stage.PreviousRevision != "" {
+			fmt.Println("Redeploying revision " + stage.PreviousRevision + " to " + stage.GatewayEnv)
+			err := deployRolloutRevision(accessToken, apiListEndpoint, apiId, stage.PreviousRevision,
+				stage.GatewayEnv, stage.PreviousVhost)
+			if err != nil {
+				failures = append(failures, stage.GatewayEnv+": "+err.Error())
+				continue
+			}
+		}
+		setRolloutStageStatus(stage, rolloutStageRolledBack, stage.Message)
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+// recordPreviousRevisions records the revision deployed in each pending stage before the rollout touches it, and
+// whether the rolled out revision is deployed there already
+func recordPreviousRevisions(accessToken, apiListEndpoint, apiId string, state *RolloutState) error {
+	_, revisions, err := GetRevisionsList(accessToken, apiListEndpoint+apiId+"/revisions")
+	if err != nil {
+		return err
+	}
+	if _, err := findRevisionId(revisions, state.Revision); err != nil {
+		return err
+	}
+	for i := range state.Stages {
+		stage := &state.Stages[i]
+		if stage.Status != rolloutStagePending {
+			continue
+		}
+		stage.PreviousRevision = ""
+		stage.PreviousVhost = ""
+		stage.AlreadyDeployed = false
+		for _, revision := range revisions {
+			revisionNum := utils.GetRevisionNumFromRevisionName(revision.RevisionNumber)
+			for _, deployment := range revision.Deployments {
+				if deployment.Name != stage.GatewayEnv {
+					continue
+				}
+				if revisionNum == state.Revision {
+					stage.AlreadyDeployed = true
+				} else {
+					stage.PreviousRevision = revisionNum
+					stage.PreviousVhost = deployment.Vhost
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func setRolloutStageStatus(stage *RolloutStage, status, message string) {
+	stage.Status = status
+	stage.Message = message
+	stage.UpdatedAt = time.Now().UTC()
+}
+
+// verifyRolloutStage runs the verification step of a stage. URLs are probed with a GET request that should
+// respond with a 2xx status, anything else is run as a command that should exit with 0.
+func verifyRolloutStage(verify, gatewayEnv string) error {
+	verify = strings.ReplaceAll(verify, RolloutGatewayEnvPlaceholder, gatewayEnv)
+	if strings.HasPrefix(verify, "http://") || strings.HasPrefix(verify, "https://") {
+		resp, err := utils.InvokeGETRequest(verify, map[string]string{})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
+			return errors.New("probe " + verify + " responded with " + resp.Status())
+		}
+		return nil
+	}
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", verify)
+	} else {
+		cmd = exec.Command("sh", "-c", verify)
+	}
+	cmd.Env = append(os.Environ(), RolloutGatewayEnvVariable+"="+gatewayEnv)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.New("command " + verify + " failed: " + err.Error())
+	}
+	return nil
+}
+
+// deployRolloutRevision deploys a revision of the API to a gateway environment
+func deployRolloutRevision(accessToken, apiListEndpoint, apiId, revisionNum, gatewayEnv, vhost string) error {
+	revisionId, err := getRevisionIdByNumber(accessToken, apiListEndpoint+apiId, revisionNum)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal([]utils.Deployment{{Name: gatewayEnv, Vhost: vhost, DisplayOnDevportal: true}})
+	if err != nil {
+		return err
+	}
+	resp, err := utils.InvokePOSTRequest(apiListEndpoint+apiId+"/deploy-revision?revisionId="+revisionId,
+		getRevisionHeaders(accessToken), string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "deploying revision "+revisionNum+" to "+gatewayEnv)
+	}
+	return nil
+}
+
+// undeployRolloutRevision undeploys a revision of the API from a gateway environment
+func undeployRolloutRevision(accessToken, apiListEndpoint, apiId, revisionNum, gatewayEnv string) error {
+	resp, err := undeployRevision(accessToken, apiListEndpoint, apiId, revisionNum,
+		[]utils.Deployment{{Name: gatewayEnv, DisplayOnDevportal: true}}, false)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return getPublisherResponseError(resp, "undeploying revision "+revisionNum+" from "+gatewayEnv)
+	}
+	return nil
+}
+
+// PrintRolloutState prints the progress of each stage of a rollout
+func PrintRolloutState(state *RolloutState) {
+	fmt.Println("Rollout of revision " + state.Revision + " of API " + state.Name + "_" + state.Version + ": " +
+		state.Status)
+	for _, stage := range state.Stages {
+		line := "  " + stage.GatewayEnv + ": " + stage.Status
+		if stage.PreviousRevision != "" {
+			line += " (previous revision " + stage.PreviousRevision + ")"
+		}
+		if stage.Message != "" {
+			line += " - " + stage.Message
+		}
+		fmt.Println(line)
+	}
+}
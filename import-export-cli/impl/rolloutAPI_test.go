@@ -0,0 +1,204 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// readRolloutDeployment returns the gateway environment of a deploy or undeploy request
+func readRolloutDeployment(t *testing.T, r *http.Request) string {
+	var deployments []utils.Deployment
+	if err := json.NewDecoder(r.Body).Decode(&deployments); err != nil || len(deployments) != 1 {
+		t.Errorf("Invalid deployments %v: %v", deployments, err)
+		return ""
+	}
+	return deployments[0].Name
+}
+
+func TestRunRolloutCompletesAllStages(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/pizza/revisions":
+			w.Write([]byte(`{"count": 2, "list": [{"id": "rev-2", "displayName": "Revision 2",
+				"deploymentInfo": [{"name": "gw-eu", "vhost": "eu.example.com"}]},
+				{"id": "rev-3", "displayName": "Revision 3"}]}`))
+		case "/apis/pizza/deploy-revision":
+			actions = append(actions, "deploy "+r.URL.Query().Get("revisionId")+" "+readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/health/gw-canary", "/health/gw-eu":
+			actions = append(actions, "verify "+r.URL.Path)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	statePath := filepath.Join(t.TempDir(), "rollout.json")
+	state := NewRolloutState("prod", "PizzaShackAPI", "1.0.0", "", "3", []string{"gw-canary", "gw-eu"}, "",
+		server.URL+"/health/"+RolloutGatewayEnvPlaceholder, time.Millisecond)
+
+	err := runRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.Nil(t, err)
+	assert.Equal(t, RolloutStatusCompleted, state.Status)
+	assert.Equal(t, []string{"deploy rev-3 gw-canary", "verify /health/gw-canary", "deploy rev-3 gw-eu",
+		"verify /health/gw-eu"}, actions)
+	assert.Equal(t, "2", state.Stages[1].PreviousRevision)
+	assert.Equal(t, "eu.example.com", state.Stages[1].PreviousVhost)
+
+	persisted, err := LoadRolloutState(statePath)
+	assert.Nil(t, err)
+	assert.Equal(t, RolloutStatusCompleted, persisted.Status)
+	assert.Equal(t, rolloutStageVerified, persisted.Stages[1].Status)
+}
+
+func TestRunRolloutRollsBackOnVerificationFailure(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/pizza/revisions":
+			w.Write([]byte(`{"count": 2, "list": [{"id": "rev-2", "displayName": "Revision 2",
+				"deploymentInfo": [{"name": "gw-eu"}]}, {"id": "rev-3", "displayName": "Revision 3"}]}`))
+		case "/apis/pizza/deploy-revision":
+			actions = append(actions, "deploy "+r.URL.Query().Get("revisionId")+" "+readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/apis/pizza/undeploy-revision":
+			actions = append(actions, "undeploy "+r.URL.Query().Get("revisionNumber")+" "+
+				readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/health/gw-canary":
+		case "/health/gw-eu":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	statePath := filepath.Join(t.TempDir(), "rollout.json")
+	state := NewRolloutState("prod", "PizzaShackAPI", "1.0.0", "", "3",
+		[]string{"gw-canary", "gw-eu", "gw-us"}, "", server.URL+"/health/"+RolloutGatewayEnvPlaceholder, 0)
+
+	err := runRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.NotNil(t, err)
+	assert.Equal(t, RolloutStatusRolledBack, state.Status)
+	assert.Equal(t, []string{"deploy rev-3 gw-canary", "deploy rev-3 gw-eu", "undeploy 3 gw-eu",
+		"deploy rev-2 gw-eu", "undeploy 3 gw-canary"}, actions)
+	assert.Equal(t, rolloutStagePending, state.Stages[2].Status)
+}
+
+func TestRunRolloutKeepsRevisionDeployedBeforeRollout(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/pizza/revisions":
+			w.Write([]byte(`{"count": 2, "list": [{"id": "rev-2", "displayName": "Revision 2",
+				"deploymentInfo": [{"name": "gw-eu"}]}, {"id": "rev-3", "displayName": "Revision 3",
+				"deploymentInfo": [{"name": "gw-canary"}]}]}`))
+		case "/apis/pizza/deploy-revision":
+			actions = append(actions, "deploy "+r.URL.Query().Get("revisionId")+" "+readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/apis/pizza/undeploy-revision":
+			actions = append(actions, "undeploy "+r.URL.Query().Get("revisionNumber")+" "+
+				readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/health/gw-canary":
+		case "/health/gw-eu":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	statePath := filepath.Join(t.TempDir(), "rollout.json")
+	state := NewRolloutState("prod", "PizzaShackAPI", "1.0.0", "", "3", []string{"gw-canary", "gw-eu"}, "",
+		server.URL+"/health/"+RolloutGatewayEnvPlaceholder, 0)
+
+	err := runRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.NotNil(t, err)
+	assert.Equal(t, RolloutStatusRolledBack, state.Status)
+	assert.True(t, state.Stages[0].AlreadyDeployed)
+	assert.Equal(t, []string{"deploy rev-3 gw-eu", "undeploy 3 gw-eu", "deploy rev-2 gw-eu"}, actions)
+	assert.Equal(t, rolloutStageRolledBack, state.Stages[0].Status)
+}
+
+func TestRunRolloutResumesAndAborts(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/pizza/revisions":
+			w.Write([]byte(`{"count": 1, "list": [{"id": "rev-3", "displayName": "Revision 3",
+				"deploymentInfo": [{"name": "gw-canary"}]}]}`))
+		case "/apis/pizza/deploy-revision":
+			actions = append(actions, "deploy "+r.URL.Query().Get("revisionId")+" "+readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		case "/apis/pizza/undeploy-revision":
+			actions = append(actions, "undeploy "+r.URL.Query().Get("revisionNumber")+" "+
+				readRolloutDeployment(t, r))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	statePath := filepath.Join(t.TempDir(), "rollout.json")
+	state := NewRolloutState("prod", "PizzaShackAPI", "1.0.0", "", "3", []string{"gw-canary", "gw-eu"}, "", "", 0)
+	state.Stages[0].Status = rolloutStageVerified
+
+	err := runRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"deploy rev-3 gw-eu"}, actions)
+
+	err = abortRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.NotNil(t, err, "a completed rollout cannot be aborted")
+
+	actions = nil
+	state.Status = RolloutStatusInProgress
+	err = abortRollout("access-token", server.URL+"/apis/", "pizza", statePath, state)
+	assert.Nil(t, err)
+	assert.Equal(t, RolloutStatusAborted, state.Status)
+	assert.Equal(t, []string{"undeploy 3 gw-eu", "undeploy 3 gw-canary"}, actions)
+}
+
+func TestVerifyRolloutStage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gw-eu/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	assert.Nil(t, verifyRolloutStage(server.URL+"/"+RolloutGatewayEnvPlaceholder+"/health", "gw-eu"))
+	assert.NotNil(t, verifyRolloutStage(server.URL+"/"+RolloutGatewayEnvPlaceholder+"/health", "gw-us"))
+	assert.Nil(t, verifyRolloutStage("test \"$"+RolloutGatewayEnvVariable+"\" = gw-eu", "gw-eu"))
+	assert.NotNil(t, verifyRolloutStage("test \"$"+RolloutGatewayEnvVariable+"\" = gw-eu", "gw-us"))
+}
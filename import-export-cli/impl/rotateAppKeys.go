@@ -0,0 +1,374 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// rotationApplicationListLimit is the maximum no. of applications of the logged in user which are rotated
+const rotationApplicationListLimit = "1000"
+
+// Sinks the rotated credentials can be written to
+const (
+	RotationOutputJSON = "json"
+	RotationOutputFile = "file"
+	RotationOutputK8s  = "k8s"
+)
+
+// AppKeyRotationOptions contains which credentials of which applications are rotated
+type AppKeyRotationOptions struct {
+	// AppName of the application to be rotated. All the applications of the Owner are rotated if this is empty.
+	AppName string
+	// Owner of the applications to be rotated. The keys are regenerated through the devportal as the logged in user,
+	// hence only the applications of the logged in user can be rotated.
+	Owner string
+	// KeyType limits the rotation to PRODUCTION or SANDBOX keys. Both are rotated if this is empty.
+	KeyType string
+	// GracePeriod to keep the previous secret valid for in seconds, where the server supports it
+	GracePeriod int
+	// APIKeys generates new API keys along with the consumer secrets
+	APIKeys bool
+	// APIKeyValidityPeriod of the new API keys in seconds. The API keys do not expire if this is 0.
+	APIKeyValidityPeriod int
+}
+
+// RotatedAppCredentials contains the new credentials of a key of an application
+type RotatedAppCredentials struct {
+	Application             string `json:"application" yaml:"application"`
+	ApplicationID           string `json:"applicationId" yaml:"applicationId"`
+	KeyType                 string `json:"keyType" yaml:"keyType"`
+	KeyManager              string `json:"keyManager,omitempty" yaml:"keyManager,omitempty"`
+	ConsumerKey             string `json:"consumerKey,omitempty" yaml:"consumerKey,omitempty"`
+	ConsumerSecret          string `json:"consumerSecret,omitempty" yaml:"consumerSecret,omitempty"`
+	PreviousSecretExpiresAt string `json:"previousSecretExpiresAt,omitempty" yaml:"previousSecretExpiresAt,omitempty"`
+	APIKey                  string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+}
+
+// AppKeyRotationAuditRecord is an entry of the audit trail of the rotations. It never contains the secrets.
+type AppKeyRotationAuditRecord struct {
+	Timestamp     string `json:"timestamp"`
+	Environment   string `json:"environment"`
+	User          string `json:"user"`
+	Application   string `json:"application"`
+	ApplicationID string `json:"applicationId,omitempty"`
+	KeyType       string `json:"keyType,omitempty"`
+	ConsumerKey   string `json:"consumerKey,omitempty"`
+	Action        string `json:"action"`
+	Status        string `json:"status"`
+	Message       string `json:"message,omitempty"`
+}
+
+type rotationApplication struct {
+	id   string
+	name string
+}
+
+// RotateAppKeys regenerates the consumer secrets, and optionally the API keys, of one or many applications
+// @param accessToken : Access token to call the devportal and admin REST APIs
+// @param environment : Environment of the applications
+// @param user : User who rotates the credentials, recorded in the audit trail
+// @param options : Applications and credentials to be rotated
+// @return new credentials, audit records of the rotations and the failures
+func RotateAppKeys(accessToken, environment, user string, options AppKeyRotationOptions) ([]RotatedAppCredentials,
+	[]AppKeyRotationAuditRecord) {
+	keyGenEnv = environment
+	var rotated []RotatedAppCredentials
+	var records []AppKeyRotationAuditRecord
+	audit := func(app rotationApplication, keyType, consumerKey, action string, err error) {
+		record := AppKeyRotationAuditRecord{
+			Timestamp:     time.Now().UTC().Format(time.RFC3339),
+			Environment:   environment,
+			User:          user,
+			Application:   app.name,
+			ApplicationID: app.id,
+			KeyType:       keyType,
+			ConsumerKey:   consumerKey,
+			Action:        action,
+			Status:        "success",
+		}
+		if err != nil {
+			record.Status = "failed"
+			record.Message = err.Error()
+		}
+		records = append(records, record)
+	}
+
+	applications, err := getApplicationsToRotate(accessToken, environment, user, options)
+	if err != nil {
+		audit(rotationApplication{name: options.AppName}, "", "", "list-applications", err)
+		return nil, records
+	}
+	for _, app := range applications {
+		appKeys, err := getApplicationKeys(app.id, accessToken)
+		if err != nil {
+			audit(app, "", "", "list-keys", err)
+			continue
+		}
+		for _, key := range appKeys.List {
+			if options.KeyType != "" && !strings.EqualFold(key.KeyType, options.KeyType) {
+				continue
+			}
+			credentials := RotatedAppCredentials{
+				Application:   app.name,
+				ApplicationID: app.id,
+				KeyType:       key.KeyType,
+				KeyManager:    key.KeyManager,
+				ConsumerKey:   key.ConsumerKey,
+			}
+			regenResponse, err := regenerateConsumerSecret(app.id, &key, options.GracePeriod, accessToken)
+			audit(app, key.KeyType, key.ConsumerKey, "regenerate-consumer-secret", err)
+			if err != nil {
+				continue
+			}
+			credentials.ConsumerSecret = regenResponse.ConsumerSecret
+			credentials.PreviousSecretExpiresAt = regenResponse.PreviousSecretExpiresAt
+			if options.GracePeriod > 0 && regenResponse.PreviousSecretExpiresAt == "" {
+				utils.Logln(utils.LogPrefixWarning + "The server revoked the previous consumer secret of " + app.name +
+					" immediately as it does not support a grace period")
+			}
+			if options.APIKeys {
+				credentials.APIKey, err = generateAPIKey(app.id, key.KeyType, options.APIKeyValidityPeriod,
+					accessToken)
+				audit(app, key.KeyType, key.ConsumerKey, "generate-api-key", err)
+			}
+			rotated = append(rotated, credentials)
+		}
+	}
+	return rotated, records
+}
+
+// getApplicationsToRotate returns the application with the given name, or all the applications of the owner. The
+// owner should be the logged in user since the keys of the applications of other users cannot be regenerated through
+// the devportal.
+func getApplicationsToRotate(accessToken, environment, user string,
+	options AppKeyRotationOptions) ([]rotationApplication, error) {
+	if options.Owner != "" && !strings.EqualFold(options.Owner, user) {
+		return nil, errors.New("the keys of the applications of " + options.Owner + " cannot be rotated by " + user +
+			". Log in as " + options.Owner + " to rotate them")
+	}
+	if options.AppName != "" {
+		appId, err := getApplicationIdByName(options.AppName, accessToken)
+		if err != nil {
+			return nil, err
+		}
+		return []rotationApplication{{id: appId, name: options.AppName}}, nil
+	}
+	_, apps, err := GetApplicationList(accessToken,
+		utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath), "",
+		rotationApplicationListLimit)
+	if err != nil {
+		return nil, err
+	}
+	var applications []rotationApplication
+	for _, app := range apps {
+		applications = append(applications, rotationApplication{id: app.ID, name: app.Name})
+	}
+	return applications, nil
+}
+
+// regenerateConsumerSecret regenerates the consumer secret of a key of an application
+// @param appId : Application ID of the key
+// @param key : Key of the application
+// @param gracePeriod : Seconds to keep the previous secret valid for, where the server supports it
+// @param accessToken : Access token to call the devportal REST API
+// @return ConsumerSecretRegenResponse, error
+func regenerateConsumerSecret(appId string, key *utils.ApplicationKey, gracePeriod int,
+	accessToken string) (*utils.ConsumerSecretRegenResponse, error) {
+	if key.KeyMappingId == "" {
+		return nil, errors.New("the key mapping of the " + key.KeyType + " key is not available")
+	}
+	regenerateEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" +
+		appId + "/oauth-keys/" + key.KeyMappingId + "/regenerate-secret"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	body := ""
+	if gracePeriod > 0 {
+		headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+		body = fmt.Sprintf(`{"gracePeriod": %d}`, gracePeriod)
+	}
+	resp, err := utils.InvokePOSTRequest(regenerateEndpoint, headers, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		regenResponse := &utils.ConsumerSecretRegenResponse{}
+		err = json.Unmarshal(resp.Body(), regenResponse)
+		return regenResponse, err
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return nil, fmt.Errorf("authorization failed while regenerating the consumer secret of application: " + appId)
+	}
+	return nil, errors.New("Request didn't respond 200 OK for regenerating the consumer secret. Status: " +
+		resp.Status())
+}
+
+// WriteRotatedAppCredentials writes the new credentials to the chosen sink
+// @param credentials : New credentials of the applications
+// @param output : json (default), file or k8s
+// @param filePath : File to write the credentials to. The JSON and the Kubernetes Secret are printed to the standard
+// output if this is empty.
+// @param secretName : Name of the Kubernetes Secret
+// @param namespace : Namespace of the Kubernetes Secret
+// @param algorithm : Algorithm to encrypt the credentials with the keystore of the secret command
+// @return error
+func WriteRotatedAppCredentials(credentials []RotatedAppCredentials, output, filePath, secretName, namespace,
+	algorithm string) error {
+	switch output {
+	case "", RotationOutputJSON:
+		content, err := json.MarshalIndent(credentials, "", "  ")
+		if err != nil {
+			return err
+		}
+		return writeRotationOutput(filePath, append(content, '\n'))
+	case RotationOutputFile:
+		if filePath == "" {
+			return errors.New("a file path is required to write the encrypted credentials")
+		}
+		keyStoreConfig, err := utils.GetKeyStoreConfigFromFile(utils.GetKeyStoreConfigFilePath())
+		if err != nil {
+			return err
+		}
+		encrypted, err := utils.EncryptSecretValues(keyStoreConfig, algorithm, flattenRotatedAppCredentials(credentials))
+		if err != nil {
+			return errors.New("encrypting the credentials: " + err.Error())
+		}
+		utils.WritePropertiesToFile(encrypted, filePath)
+		return os.Chmod(filePath, 0600)
+	case RotationOutputK8s:
+		secret := rotationK8sSecret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Type:       "Opaque",
+			StringData: flattenRotatedAppCredentials(credentials),
+		}
+		secret.Metadata.Name = secretName
+		secret.Metadata.Namespace = namespace
+		content, err := yaml.Marshal(secret)
+		if err != nil {
+			return err
+		}
+		return writeRotationOutput(filePath, content)
+	}
+	return errors.New("invalid output " + output + ". Output should be one of " + RotationOutputJSON + ", " +
+		RotationOutputFile + " or " + RotationOutputK8s)
+}
+
+type rotationK8sSecret struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace,omitempty"`
+	} `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+// flattenRotatedAppCredentials returns the credentials as properties named
+// <application>_<key manager>_<key type>_<credential>, so that the keys of an application in different key managers
+// are kept apart
+func flattenRotatedAppCredentials(credentials []RotatedAppCredentials) map[string]string {
+	properties := make(map[string]string)
+	for _, credential := range credentials {
+		prefix := strings.Replace(credential.Application, " ", "_", -1)
+		if credential.KeyManager != "" {
+			prefix += "_" + strings.Replace(credential.KeyManager, " ", "_", -1)
+		}
+		prefix += "_" + strings.ToLower(credential.KeyType)
+		properties[prefix+"_consumer_key"] = credential.ConsumerKey
+		properties[prefix+"_consumer_secret"] = credential.ConsumerSecret
+		if credential.APIKey != "" {
+			properties[prefix+"_api_key"] = credential.APIKey
+		}
+	}
+	return properties
+}
+
+func writeRotationOutput(filePath string, content []byte) error {
+	if filePath == "" {
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+	return ioutil.WriteFile(filePath, content, 0600)
+}
+
+// GetAppKeyRotationAuditLogPath returns the default path of the audit trail of the rotations
+func GetAppKeyRotationAuditLogPath() string {
+	return filepath.Join(utils.ConfigDirPath, utils.AppKeyRotationAuditLogFileName)
+}
+
+// WriteAppKeyRotationAuditRecords appends the audit records to the audit trail as JSON lines
+// @param auditLogPath : Path of the audit trail
+// @param records : Audit records of the rotations
+// @return error
+func WriteAppKeyRotationAuditRecords(auditLogPath string, records []AppKeyRotationAuditRecord) error {
+	if err := os.MkdirAll(filepath.Dir(auditLogPath), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return writeAuditRecords(file, records)
+}
+
+func writeAuditRecords(writer io.Writer, records []AppKeyRotationAuditRecord) error {
+	encoder := json.NewEncoder(writer)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintAppKeyRotationSummary prints the rotations which failed and returns the number of them
+// @param records : Audit records of the rotations
+// @return number of failures
+func PrintAppKeyRotationSummary(records []AppKeyRotationAuditRecord) int {
+	failed, rotated := 0, 0
+	for _, record := range records {
+		if record.Status != "success" {
+			failed++
+			fmt.Fprintf(os.Stderr, "Failed to %s of %s %s: %s\n", strings.Replace(record.Action, "-", " ", -1),
+				record.Application, strings.ToLower(record.KeyType), record.Message)
+		} else if record.Action == "regenerate-consumer-secret" {
+			rotated++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Rotated %d key(s) with %d failure(s)\n", rotated, failed)
+	return failed
+}
@@ -0,0 +1,108 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+var rotatedTestCredentials = []RotatedAppCredentials{
+	{Application: "Sample App", ApplicationID: "1", KeyType: "PRODUCTION", ConsumerKey: "key", ConsumerSecret: "secret",
+		APIKey: "api-key"},
+	{Application: "Sample App", ApplicationID: "1", KeyType: "SANDBOX", ConsumerKey: "sandbox-key",
+		ConsumerSecret: "sandbox-secret"},
+}
+
+func TestWriteRotatedAppCredentialsAsJSON(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "credentials.json")
+	assert.Nil(t, WriteRotatedAppCredentials(rotatedTestCredentials, RotationOutputJSON, filePath, "", "", ""))
+	content, err := ioutil.ReadFile(filePath)
+	assert.Nil(t, err)
+	var credentials []RotatedAppCredentials
+	assert.Nil(t, json.Unmarshal(content, &credentials))
+	assert.Equal(t, rotatedTestCredentials, credentials)
+}
+
+func TestWriteRotatedAppCredentialsAsK8sSecret(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "secret.yaml")
+	assert.Nil(t, WriteRotatedAppCredentials(rotatedTestCredentials, RotationOutputK8s, filePath, "app-credentials",
+		"wso2", ""))
+	content, err := ioutil.ReadFile(filePath)
+	assert.Nil(t, err)
+	secret := rotationK8sSecret{}
+	assert.Nil(t, yaml.Unmarshal(content, &secret))
+	assert.Equal(t, "Secret", secret.Kind)
+	assert.Equal(t, "app-credentials", secret.Metadata.Name)
+	assert.Equal(t, "wso2", secret.Metadata.Namespace)
+	assert.Equal(t, map[string]string{
+		"Sample_App_production_consumer_key":    "key",
+		"Sample_App_production_consumer_secret": "secret",
+		"Sample_App_production_api_key":         "api-key",
+		"Sample_App_sandbox_consumer_key":       "sandbox-key",
+		"Sample_App_sandbox_consumer_secret":    "sandbox-secret",
+	}, secret.StringData)
+
+	assert.NotNil(t, WriteRotatedAppCredentials(rotatedTestCredentials, "vault", filePath, "", "", ""))
+	assert.NotNil(t, WriteRotatedAppCredentials(rotatedTestCredentials, RotationOutputFile, "", "", "", ""))
+}
+
+func TestFlattenRotatedAppCredentialsWithKeyManagers(t *testing.T) {
+	properties := flattenRotatedAppCredentials([]RotatedAppCredentials{
+		{Application: "Sample App", KeyType: "PRODUCTION", KeyManager: "Resident Key Manager", ConsumerKey: "key",
+			ConsumerSecret: "secret"},
+		{Application: "Sample App", KeyType: "PRODUCTION", KeyManager: "Okta", ConsumerKey: "okta-key",
+			ConsumerSecret: "okta-secret"},
+	})
+	assert.Equal(t, map[string]string{
+		"Sample_App_Resident_Key_Manager_production_consumer_key":    "key",
+		"Sample_App_Resident_Key_Manager_production_consumer_secret": "secret",
+		"Sample_App_Okta_production_consumer_key":                    "okta-key",
+		"Sample_App_Okta_production_consumer_secret":                 "okta-secret",
+	}, properties)
+}
+
+func TestWriteAuditRecordsOmitsSecrets(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.Nil(t, writeAuditRecords(&buffer, []AppKeyRotationAuditRecord{
+		{Application: "Sample App", KeyType: "PRODUCTION", ConsumerKey: "key", Action: "regenerate-consumer-secret",
+			Status: "success"},
+		{Application: "Sample App", KeyType: "SANDBOX", Action: "generate-api-key", Status: "failed",
+			Message: "Status: 403"},
+	}))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.NotContains(t, buffer.String(), "consumerSecret")
+	record := AppKeyRotationAuditRecord{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "failed", record.Status)
+}
+
+func TestGetApplicationsToRotateRejectsOtherOwners(t *testing.T) {
+	_, err := getApplicationsToRotate("token", "dev", "admin", AppKeyRotationOptions{Owner: "alice"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Log in as alice")
+}
@@ -0,0 +1,484 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	scopeIdHeader          = "ID"
+	scopeNameHeader        = "NAME"
+	scopeDisplayNameHeader = "DISPLAY NAME"
+	scopeRolesHeader       = "ROLES"
+	scopeUsageHeader       = "USAGE"
+
+	defaultScopeTableFormat = "table {{.Id}}\t{{.Name}}\t{{.DisplayName}}\t{{.Roles}}\t{{.Usage}}"
+
+	scopeUsageNameHeader     = "API NAME"
+	scopeUsageVersionHeader  = "VERSION"
+	scopeUsageProviderHeader = "PROVIDER"
+	scopeUsageContextHeader  = "CONTEXT"
+
+	defaultScopeUsageTableFormat = "table {{.Name}}\t{{.Version}}\t{{.Provider}}\t{{.Context}}"
+)
+
+// scopeFile is the content of an exported shared scope file
+type scopeFile struct {
+	Type    string      `json:"type" yaml:"type"`
+	Version string      `json:"version" yaml:"version"`
+	Data    utils.Scope `json:"data" yaml:"data"`
+}
+
+// sharedScope holds information about a shared scope for outputting
+type sharedScope struct {
+	scope utils.Scope
+}
+
+// Id of the scope
+func (s sharedScope) Id() string {
+	return s.scope.ID
+}
+
+// Name of the scope
+func (s sharedScope) Name() string {
+	return s.scope.Name
+}
+
+// DisplayName of the scope
+func (s sharedScope) DisplayName() string {
+	return s.scope.DisplayName
+}
+
+// Roles bound to the scope
+func (s sharedScope) Roles() string {
+	return strings.Join(s.scope.Bindings, ",")
+}
+
+// Usage of the scope (no. of APIs)
+func (s sharedScope) Usage() int {
+	return s.scope.UsageCount
+}
+
+// MarshalJSON marshals the scope using custom marshaller which uses methods instead of fields
+func (s *sharedScope) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(s)
+}
+
+// scopeUsageAPI holds information about an API a shared scope is used in for outputting
+type scopeUsageAPI struct {
+	api utils.ScopeUsageAPIInfo
+}
+
+// Name of the API
+func (a scopeUsageAPI) Name() string {
+	return a.api.Name
+}
+
+// Version of the API
+func (a scopeUsageAPI) Version() string {
+	return a.api.Version
+}
+
+// Provider of the API
+func (a scopeUsageAPI) Provider() string {
+	return a.api.Provider
+}
+
+// Context of the API
+func (a scopeUsageAPI) Context() string {
+	return a.api.Context
+}
+
+// MarshalJSON marshals the API using custom marshaller which uses methods instead of fields
+func (a *scopeUsageAPI) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(a)
+}
+
+// GetSharedScopes retrieves the shared scopes of an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to retrieve the shared scopes from
+// @return array of scopes, error
+func GetSharedScopes(accessToken, environment string) ([]utils.Scope, error) {
+	return getSharedScopesOfTenant(accessToken, environment, "")
+}
+
+// getSharedScopesOfTenant retrieves the shared scopes of a tenant. The scopes of the tenant of the user are retrieved
+// if the tenant domain is empty.
+func getSharedScopesOfTenant(accessToken, environment, tenantDomain string) ([]utils.Scope, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	endpoint := getSharedScopesEndpoint(environment) + "?limit=1000"
+	if tenantDomain != "" {
+		endpoint += "&tenantDomain=" + tenantDomain
+	}
+	resp, err := utils.InvokeGETRequest(endpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the shared scopes")
+	}
+	scopeList := &utils.ScopeListResponse{}
+	if err = json.Unmarshal(resp.Body(), scopeList); err != nil {
+		return nil, err
+	}
+	return scopeList.List, nil
+}
+
+// GetSharedScopeUsage retrieves the APIs a shared scope is used in
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the shared scope
+// @param name : Name of the shared scope
+// @return array of APIs, error
+func GetSharedScopeUsage(accessToken, environment, name string) ([]utils.ScopeUsageAPIInfo, error) {
+	scope, err := getSharedScopeByName(accessToken, environment, name)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(getSharedScopesEndpoint(environment)+"/"+scope.ID+"/usage", headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getPublisherResponseError(resp, "retrieving the usage of the shared scope "+name)
+	}
+	usage := &utils.ScopeUsage{}
+	if err = json.Unmarshal(resp.Body(), usage); err != nil {
+		return nil, err
+	}
+	return usage.UsedAPIList, nil
+}
+
+// DeleteSharedScope deletes a shared scope
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the shared scope
+// @param name : Name of the shared scope
+// @param checkUsage : Do not delete the scope if it is used in any API
+// @return APIs the scope is used in if it was not deleted due to the usage, error
+func DeleteSharedScope(accessToken, environment, name string, checkUsage bool) ([]utils.ScopeUsageAPIInfo, error) {
+	if checkUsage {
+		usage, err := GetSharedScopeUsage(accessToken, environment, name)
+		if err != nil {
+			return nil, err
+		}
+		if len(usage) > 0 {
+			return usage, fmt.Errorf("shared scope %s is used in %d API(s)", name, len(usage))
+		}
+	}
+	scope, err := getSharedScopeByName(accessToken, environment, name)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(getSharedScopesEndpoint(environment)+"/"+scope.ID, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+		return nil, getPublisherResponseError(resp, "deleting the shared scope "+name)
+	}
+	return nil, nil
+}
+
+// ExportSharedScopes writes the shared scopes of an environment to files
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to export the shared scopes from
+// @param tenantDomain : Tenant to export the shared scopes from. The tenant of the user is used if this is empty.
+// @param names : Names of the shared scopes to export. All the shared scopes are exported if this is empty.
+// @param exportDirectory : Directory to write the shared scope files to
+// @param exportFormat : File format of the shared scope files (YAML or JSON)
+// @return paths of the exported files, error
+func ExportSharedScopes(accessToken, environment, tenantDomain string, names []string, exportDirectory,
+	exportFormat string) ([]string, error) {
+	scopes, err := getSharedScopesOfTenant(accessToken, environment, tenantDomain)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > 0 {
+		scopes, err = selectSharedScopes(scopes, names)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = utils.CreateDirIfNotExist(exportDirectory); err != nil {
+		return nil, err
+	}
+	var paths []string
+	fileNames := make(map[string]bool)
+	for _, scope := range scopes {
+		// Scope names which differ only in the characters replaced in file names get numbered file names
+		fileName := sharedScopeFileName(scope.Name)
+		for i := 2; fileNames[strings.ToLower(fileName)]; i++ {
+			fileName = sharedScopeFileName(scope.Name) + "-" + strconv.Itoa(i)
+		}
+		fileNames[strings.ToLower(fileName)] = true
+		path, err := writeSharedScopeFile(exportDirectory, fileName, scope, exportFormat)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// ImportSharedScopes creates or updates the shared scopes from a shared scope file or a directory of them
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to import the shared scopes to
+// @param path : Shared scope file or directory
+// @param update : Update the shared scopes that already exist
+// @return names of the imported shared scopes, error
+func ImportSharedScopes(accessToken, environment, path string, update bool) ([]string, error) {
+	scopes, err := readSharedScopeFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	existingScopes, err := GetSharedScopes(accessToken, environment)
+	if err != nil {
+		return nil, err
+	}
+	existingIds := make(map[string]string)
+	for _, scope := range existingScopes {
+		existingIds[scope.Name] = scope.ID
+	}
+
+	headers := make(map[string]string)
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	endpoint := getSharedScopesEndpoint(environment)
+	var imported []string
+	for _, scope := range scopes {
+		body, err := json.Marshal(scope)
+		if err != nil {
+			return imported, err
+		}
+		if id, exists := existingIds[scope.Name]; exists {
+			if !update {
+				return imported, errors.New("shared scope " + scope.Name + " already exists. Use --update to update it")
+			}
+			resp, err := utils.InvokePUTRequestWithoutQueryParams(endpoint+"/"+id, headers, string(body))
+			if err != nil {
+				return imported, err
+			}
+			if resp.StatusCode() != http.StatusOK {
+				return imported, getPublisherResponseError(resp, "updating the shared scope "+scope.Name)
+			}
+		} else {
+			resp, err := utils.InvokePOSTRequest(endpoint, headers, string(body))
+			if err != nil {
+				return imported, err
+			}
+			if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+				return imported, getPublisherResponseError(resp, "creating the shared scope "+scope.Name)
+			}
+		}
+		imported = append(imported, scope.Name)
+	}
+	return imported, nil
+}
+
+func getSharedScopesEndpoint(environment string) string {
+	return utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath) + "/scopes"
+}
+
+func getSharedScopeByName(accessToken, environment, name string) (*utils.Scope, error) {
+	scopes, err := GetSharedScopes(accessToken, environment)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectSharedScopes(scopes, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	return &selected[0], nil
+}
+
+// selectSharedScopes returns the shared scopes with the given names
+func selectSharedScopes(scopes []utils.Scope, names []string) ([]utils.Scope, error) {
+	var selected []utils.Scope
+	for _, name := range names {
+		found := false
+		for _, scope := range scopes {
+			if scope.Name == name {
+				selected = append(selected, scope)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("shared scope " + name + " not found")
+		}
+	}
+	return selected, nil
+}
+
+// sharedScopeFileName returns the name of the file of a shared scope. The characters of the scope name which are not
+// allowed in file names (such as "/" and ":" which are common in scope names) are replaced with "_".
+func sharedScopeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' ||
+			r == '.' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// writeSharedScopeFile writes a shared scope to <fileName>.yaml or <fileName>.json in a directory. The name of the
+// scope is kept as it is in the file.
+func writeSharedScopeFile(directory, fileName string, scope utils.Scope, exportFormat string) (string, error) {
+	scope.ID = ""
+	scope.UsageCount = 0
+	sort.Strings(scope.Bindings)
+	file := scopeFile{Type: utils.SchemaTypeScope, Version: utils.MigrateProjectLatestSchemaVersion, Data: scope}
+	var content []byte
+	var err error
+	path := filepath.Join(directory, fileName)
+	if strings.EqualFold(exportFormat, "json") {
+		path += ".json"
+		content, err = json.MarshalIndent(file, "", "  ")
+	} else {
+		path += ".yaml"
+		content, err = yaml.Marshal(file)
+	}
+	if err != nil {
+		return "", err
+	}
+	return path, ioutil.WriteFile(path, content, os.ModePerm)
+}
+
+// readSharedScopeFiles reads a shared scope file or the shared scope files of a directory
+func readSharedScopeFiles(path string) ([]utils.Scope, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{path}
+	if info.IsDir() {
+		paths = nil
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			extension := strings.ToLower(filepath.Ext(entry.Name()))
+			if !entry.IsDir() && (extension == ".yaml" || extension == ".yml" || extension == ".json") {
+				paths = append(paths, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	var scopes []utils.Scope
+	for _, scopePath := range paths {
+		content, err := ioutil.ReadFile(scopePath)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(filepath.Ext(scopePath), ".json") {
+			if content, err = utils.YamlToJson(content); err != nil {
+				return nil, errors.New("error reading " + scopePath + ": " + err.Error())
+			}
+		}
+		file := &scopeFile{}
+		if err = json.Unmarshal(content, file); err != nil {
+			return nil, errors.New("error reading " + scopePath + ": " + err.Error())
+		}
+		if file.Type != utils.SchemaTypeScope || file.Data.Name == "" {
+			return nil, errors.New(scopePath + " is not a shared scope file")
+		}
+		if file.Data.Bindings == nil {
+			file.Data.Bindings = []string{}
+		}
+		scopes = append(scopes, file.Data)
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("no shared scope files were found in " + path)
+	}
+	return scopes, nil
+}
+
+// PrintSharedScopes prints the shared scopes in the given format
+// @param scopes : Shared scopes
+// @param format : Format type of the output
+func PrintSharedScopes(scopes []utils.Scope, format string) {
+	if format == "" {
+		format = defaultScopeTableFormat
+	}
+	scopeContext := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, scope := range scopes {
+			if err := t.Execute(w, &sharedScope{scope}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	scopeTableHeaders := map[string]string{
+		"Id":          scopeIdHeader,
+		"Name":        scopeNameHeader,
+		"DisplayName": scopeDisplayNameHeader,
+		"Roles":       scopeRolesHeader,
+		"Usage":       scopeUsageHeader,
+	}
+	if err := scopeContext.Write(renderer, scopeTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// PrintSharedScopeUsage prints the APIs a shared scope is used in
+// @param apis : APIs the shared scope is used in
+func PrintSharedScopeUsage(apis []utils.ScopeUsageAPIInfo) {
+	usageContext := formatter.NewContext(os.Stdout, defaultScopeUsageTableFormat)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, api := range apis {
+			if err := t.Execute(w, &scopeUsageAPI{api}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	usageTableHeaders := map[string]string{
+		"Name":     scopeUsageNameHeader,
+		"Version":  scopeUsageVersionHeader,
+		"Provider": scopeUsageProviderHeader,
+		"Context":  scopeUsageContextHeader,
+	}
+	if err := usageContext.Write(renderer, usageTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,87 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestSharedScopeFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	scope := utils.Scope{ID: "123", Name: "read_orders", DisplayName: "Read Orders", Description: "Read the orders",
+		Bindings: []string{"subscriber", "admin"}, UsageCount: 3}
+
+	yamlPath, err := writeSharedScopeFile(dir, sharedScopeFileName(scope.Name), scope, "YAML")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "read_orders.yaml"), yamlPath)
+	jsonScope := scope
+	jsonScope.Name = "write_orders"
+	jsonPath, err := writeSharedScopeFile(dir, sharedScopeFileName(jsonScope.Name), jsonScope, "JSON")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "write_orders.json"), jsonPath)
+
+	scopes, err := readSharedScopeFiles(yamlPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.Scope{{Name: "read_orders", DisplayName: "Read Orders", Description: "Read the orders",
+		Bindings: []string{"admin", "subscriber"}}}, scopes)
+
+	scopes, err = readSharedScopeFiles(dir)
+	assert.Nil(t, err)
+	assert.Len(t, scopes, 2)
+	assert.Equal(t, "write_orders", scopes[1].Name)
+}
+
+func TestSharedScopeFileName(t *testing.T) {
+	dir := t.TempDir()
+	scope := utils.Scope{Name: "orders:read/all", Bindings: []string{"admin"}}
+	fileName := sharedScopeFileName(scope.Name)
+	assert.Equal(t, "orders_read_all", fileName)
+
+	path, err := writeSharedScopeFile(dir, fileName, scope, "YAML")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "orders_read_all.yaml"), path)
+	scopes, err := readSharedScopeFiles(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "orders:read/all", scopes[0].Name)
+}
+
+func TestReadSharedScopeFilesRejectsOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "api.yaml"), "type: api\nversion: v4.0.0\ndata:\n  name: PizzaShackAPI\n")
+	_, err := readSharedScopeFiles(dir)
+	assert.NotNil(t, err)
+
+	emptyDir := t.TempDir()
+	_, err = readSharedScopeFiles(emptyDir)
+	assert.NotNil(t, err)
+}
+
+func TestSelectSharedScopes(t *testing.T) {
+	scopes := []utils.Scope{{ID: "1", Name: "read_orders"}, {ID: "2", Name: "write_orders"}}
+	selected, err := selectSharedScopes(scopes, []string{"write_orders"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", selected[0].ID)
+
+	_, err = selectSharedScopes(scopes, []string{"delete_orders"})
+	assert.NotNil(t, err)
+}
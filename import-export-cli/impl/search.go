@@ -0,0 +1,260 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	searchTypeHeader    = "TYPE"
+	searchNameHeader    = "NAME"
+	searchVersionHeader = "VERSION"
+	searchContextHeader = "CONTEXT"
+	searchMatchHeader   = "MATCH"
+
+	defaultSearchTableFormat = "table {{.Type}}\t{{.Name}}\t{{.Version}}\t{{.Context}}\t{{.Match}}"
+)
+
+// Types of the results of the unified search
+const (
+	searchResultTypeDocument   = "DOC"
+	searchResultTypeDefinition = "DEFINITION"
+)
+
+// No. of results fetched from the server at once
+const searchPageSize = 100
+
+// SearchPrefixes are the prefixes supported by the unified search of the publisher
+var SearchPrefixes = []string{"name", "context", "version", "provider", "status", "tags", "description", "content",
+	"doc"}
+
+var searchPrefixRegex = regexp.MustCompile(`^([A-Za-z-]+):`)
+
+// searchResult holds a result of the unified search for outputting
+type searchResult struct {
+	result utils.SearchResult
+	match  string
+}
+
+// Id of the matching artifact
+func (r searchResult) Id() string {
+	return r.result.ID
+}
+
+// Type of the matching artifact
+func (r searchResult) Type() string {
+	return r.result.Type
+}
+
+// Name of the API or API Product. For documents and definitions, the name of the API they belong to.
+func (r searchResult) Name() string {
+	if r.isAPIResource() {
+		return r.result.APIName
+	}
+	return r.result.Name
+}
+
+// Version of the API or API Product
+func (r searchResult) Version() string {
+	if r.isAPIResource() {
+		return r.result.APIVersion
+	}
+	return r.result.Version
+}
+
+// Context of the API or API Product
+func (r searchResult) Context() string {
+	if r.isAPIResource() {
+		return r.result.APIContext
+	}
+	return r.result.Context
+}
+
+// Provider of the API or API Product
+func (r searchResult) Provider() string {
+	if r.isAPIResource() {
+		return r.result.APIProvider
+	}
+	return r.result.Provider
+}
+
+// Status of the API or API Product
+func (r searchResult) Status() string {
+	return r.result.Status
+}
+
+// Match is the source the query matched (name, context, content, a document or the API definition)
+func (r searchResult) Match() string {
+	return r.match
+}
+
+// MarshalJSON marshals the result using custom marshaller which uses methods instead of fields
+func (r *searchResult) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+func (r searchResult) isAPIResource() bool {
+	return r.result.Type == searchResultTypeDocument || r.result.Type == searchResultTypeDefinition
+}
+
+// ValidateSearchQuery checks that the prefixes of a unified search query are supported
+// @param query : Search query
+// @return error
+func ValidateSearchQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return errors.New("search query is empty")
+	}
+	for _, term := range strings.Fields(query) {
+		prefix := searchTermPrefix(term)
+		if prefix != "" && !containsString(SearchPrefixes, strings.ToLower(prefix)) {
+			return errors.New("unsupported search prefix " + prefix + ": (supported prefixes are " +
+				strings.Join(SearchPrefixes, ":, ") + ":)")
+		}
+	}
+	return nil
+}
+
+// searchTermPrefix returns the prefix of a search term, ignoring the scheme of URLs
+func searchTermPrefix(term string) string {
+	match := searchPrefixRegex.FindStringSubmatch(term)
+	if match == nil || strings.HasPrefix(term[len(match[0]):], "//") {
+		return ""
+	}
+	return match[1]
+}
+
+// SearchFromEnv searches the APIs, API Products, documents and API definitions of an environment, fetching all the
+// pages of results
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to search in
+// @param query : Search query
+// @param limit : Maximum no. of results to return. All the results are returned if zero.
+// @return results, error
+func SearchFromEnv(accessToken, environment, query string, limit int) ([]utils.SearchResult, error) {
+	searchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	fetch := func(offset, pageSize int) (*utils.SearchResultList, error) {
+		queryParams := map[string]string{
+			"query":  query,
+			"offset": strconv.Itoa(offset),
+			"limit":  strconv.Itoa(pageSize),
+		}
+		resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, searchEndpoint, headers)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, getPublisherResponseError(resp, "searching "+query)
+		}
+		page := &utils.SearchResultList{}
+		if err = json.Unmarshal(resp.Body(), page); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}
+	return searchAllPages(fetch, limit)
+}
+
+// searchAllPages fetches the pages of search results until all the results or the limit is reached
+func searchAllPages(fetch func(offset, pageSize int) (*utils.SearchResultList, error),
+	limit int) ([]utils.SearchResult, error) {
+	var results []utils.SearchResult
+	for {
+		pageSize := searchPageSize
+		if limit > 0 && limit-len(results) < pageSize {
+			pageSize = limit - len(results)
+		}
+		page, err := fetch(len(results), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page.List...)
+		if len(page.List) == 0 || (limit > 0 && len(results) >= limit) ||
+			(page.Pagination.Total > 0 && len(results) >= page.Pagination.Total) {
+			return results, nil
+		}
+	}
+}
+
+// searchMatchSource describes what a result matched in a query
+func searchMatchSource(result utils.SearchResult, query string) string {
+	switch result.Type {
+	case searchResultTypeDocument:
+		return "document " + result.Name
+	case searchResultTypeDefinition:
+		return "definition " + result.Name
+	}
+	var prefixes []string
+	for _, term := range strings.Fields(query) {
+		if prefix := strings.ToLower(searchTermPrefix(term)); prefix != "" && !containsString(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		return "content"
+	}
+	return strings.Join(prefixes, ",")
+}
+
+// PrintSearchResults prints the results of a unified search
+// @param results : Search results
+// @param query : Search query, used to describe the matches
+// @param format : Go template to format the results with
+func PrintSearchResults(results []utils.SearchResult, query, format string) {
+	if format == "" {
+		format = defaultSearchTableFormat
+	}
+	context := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, result := range results {
+			if err := t.Execute(w, &searchResult{result, searchMatchSource(result, query)}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	headers := map[string]string{
+		"Id":       apiIdHeader,
+		"Type":     searchTypeHeader,
+		"Name":     searchNameHeader,
+		"Version":  searchVersionHeader,
+		"Context":  searchContextHeader,
+		"Provider": apiProviderHeader,
+		"Status":   apiStatusHeader,
+		"Match":    searchMatchHeader,
+	}
+	if err := context.Write(renderer, headers); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
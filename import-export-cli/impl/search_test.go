@@ -0,0 +1,101 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func fakeSearchPages(total int, calls *[]string) func(offset, pageSize int) (*utils.SearchResultList, error) {
+	return func(offset, pageSize int) (*utils.SearchResultList, error) {
+		*calls = append(*calls, strconv.Itoa(offset)+"/"+strconv.Itoa(pageSize))
+		page := &utils.SearchResultList{}
+		for i := offset; i < total && i < offset+pageSize; i++ {
+			page.List = append(page.List, utils.SearchResult{Name: "API" + strconv.Itoa(i), Type: "API"})
+		}
+		page.Count = len(page.List)
+		page.Pagination.Total = total
+		return page, nil
+	}
+}
+
+func TestSearchAllPages(t *testing.T) {
+	var calls []string
+	results, err := searchAllPages(fakeSearchPages(250, &calls), 0)
+	assert.Nil(t, err)
+	assert.Len(t, results, 250)
+	assert.Equal(t, "API249", results[249].Name)
+	assert.Equal(t, []string{"0/100", "100/100", "200/100"}, calls)
+}
+
+func TestSearchAllPagesWithLimit(t *testing.T) {
+	var calls []string
+	results, err := searchAllPages(fakeSearchPages(250, &calls), 120)
+	assert.Nil(t, err)
+	assert.Len(t, results, 120)
+	assert.Equal(t, []string{"0/100", "100/20"}, calls)
+
+	calls = nil
+	results, err = searchAllPages(fakeSearchPages(0, &calls), 0)
+	assert.Nil(t, err)
+	assert.Len(t, results, 0)
+	assert.Equal(t, []string{"0/100"}, calls)
+}
+
+func TestSearchAllPagesError(t *testing.T) {
+	_, err := searchAllPages(func(offset, pageSize int) (*utils.SearchResultList, error) {
+		return nil, errors.New("authorization failed")
+	}, 0)
+	assert.NotNil(t, err)
+}
+
+func TestValidateSearchQuery(t *testing.T) {
+	assert.Nil(t, ValidateSearchQuery("context:/orders"))
+	assert.Nil(t, ValidateSearchQuery("name:Pizza provider:admin"))
+	assert.Nil(t, ValidateSearchQuery("https://orders.example.com"))
+	assert.Nil(t, ValidateSearchQuery("orders"))
+	assert.NotNil(t, ValidateSearchQuery(" "))
+	err := ValidateSearchQuery("owner:admin")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unsupported search prefix owner:")
+}
+
+func TestSearchResult(t *testing.T) {
+	api := utils.SearchResult{Name: "OrdersAPI", Type: "API", Version: "1.0.0", Context: "/orders"}
+	assert.Equal(t, "context", searchMatchSource(api, "context:/orders"))
+	assert.Equal(t, "name,provider", searchMatchSource(api, "name:Orders provider:admin name:Pizza"))
+	assert.Equal(t, "content", searchMatchSource(api, "orders"))
+
+	doc := utils.SearchResult{Name: "Refunds", Type: searchResultTypeDocument, APIName: "OrdersAPI",
+		APIVersion: "1.0.0", APIContext: "/orders"}
+	assert.Equal(t, "document Refunds", searchMatchSource(doc, "doc:refund"))
+	row := searchResult{doc, "document Refunds"}
+	assert.Equal(t, "OrdersAPI", row.Name())
+	assert.Equal(t, "1.0.0", row.Version())
+	assert.Equal(t, "/orders", row.Context())
+
+	row = searchResult{api, "context"}
+	assert.Equal(t, "OrdersAPI", row.Name())
+	assert.Equal(t, "/orders", row.Context())
+}
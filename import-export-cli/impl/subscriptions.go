@@ -0,0 +1,259 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	subscriptionIdHeader       = "ID"
+	subscriptionAPINameHeader  = "API NAME"
+	subscriptionVersionHeader  = "VERSION"
+	subscriptionProviderHeader = "PROVIDER"
+	subscriptionTierHeader     = "TIER"
+	subscriptionStatusHeader   = "STATUS"
+
+	defaultSubscriptionTableFormat = "table {{.Id}}\t{{.APIName}}\t{{.Version}}\t{{.Provider}}\t{{.Tier}}\t{{.Status}}"
+)
+
+// subscription contains information about utils.Subscription
+type subscription struct {
+	id       string
+	apiName  string
+	version  string
+	provider string
+	tier     string
+	status   string
+}
+
+// creates a new subscription definition from utils.Subscription
+func newSubscriptionDefinitionFromSubscription(s utils.Subscription) *subscription {
+	return &subscription{s.SubscriptionID, s.APIInfo.Name, s.APIInfo.Version, s.APIInfo.Provider,
+		s.ThrottlingPolicy, s.Status}
+}
+
+// Id of subscription
+func (s subscription) Id() string {
+	return s.id
+}
+
+// APIName of subscription
+func (s subscription) APIName() string {
+	return s.apiName
+}
+
+// Version of the API of subscription
+func (s subscription) Version() string {
+	return s.version
+}
+
+// Provider of the API of subscription
+func (s subscription) Provider() string {
+	return s.provider
+}
+
+// Tier of subscription
+func (s subscription) Tier() string {
+	return s.tier
+}
+
+// Status of subscription
+func (s subscription) Status() string {
+	return s.status
+}
+
+// MarshalJSON marshals subscription using custom marshaller which uses methods instead of fields
+func (s *subscription) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(s)
+}
+
+// AddSubscription subscribes an application to an API or API Product
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment of the application and the API
+// @param appName : Name of the application
+// @param name : Name of the API or API Product
+// @param version : Version of the API or API Product
+// @param provider : Provider of the API or API Product
+// @param tier : Subscription throttling tier. The first tier of the API is used if this is empty.
+// @return subscriptionId, error
+func AddSubscription(accessToken, environment, appName, name, version, provider, tier string) (string, error) {
+	keyGenEnv = environment
+	apiName = name
+	apiVersion = version
+	apiProvider = provider
+	appId, err := getApplicationIdByName(appName, accessToken)
+	if err != nil {
+		return "", err
+	}
+	apiId, err := searchApiOrProduct(accessToken)
+	if err != nil {
+		return "", err
+	}
+	api, err := getApiOrProduct(apiId, accessToken)
+	if err != nil {
+		return "", err
+	}
+	tier, err = selectThrottlingPolicy(api.Policies, tier, "subscription tier of "+name)
+	if err != nil {
+		return "", err
+	}
+	utils.Logln(utils.LogPrefixInfo+"Subscribing to", name, version, "with the tier", tier)
+	return subscribeApiOrProduct(apiId, appId, tier, accessToken)
+}
+
+// GetSubscriptions retrieves the subscriptions of an application
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @return array of Subscription objects
+// @return error
+func GetSubscriptions(accessToken, environment, appName string) ([]utils.Subscription, error) {
+	keyGenEnv = environment
+	appId, err := getApplicationIdByName(appName, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	queryParams := map[string]string{"applicationId": appId, "limit": "1000"}
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, getSubscriptionsEndpoint(), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		subscriptionList := &utils.SubscriptionList{}
+		err = json.Unmarshal(resp.Body(), subscriptionList)
+		return subscriptionList.List, err
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return nil, fmt.Errorf("authorization failed while retrieving the subscriptions of application: " + appName)
+	}
+	return nil, errors.New("Request didn't respond 200 OK for retrieving subscriptions. Status: " + resp.Status())
+}
+
+// DeleteSubscription removes the subscription of an application to an API or API Product
+// @param accessToken : Access token to call the devportal REST API
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param name : Name of the API or API Product
+// @param version : Version of the API or API Product
+// @param provider : Provider of the API or API Product. Any provider is matched if this is empty.
+// @return subscriptionId, error
+func DeleteSubscription(accessToken, environment, appName, name, version, provider string) (string, error) {
+	subscriptions, err := GetSubscriptions(accessToken, environment, appName)
+	if err != nil {
+		return "", err
+	}
+	subscriptionId, err := findSubscription(subscriptions, name, version, provider)
+	if err != nil {
+		return "", err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(getSubscriptionsEndpoint()+"/"+subscriptionId, headers)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusNoContent {
+		return subscriptionId, nil
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return "", fmt.Errorf("authorization failed while deleting the subscription: " + subscriptionId)
+	}
+	return "", errors.New("Request didn't respond 200 OK for deleting the subscription. Status: " + resp.Status())
+}
+
+// findSubscription returns the ID of the subscription to the API or API Product with the given name and version
+func findSubscription(subscriptions []utils.Subscription, name, version, provider string) (string, error) {
+	var matches []string
+	for _, sub := range subscriptions {
+		if sub.APIInfo.Name == name && sub.APIInfo.Version == version &&
+			(provider == "" || sub.APIInfo.Provider == provider) {
+			matches = append(matches, sub.SubscriptionID)
+		}
+	}
+	if len(matches) == 0 {
+		return "", errors.New("cannot find a subscription to " + name + " " + version)
+	}
+	if len(matches) > 1 {
+		return "", errors.New("more than one subscription to " + name + " " + version +
+			" found. Specify the provider to pick one")
+	}
+	return matches[0], nil
+}
+
+// getSubscriptionsEndpoint returns the subscriptions endpoint of the devportal REST API of the environment
+func getSubscriptionsEndpoint() string {
+	subEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
+	return strings.Replace(subEndpoint, "applications", "subscriptions", -1)
+}
+
+// PrintSubscriptions prints the subscriptions of an application
+func PrintSubscriptions(subscriptions []utils.Subscription, format string) {
+	if format == "" {
+		format = defaultSubscriptionTableFormat
+	}
+
+	// create new subscription context with standard output
+	subscriptionContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection of subscriptions
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, s := range subscriptions {
+			if err := t.Execute(w, newSubscriptionDefinitionFromSubscription(s)); err != nil {
+				return err
+			}
+			// write a new line after executing template
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	subscriptionTableHeaders := map[string]string{
+		"Id":       subscriptionIdHeader,
+		"APIName":  subscriptionAPINameHeader,
+		"Version":  subscriptionVersionHeader,
+		"Provider": subscriptionProviderHeader,
+		"Tier":     subscriptionTierHeader,
+		"Status":   subscriptionStatusHeader,
+	}
+
+	// execute context
+	if err := subscriptionContext.Write(renderer, subscriptionTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,533 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ContractTestTarget is an API and the gateway URL its operations are tested against
+type ContractTestTarget struct {
+	Name                string
+	Version             string
+	GatewayURL          string
+	AuthorizationHeader string
+	AccessToken         string
+	Definition          map[string]interface{}
+	Operations          []interface{}
+}
+
+// ContractTestCase is the result of invoking an operation of the API
+type ContractTestCase struct {
+	Method   string
+	Path     string
+	Status   int
+	Duration time.Duration
+	Failures []string
+	Err      error
+}
+
+// Passed returns whether the operation responded as documented in the definition
+func (testCase *ContractTestCase) Passed() bool {
+	return testCase.Err == nil && len(testCase.Failures) == 0
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// LoadLocalContractTestTarget reads the API to be tested from an API project or an OpenAPI definition file
+// @param definitionPath : Path to an API project directory or archive, or to an OpenAPI definition file
+// @param gatewayURL : URL of the gateway or a stand-in for it. The context and version of the API are appended when
+// the definition is an API project.
+// @return ContractTestTarget
+// @return error
+func LoadLocalContractTestTarget(definitionPath, gatewayURL string) (*ContractTestTarget, error) {
+	info, err := os.Stat(definitionPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() && !strings.EqualFold(filepath.Ext(definitionPath), ".zip") {
+		definition, err := readOpenAPIDefinition(strings.TrimSuffix(definitionPath, filepath.Ext(definitionPath)))
+		if err != nil {
+			return nil, err
+		}
+		target := &ContractTestTarget{GatewayURL: strings.TrimSuffix(gatewayURL, "/"), Definition: definition}
+		if apiInfo, ok := definition["info"].(map[string]interface{}); ok {
+			target.Name, _ = apiInfo["title"].(string)
+			target.Version, _ = apiInfo["version"].(string)
+		}
+		return target, nil
+	}
+
+	projectPath, cleanup, err := openAPIProject(definitionPath)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	summary, err := readAPIProjectSummary(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	definition, err := readOpenAPIDefinition(filepath.Join(projectPath, utils.InitProjectDefinitions, "swagger"))
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTestTarget{
+		Name:    summary.Data.Name,
+		Version: summary.Data.Version,
+		GatewayURL: strings.TrimSuffix(gatewayURL, "/") + getMockBasePath(summary.Data.Context,
+			summary.Data.Version),
+		AuthorizationHeader: summary.Data.AuthorizationHeader,
+		Definition:          definition,
+		Operations:          summary.Data.Operations,
+	}, nil
+}
+
+// openAPIProject returns the directory of an API project. Archives are extracted to a temporary directory which is
+// removed by the returned cleanup function.
+func openAPIProject(projectPath string) (string, func(), error) {
+	info, err := os.Stat(projectPath)
+	if err != nil {
+		return "", nil, err
+	}
+	if info.IsDir() {
+		return projectPath, func() {}, nil
+	}
+	clone, err := utils.GetTempCloneFromDirOrZip(projectPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return clone, func() { _ = os.RemoveAll(filepath.Dir(clone)) }, nil
+}
+
+// readAPIProjectSummary reads the fields of the API definition of a project directory
+func readAPIProjectSummary(projectPath string) (*mockAPIDefinition, error) {
+	_, content, err := resolveYamlOrJSON(filepath.Join(projectPath, "api"))
+	if err != nil {
+		return nil, errors.New(projectPath + " is not an API project: " + err.Error())
+	}
+	definition := &mockAPIDefinition{}
+	if err = json.Unmarshal(content, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// readOpenAPIDefinition reads a YAML or JSON OpenAPI definition given the path without the extension
+func readOpenAPIDefinition(filename string) (map[string]interface{}, error) {
+	_, content, err := resolveYamlOrJSON(filename)
+	if err != nil {
+		return nil, err
+	}
+	openAPI := map[string]interface{}{}
+	if err = json.Unmarshal(content, &openAPI); err != nil {
+		return nil, err
+	}
+	return openAPI, nil
+}
+
+// GetContractTestTarget retrieves the definition and the gateway URL of an API deployed in an environment
+// @param accessToken : Access token to call the Publisher and DevPortal REST APIs
+// @param environment : Environment of the API
+// @param name : Name of the API
+// @param version : Version of the API
+// @param provider : Provider of the API
+// @param gatewayURL : URL of a gateway to override the one the API is deployed in. The context and version of the
+// API are appended.
+// @param gatewayEnvironment : Gateway environment to pick the URL of the API from when the API is deployed in many
+// @return ContractTestTarget
+// @return error
+func GetContractTestTarget(accessToken, environment, name, version, provider, gatewayURL,
+	gatewayEnvironment string) (*ContractTestTarget, error) {
+	apiId, err := GetAPIId(accessToken, environment, name, version, provider)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	apiEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath) + "/" + apiId
+
+	summary := &mockAPIDefinition{}
+	if err = getContractTestResource(apiEndpoint, headers, "retrieving the API "+name, &summary.Data); err != nil {
+		return nil, err
+	}
+	definition := map[string]interface{}{}
+	if err = getContractTestResource(apiEndpoint+"/swagger", headers, "retrieving the definition of the API "+name,
+		&definition); err != nil {
+		return nil, err
+	}
+
+	target := &ContractTestTarget{
+		Name:                summary.Data.Name,
+		Version:             summary.Data.Version,
+		AuthorizationHeader: summary.Data.AuthorizationHeader,
+		Definition:          definition,
+		Operations:          summary.Data.Operations,
+	}
+	if gatewayURL != "" {
+		target.GatewayURL = strings.TrimSuffix(gatewayURL, "/") + getMockBasePath(summary.Data.Context,
+			summary.Data.Version)
+		return target, nil
+	}
+
+	devPortalAPIEndpoint := strings.Replace(utils.GetDevPortalApplicationListEndpointOfEnv(environment,
+		utils.MainConfigFilePath), "applications", "apis", 1) + "/" + apiId
+	devPortalAPI := &struct {
+		EndpointURLs []struct {
+			EnvironmentName string            `json:"environmentName"`
+			URLs            map[string]string `json:"URLs"`
+		} `json:"endpointURLs"`
+	}{}
+	if err = getContractTestResource(devPortalAPIEndpoint, headers, "retrieving the gateway URLs of the API "+name,
+		devPortalAPI); err != nil {
+		return nil, err
+	}
+	for _, endpointURL := range devPortalAPI.EndpointURLs {
+		if gatewayEnvironment != "" && endpointURL.EnvironmentName != gatewayEnvironment {
+			continue
+		}
+		for _, transport := range []string{"https", "http"} {
+			if endpointURL.URLs[transport] != "" {
+				target.GatewayURL = strings.TrimSuffix(endpointURL.URLs[transport], "/")
+				return target, nil
+			}
+		}
+	}
+	if gatewayEnvironment != "" {
+		return nil, errors.New("the API " + name + " is not deployed in the gateway environment " + gatewayEnvironment)
+	}
+	return nil, errors.New("the API " + name + " is not deployed in any gateway environment")
+}
+
+// getContractTestResource retrieves a JSON resource from the REST APIs of API Manager
+func getContractTestResource(endpoint string, headers map[string]string, action string, resource interface{}) error {
+	resp, err := utils.InvokeGETRequest(endpoint, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		return json.Unmarshal(resp.Body(), resource)
+	}
+	return getPublisherResponseError(resp, action)
+}
+
+// RunContractTests invokes each operation of the API with a request generated from the definition and validates
+// the status code and the body of the response against the definition
+// @param target : API to be tested
+// @return results of the operations
+func RunContractTests(target *ContractTestTarget) []*ContractTestCase {
+	paths, _ := target.Definition["paths"].(map[string]interface{})
+	var pathNames []string
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	var testCases []*ContractTestCase
+	for _, path := range pathNames {
+		pathItem, _ := resolveMockRef(target.Definition, paths[path]).(map[string]interface{})
+		var methods []string
+		for method := range pathItem {
+			if isHTTPMethod(method) {
+				methods = append(methods, method)
+			}
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			operation, _ := pathItem[method].(map[string]interface{})
+			testCase := &ContractTestCase{Method: strings.ToUpper(method), Path: path}
+			start := time.Now()
+			testCase.Err = target.runContractTest(testCase, pathItem, operation)
+			testCase.Duration = time.Since(start)
+			testCases = append(testCases, testCase)
+		}
+	}
+	return testCases
+}
+
+// runContractTest invokes an operation and adds the mismatches with the definition as failures of the test case
+func (target *ContractTestTarget) runContractTest(testCase *ContractTestCase, pathItem,
+	operation map[string]interface{}) error {
+	requestPath := testCase.Path
+	headers := map[string]string{utils.HeaderAccept: utils.HeaderValueApplicationJSON}
+	queryParams := map[string]string{}
+	form := url.Values{}
+	body := ""
+
+	parameters := append(toSlice(pathItem["parameters"]), toSlice(operation["parameters"])...)
+	for _, parameterValue := range parameters {
+		parameter, _ := resolveMockRef(target.Definition, parameterValue).(map[string]interface{})
+		name, _ := parameter["name"].(string)
+		location, _ := parameter["in"].(string)
+		required := parameter["required"] == true
+		if location == "body" {
+			content, _ := json.Marshal(generateMockValue(target.Definition, parameter["schema"], 0))
+			body = string(content)
+			headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+			continue
+		}
+		if location != "path" && !required {
+			continue
+		}
+		value := generateParameterValue(target.Definition, parameter)
+		switch location {
+		case "path":
+			requestPath = strings.Replace(requestPath, "{"+name+"}", url.PathEscape(value), -1)
+		case "query":
+			queryParams[name] = value
+		case "header":
+			headers[name] = value
+		case "formData":
+			form.Set(name, value)
+		}
+	}
+	if len(form) > 0 {
+		body = form.Encode()
+		headers[utils.HeaderContentType] = "application/x-www-form-urlencoded"
+	}
+	if requestBody, ok := resolveMockRef(target.Definition, operation["requestBody"]).(map[string]interface{}); ok {
+		content, _ := requestBody["content"].(map[string]interface{})
+		if mediaType := pickMockMediaType(content); mediaType != "" {
+			media, _ := content[mediaType].(map[string]interface{})
+			value, ok := media["example"]
+			if !ok {
+				value = generateMockValue(target.Definition, media["schema"], 0)
+			}
+			if text, isText := value.(string); isText && !strings.Contains(mediaType, "json") {
+				body = text
+			} else {
+				encoded, _ := json.Marshal(value)
+				body = string(encoded)
+			}
+			headers[utils.HeaderContentType] = mediaType
+		}
+	}
+
+	if target.AccessToken != "" && isMockOperationSecured(operation, testCase.Path, testCase.Method, target.Operations) {
+		authorizationHeader := target.AuthorizationHeader
+		if authorizationHeader == "" {
+			authorizationHeader = utils.HeaderAuthorization
+		}
+		headers[authorizationHeader] = utils.HeaderValueAuthBearerPrefix + " " + target.AccessToken
+	}
+
+	resp, err := utils.InvokeRequest(testCase.Method, target.GatewayURL+requestPath, headers, queryParams, body)
+	if err != nil {
+		return err
+	}
+	testCase.Status = resp.StatusCode()
+	testCase.Failures = validateContractTestResponse(target.Definition, operation, resp.StatusCode(),
+		resp.Header().Get(utils.HeaderContentType), resp.Body())
+	return nil
+}
+
+// generateParameterValue generates the value of a parameter from its example or schema
+func generateParameterValue(definition, parameter map[string]interface{}) string {
+	value, ok := parameter["example"]
+	if !ok {
+		if schema, hasSchema := parameter["schema"]; hasSchema {
+			value = generateMockValue(definition, schema, 0)
+		} else {
+			// OpenAPI 2 parameters have the type and the format in the parameter itself
+			value = generateMockValue(definition, parameter, 0)
+		}
+	}
+	if list, ok := value.([]interface{}); ok {
+		var items []string
+		for _, item := range list {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// validateContractTestResponse validates the status code and the body of a response against the responses of an
+// operation
+func validateContractTestResponse(definition, operation map[string]interface{}, status int, contentType string,
+	body []byte) []string {
+	responses, _ := operation["responses"].(map[string]interface{})
+	code := strconv.Itoa(status)
+	documentedSuccess := false
+	for documentedCode := range responses {
+		documentedSuccess = documentedSuccess || strings.HasPrefix(documentedCode, "2")
+	}
+	var failures []string
+	if documentedSuccess && (status < 200 || status > 299) {
+		failures = append(failures, fmt.Sprintf("expected a successful response but the status was %d", status))
+	}
+
+	responseValue, ok := responses[code]
+	if !ok {
+		responseValue, ok = responses[code[:1]+"XX"]
+	}
+	if !ok {
+		responseValue, ok = responses["default"]
+	}
+	if !ok {
+		return append(failures, fmt.Sprintf("status %d is not documented for the operation", status))
+	}
+
+	response, _ := resolveMockRef(definition, responseValue).(map[string]interface{})
+	schema, hasSchema := response["schema"]
+	if content, ok := response["content"].(map[string]interface{}); ok {
+		mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+		media, ok := content[mediaType].(map[string]interface{})
+		if !ok {
+			media, _ = content[pickMockMediaType(content)].(map[string]interface{})
+		}
+		schema, hasSchema = media["schema"]
+	}
+	if !hasSchema || schema == nil || len(strings.TrimSpace(string(body))) == 0 && status == http.StatusNoContent {
+		return failures
+	}
+	if !strings.Contains(contentType, "json") {
+		return append(failures, fmt.Sprintf("expected a JSON response but the content type was %q", contentType))
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return append(failures, "the response body is not valid JSON: "+err.Error())
+	}
+	return append(failures, validateSchemaValue(definition, schema, value, "$", 0)...)
+}
+
+// PrintContractTestResults prints the results of the operations and a summary
+// @param testCases : Results of the operations
+// @return number of operations which failed
+func PrintContractTestResults(testCases []*ContractTestCase) int {
+	failed := 0
+	for _, testCase := range testCases {
+		duration := testCase.Duration.Round(time.Millisecond)
+		switch {
+		case testCase.Err != nil:
+			failed++
+			fmt.Printf("ERROR %s %s (%s)\n      %s\n", testCase.Method, testCase.Path, duration, testCase.Err.Error())
+		case !testCase.Passed():
+			failed++
+			fmt.Printf("FAIL  %s %s (%d, %s)\n", testCase.Method, testCase.Path, testCase.Status, duration)
+			for _, failure := range testCase.Failures {
+				fmt.Println("      " + failure)
+			}
+		default:
+			fmt.Printf("PASS  %s %s (%d, %s)\n", testCase.Method, testCase.Path, testCase.Status, duration)
+		}
+	}
+	fmt.Printf("%d operation(s) tested: %d passed, %d failed\n", len(testCases), len(testCases)-failed, failed)
+	return failed
+}
+
+// WriteContractTestJUnitReport writes the results of the operations as a JUnit XML report
+// @param reportPath : Path of the report file
+// @param target : API which was tested
+// @param testCases : Results of the operations
+// @param startTime : Time the tests were started at
+// @return error
+func WriteContractTestJUnitReport(reportPath string, target *ContractTestTarget, testCases []*ContractTestCase,
+	startTime time.Time) error {
+	suiteName := target.Name + " " + target.Version
+	suite := junitTestSuite{
+		Name:      suiteName,
+		Tests:     len(testCases),
+		Timestamp: startTime.UTC().Format("2006-01-02T15:04:05"),
+	}
+	var total time.Duration
+	for _, testCase := range testCases {
+		total += testCase.Duration
+		junitCase := junitTestCase{
+			Name:      testCase.Method + " " + testCase.Path,
+			ClassName: strings.Replace(target.Name+"."+target.Version, " ", "_", -1),
+			Time:      formatJUnitSeconds(testCase.Duration),
+		}
+		if testCase.Err != nil {
+			suite.Errors++
+			junitCase.Error = &junitProblem{Message: testCase.Err.Error(), Type: "RequestError",
+				Text: testCase.Err.Error()}
+		} else if !testCase.Passed() {
+			suite.Failures++
+			junitCase.Failure = &junitProblem{
+				Message: testCase.Failures[0],
+				Type:    "ContractViolation",
+				Text:    fmt.Sprintf("Status: %d\n%s", testCase.Status, strings.Join(testCase.Failures, "\n")),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, junitCase)
+	}
+	suite.Time = formatJUnitSeconds(total)
+	report := junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	}
+	content, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Writing the JUnit report to " + reportPath)
+	return ioutil.WriteFile(reportPath, append([]byte(xml.Header), append(content, '\n')...), 0644)
+}
+
+func formatJUnitSeconds(duration time.Duration) string {
+	return strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+}
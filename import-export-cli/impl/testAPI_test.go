@@ -0,0 +1,122 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const contractTestDefinition = `openapi: 3.0.1
+info:
+  title: Orders
+  version: 1.0.0
+paths:
+  /orders/{orderId}:
+    get:
+      parameters:
+        - name: orderId
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Order'
+components:
+  schemas:
+    Order:
+      type: object
+      required: [id, status]
+      properties:
+        id:
+          type: integer
+        status:
+          type: string
+          enum: [PENDING, DELIVERED]
+`
+
+func TestRunContractTestsPassesAgainstMockServer(t *testing.T) {
+	projectPath := utils.GetRelativeTestDataPathFromImpl() + "PizzaShackAPI-1.0.0"
+	mockServer, err := LoadMockServer(projectPath)
+	assert.Nil(t, err)
+	server := httptest.NewServer(mockServer)
+	defer server.Close()
+
+	target, err := LoadLocalContractTestTarget(projectPath, server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, server.URL+"/pizzashack/1.0.0", target.GatewayURL)
+	target.AccessToken = "any-token"
+
+	testCases := RunContractTests(target)
+	assert.NotEmpty(t, testCases)
+	for _, testCase := range testCases {
+		assert.True(t, testCase.Passed(), "%s %s: %v %v", testCase.Method, testCase.Path, testCase.Err,
+			testCase.Failures)
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "report.xml")
+	assert.Nil(t, WriteContractTestJUnitReport(reportPath, target, testCases, time.Now()))
+	report, err := ioutil.ReadFile(reportPath)
+	assert.Nil(t, err)
+	assert.Contains(t, string(report), `<testsuite name="PizzaShackAPI 1.0.0"`)
+	assert.Contains(t, string(report), `name="GET /menu"`)
+	assert.NotContains(t, string(report), "<failure")
+}
+
+func TestRunContractTestsReportsViolations(t *testing.T) {
+	definitionPath := filepath.Join(t.TempDir(), "orders.yaml")
+	writeTestFile(t, definitionPath, contractTestDefinition)
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "1", "status": "LOST"}`))
+	}))
+	defer server.Close()
+
+	target, err := LoadLocalContractTestTarget(definitionPath, server.URL+"/orders/1.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, "Orders", target.Name)
+
+	testCases := RunContractTests(target)
+	assert.Len(t, testCases, 1)
+	assert.Equal(t, "/orders/1.0.0/orders/0", requestedPath)
+	assert.False(t, testCases[0].Passed())
+	assert.Len(t, testCases[0].Failures, 2)
+
+	reportPath := filepath.Join(t.TempDir(), "report.xml")
+	assert.Nil(t, WriteContractTestJUnitReport(reportPath, target, testCases, time.Now()))
+	report, err := ioutil.ReadFile(reportPath)
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(report), `failures="1"`))
+	assert.Contains(t, string(report), `type="ContractViolation"`)
+}
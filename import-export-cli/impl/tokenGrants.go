@@ -0,0 +1,414 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// authorizationCodeTimeout is the time to wait for the user to authorize in the browser
+const authorizationCodeTimeout = 5 * time.Minute
+
+// KeyGenOptions contains how the token to invoke an API is generated
+type KeyGenOptions struct {
+	// GrantType is one of client_credentials (default), password, authorization_code and refresh_token
+	GrantType string
+	// Scopes of the token. The scopes of the subscribed APIs are used if this is empty.
+	Scopes []string
+	// ValidityPeriod of the token or the API key in seconds. The default of the key manager is used if this is 0.
+	ValidityPeriod int
+	// Username and Password of the resource owner for the password grant
+	Username string
+	Password string
+	// RefreshToken to exchange for the refresh_token grant
+	RefreshToken string
+	// CallbackPort of the loopback listener for the authorization_code grant. A free port is used if this is 0.
+	CallbackPort int
+	// APIKey generates an API key instead of an OAuth2 token
+	APIKey bool
+	// Decode prints the header and the claims of the generated token
+	Decode bool
+}
+
+// getTokenWithOptions generates a token for the key of an application with the grant type of the key generation
+// options
+// @param appId : Application ID of the key
+// @param key : Consumer key and secret of the application
+// @param scopes : Scopes to generate the token
+// @param accessToken : Access token to call the devportal REST API
+// @return TokenResponse, error
+func getTokenWithOptions(appId string, key *utils.ApplicationKey, scopes []string,
+	accessToken string) (*utils.TokenResponse, error) {
+	scope := strings.Join(scopes, " ")
+	switch keyGenOptions.GrantType {
+	case "", utils.GrantTypeClientCredentials:
+		return requestToken(key, url.Values{"grant_type": {utils.GrantTypeClientCredentials}, "scope": {scope}})
+	case utils.GrantTypePassword:
+		if keyGenOptions.Username == "" || keyGenOptions.Password == "" {
+			return nil, errors.New("username and password are required for the password grant")
+		}
+		return requestToken(key, url.Values{
+			"grant_type": {utils.GrantTypePassword},
+			"username":   {keyGenOptions.Username},
+			"password":   {keyGenOptions.Password},
+			"scope":      {scope},
+		})
+	case utils.GrantTypeRefreshToken:
+		if keyGenOptions.RefreshToken == "" {
+			return nil, errors.New("a refresh token is required for the refresh_token grant")
+		}
+		return requestToken(key, url.Values{
+			"grant_type":    {utils.GrantTypeRefreshToken},
+			"refresh_token": {keyGenOptions.RefreshToken},
+			"scope":         {scope},
+		})
+	case utils.GrantTypeAuthorizationCode:
+		return getTokenWithAuthorizationCode(appId, key, scope, accessToken)
+	}
+	return nil, errors.New("unsupported grant type " + keyGenOptions.GrantType + ". Supported grant types: " +
+		strings.Join([]string{utils.GrantTypeClientCredentials, utils.GrantTypePassword,
+			utils.GrantTypeAuthorizationCode, utils.GrantTypeRefreshToken}, ", "))
+}
+
+// getTokenWithAuthorizationCode runs the authorization code grant with PKCE. The user authorizes in a browser and
+// the authorization code is received by a listener on the loopback interface.
+func getTokenWithAuthorizationCode(appId string, key *utils.ApplicationKey, scope,
+	accessToken string) (*utils.TokenResponse, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:"+strconv.Itoa(keyGenOptions.CallbackPort))
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+	redirectURI := "http://" + listener.Addr().String() + "/callback"
+	if key.KeyMappingId == "" {
+		return nil, errors.New("cannot update the keys of the CLI application to allow the authorization_code grant")
+	}
+	if err = updateApplicationKey(appId, allowAuthorizationCodeGrant(key, redirectURI), accessToken); err != nil {
+		return nil, err
+	}
+	// The keys of the CLI application are shared by the other commands, hence the original callback URL and grant
+	// types are restored once the token is issued
+	defer func() {
+		if err := updateApplicationKey(appId, key, accessToken); err != nil {
+			fmt.Fprintln(os.Stderr, utils.LogPrefixWarning+"Could not restore the keys of the CLI application: "+
+				err.Error())
+		}
+	}()
+
+	verifier, err := generateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	state, err := generateRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	authorizeURL := getAuthorizeEndpoint() + "?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {key.ConsumerKey},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {strings.TrimSpace("openid " + scope)},
+		"state":                 {state},
+		"code_challenge":        {getPKCECodeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+	// Print to the standard error to keep the standard output for the token
+	fmt.Fprintln(os.Stderr, "Open the following URL in a browser to authorize the CLI application:")
+	fmt.Fprintln(os.Stderr, authorizeURL)
+
+	code, err := waitForAuthorizationCode(listener, state, authorizationCodeTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return requestToken(key, url.Values{
+		"grant_type":    {utils.GrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	})
+}
+
+// waitForAuthorizationCode serves the redirect of the authorization server on the listener and returns the
+// authorization code of it
+func waitForAuthorizationCode(listener net.Listener, state string, timeout time.Duration) (string, error) {
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/callback" {
+			http.NotFound(w, r)
+			return
+		}
+		query := r.URL.Query()
+		result := callbackResult{code: query.Get("code")}
+		if query.Get("error") != "" {
+			result.err = errors.New("authorization failed: " + query.Get("error") + " " +
+				query.Get("error_description"))
+		} else if query.Get("state") != state {
+			result.err = errors.New("authorization failed: the state of the redirect does not match")
+		} else if result.code == "" {
+			result.err = errors.New("authorization failed: the redirect does not have an authorization code")
+		}
+		if result.err != nil {
+			http.Error(w, result.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = w.Write([]byte("Authorization completed. You can close this window and return to " +
+				utils.ProjectName + "."))
+		}
+		select {
+		case results <- result:
+		default:
+		}
+	})}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Shutdown(context.Background())
+
+	select {
+	case result := <-results:
+		return result.code, result.err
+	case <-time.After(timeout):
+		return "", errors.New("timed out waiting for the authorization in the browser")
+	}
+}
+
+// allowAuthorizationCodeGrant returns a copy of the key of the application which supports the authorization code
+// grant with the given callback URL
+func allowAuthorizationCodeGrant(key *utils.ApplicationKey, callbackURL string) *utils.ApplicationKey {
+	updatedKey := *key
+	updatedKey.CallbackURL = callbackURL
+	updatedKey.SupportedGrantTypes = []string{utils.GrantTypeAuthorizationCode}
+	for _, grantType := range key.SupportedGrantTypes {
+		if grantType != utils.GrantTypeAuthorizationCode {
+			updatedKey.SupportedGrantTypes = append(updatedKey.SupportedGrantTypes, grantType)
+		}
+	}
+	return &updatedKey
+}
+
+// updateApplicationKey updates the callback URL and the grant types of a key of the application
+func updateApplicationKey(appId string, key *utils.ApplicationKey, accessToken string) error {
+	body, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	keyEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" +
+		appId + "/oauth-keys/" + key.KeyMappingId
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePutRequest(nil, keyEndpoint, headers, string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusOK {
+		return nil
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return fmt.Errorf("authorization failed while updating the keys of the CLI application: " + appId)
+	}
+	return errors.New("Request didn't respond 200 OK for updating the keys of the CLI application. Status: " +
+		resp.Status())
+}
+
+// getAuthorizeEndpoint returns the authorize endpoint served along with the token endpoint of the environment
+func getAuthorizeEndpoint() string {
+	tokenEndpoint := keyGenTokenEndpoint
+	if tokenEndpoint == "" {
+		tokenEndpoint = utils.GetTokenEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(tokenEndpoint, "/"), "token") + "authorize"
+}
+
+// getPKCECodeChallenge returns the S256 code challenge of a PKCE code verifier
+func getPKCECodeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// generateRandomString returns a URL safe string of the given number of random bytes
+func generateRandomString(length int) (string, error) {
+	randomBytes := make([]byte, length)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+// isAPIKeySecuredOnly returns whether the API can be invoked only with API keys
+func isAPIKeySecuredOnly(api *utils.APIData) bool {
+	apiKeySecured := false
+	for _, scheme := range api.SecurityScheme {
+		if scheme == utils.SecuritySchemeOAuth2 {
+			return false
+		}
+		apiKeySecured = apiKeySecured || scheme == utils.SecuritySchemeAPIKey
+	}
+	return apiKeySecured
+}
+
+// generateAPIKey generates an API key of an application
+// @param appId : Application ID to generate the API key of
+// @param keyType : Key type of the API key (PRODUCTION or SANDBOX)
+// @param validityPeriod : Validity period of the API key in seconds. The API key does not expire if this is 0.
+// @param accessToken : Access token to call the devportal REST API
+// @return apiKey, error
+func generateAPIKey(appId, keyType string, validityPeriod int, accessToken string) (string, error) {
+	apiKeyEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" +
+		appId + "/api-keys/" + keyType + "/generate"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	if validityPeriod <= 0 {
+		validityPeriod = -1
+	}
+	body := `{"validityPeriod": ` + strconv.Itoa(validityPeriod) + `, "additionalProperties": {}}`
+	resp, err := utils.InvokePOSTRequest(apiKeyEndpoint, headers, body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
+		// 200 OK or 201 Created
+		apiKey := &struct {
+			APIKey string `json:"apikey"`
+		}{}
+		err = json.Unmarshal(resp.Body(), apiKey)
+		return apiKey.APIKey, err
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return "", fmt.Errorf("authorization failed while generating an API key of the application: " + appId)
+	}
+	return "", errors.New("Request didn't respond 200 OK for generating an API key. Status: " + resp.Status())
+}
+
+// DecodeJWT decodes the header and the claims of a JWT without verifying its signature
+// @param token : JWT to be decoded
+// @return header, claims, error
+func DecodeJWT(token string) (map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, nil, errors.New("the token is not a JWT")
+	}
+	header := map[string]interface{}{}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, nil, errors.New("invalid JWT header: " + err.Error())
+	}
+	claims := map[string]interface{}{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, nil, errors.New("invalid JWT claims: " + err.Error())
+	}
+	return header, claims, nil
+}
+
+func decodeJWTPart(part string, value interface{}) error {
+	content, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+// PrintDecodedJWT prints the header and the claims of a JWT followed by the expiry, the audience and the scopes of it
+// @param token : JWT to be decoded
+func PrintDecodedJWT(token string) {
+	header, claims, err := DecodeJWT(token)
+	if err != nil {
+		utils.HandleErrorAndExit("Error decoding the token", err)
+	}
+	headerContent, _ := json.MarshalIndent(header, "", "  ")
+	claimsContent, _ := json.MarshalIndent(claims, "", "  ")
+	fmt.Println("Header:")
+	fmt.Println(string(headerContent))
+	fmt.Println("Claims:")
+	fmt.Println(string(claimsContent))
+	for _, line := range summarizeJWTClaims(claims, time.Now()) {
+		fmt.Println(line)
+	}
+}
+
+// summarizeJWTClaims describes the expiry, the audience and the scopes of the claims of a JWT
+func summarizeJWTClaims(claims map[string]interface{}, now time.Time) []string {
+	var summary []string
+	if exp, ok := claims["exp"].(json.Number); ok {
+		if seconds, err := exp.Int64(); err == nil {
+			expiry := time.Unix(seconds, 0)
+			remaining := expiry.Sub(now).Round(time.Second)
+			if remaining <= 0 {
+				summary = append(summary, fmt.Sprintf("Expires:  %s (EXPIRED %s ago)", expiry.UTC().Format(time.RFC3339),
+					-remaining))
+			} else {
+				summary = append(summary, fmt.Sprintf("Expires:  %s (in %s)", expiry.UTC().Format(time.RFC3339),
+					remaining))
+			}
+		}
+	} else {
+		summary = append(summary, "Expires:  never")
+	}
+
+	var audience []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		audience = append(audience, aud)
+	case []interface{}:
+		for _, value := range aud {
+			audience = append(audience, fmt.Sprint(value))
+		}
+	}
+	summary = append(summary, "Audience: "+strings.Join(audience, ", "))
+
+	var scopes []string
+	switch scope := claims["scope"].(type) {
+	case string:
+		scopes = strings.Fields(scope)
+	case []interface{}:
+		for _, value := range scope {
+			scopes = append(scopes, fmt.Sprint(value))
+		}
+	}
+	sort.Strings(scopes)
+	return append(summary, "Scopes:   "+strings.Join(scopes, " "))
+}
@@ -0,0 +1,136 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestDecodeJWT(t *testing.T) {
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","aud":["client-id"],"exp":1700000000,`+
+			`"scope":"write read"}`)) + ".signature"
+	header, claims, err := DecodeJWT(token)
+	assert.Nil(t, err)
+	assert.Equal(t, "RS256", header["alg"])
+	assert.Equal(t, "admin", claims["sub"])
+
+	summary := summarizeJWTClaims(claims, time.Unix(1700000000-90, 0))
+	assert.Equal(t, []string{
+		"Expires:  2023-11-14T22:13:20Z (in 1m30s)",
+		"Audience: client-id",
+		"Scopes:   read write",
+	}, summary)
+	summary = summarizeJWTClaims(claims, time.Unix(1700000000+60, 0))
+	assert.Equal(t, "Expires:  2023-11-14T22:13:20Z (EXPIRED 1m0s ago)", summary[0])
+
+	_, _, err = DecodeJWT("5f2b9c1e-opaque-token")
+	assert.EqualError(t, err, "the token is not a JWT")
+}
+
+func TestGetPKCECodeChallenge(t *testing.T) {
+	// Example of RFC 7636 Appendix B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		getPKCECodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestWaitForAuthorizationCode(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer listener.Close()
+	go func() {
+		response, err := http.Get("http://" + listener.Addr().String() + "/callback?code=abc&state=xyz")
+		if err == nil {
+			response.Body.Close()
+		}
+	}()
+	code, err := waitForAuthorizationCode(listener, "xyz", 5*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", code)
+}
+
+func TestGetTokenWithOptionsUsesGrantType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "key", username)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, "600", r.PostForm.Get("validity_period"))
+		switch r.PostForm.Get("grant_type") {
+		case utils.GrantTypePassword:
+			assert.Equal(t, "alice", r.PostForm.Get("username"))
+			assert.Equal(t, "read write", r.PostForm.Get("scope"))
+			_, _ = w.Write([]byte(`{"access_token": "password-token", "refresh_token": "refresh"}`))
+		case utils.GrantTypeRefreshToken:
+			assert.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+			_, _ = w.Write([]byte(`{"access_token": "refreshed-token"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	defer func() {
+		keyGenTokenEndpoint = ""
+		keyGenOptions = KeyGenOptions{}
+	}()
+	keyGenTokenEndpoint = server.URL
+	key := &utils.ApplicationKey{ConsumerKey: "key", ConsumerSecret: "secret"}
+
+	keyGenOptions = KeyGenOptions{GrantType: utils.GrantTypePassword, Username: "alice", Password: "pass",
+		ValidityPeriod: 600}
+	tokenResponse, err := getTokenWithOptions("app-id", key, []string{"read", "write"}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "password-token", tokenResponse.AccessToken)
+	assert.Equal(t, "refresh", tokenResponse.RefreshToken)
+
+	keyGenOptions = KeyGenOptions{GrantType: utils.GrantTypeRefreshToken, RefreshToken: "refresh", ValidityPeriod: 600}
+	tokenResponse, err = getTokenWithOptions("app-id", key, nil, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "refreshed-token", tokenResponse.AccessToken)
+
+	keyGenOptions = KeyGenOptions{GrantType: "implicit"}
+	_, err = getTokenWithOptions("app-id", key, nil, "")
+	assert.NotNil(t, err)
+}
+
+func TestAllowAuthorizationCodeGrantKeepsOriginalKey(t *testing.T) {
+	key := &utils.ApplicationKey{KeyMappingId: "mapping", CallbackURL: "https://app.example.com/callback",
+		SupportedGrantTypes: []string{utils.GrantTypeClientCredentials, utils.GrantTypePassword}}
+
+	updatedKey := allowAuthorizationCodeGrant(key, "http://127.0.0.1:8080/callback")
+	assert.Equal(t, "http://127.0.0.1:8080/callback", updatedKey.CallbackURL)
+	assert.Equal(t, []string{utils.GrantTypeAuthorizationCode, utils.GrantTypeClientCredentials,
+		utils.GrantTypePassword}, updatedKey.SupportedGrantTypes)
+	assert.Equal(t, "https://app.example.com/callback", key.CallbackURL)
+	assert.Equal(t, []string{utils.GrantTypeClientCredentials, utils.GrantTypePassword}, key.SupportedGrantTypes)
+}
+
+func TestIsAPIKeySecuredOnly(t *testing.T) {
+	assert.True(t, isAPIKeySecuredOnly(&utils.APIData{SecurityScheme: []string{"api_key", "oauth_basic_auth_api_key_mandatory"}}))
+	assert.False(t, isAPIKeySecuredOnly(&utils.APIData{SecurityScheme: []string{"oauth2", "api_key"}}))
+	assert.False(t, isAPIKeySecuredOnly(&utils.APIData{}))
+}
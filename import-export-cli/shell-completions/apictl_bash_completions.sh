@@ -360,6 +360,156 @@ __apictl_handle_word()
     __apictl_handle_word
 }
 
+_apictl_add_app()
+{
+    last_command="apictl_add_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--description=")
+    two_word_flags+=("--description")
+    local_nonpersistent_flags+=("--description")
+    local_nonpersistent_flags+=("--description=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--throttling-policy=")
+    two_word_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy=")
+    flags+=("--token-type=")
+    two_word_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_add_doc()
+{
+    last_command="apictl_add_doc"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--artifact-type=")
+    two_word_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type=")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--other-type-name=")
+    two_word_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--source-type=")
+    two_word_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type=")
+    flags+=("--source-url=")
+    two_word_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url=")
+    flags+=("--summary=")
+    two_word_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--visibility=")
+    two_word_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_add_env()
 {
     last_command="apictl_add_env"
@@ -415,6 +565,72 @@ _apictl_add_env()
     noun_aliases=()
 }
 
+_apictl_add_gateway-env()
+{
+    last_command="apictl_add_gateway-env"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--description=")
+    two_word_flags+=("--description")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--description")
+    local_nonpersistent_flags+=("--description=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--display-name=")
+    two_word_flags+=("--display-name")
+    local_nonpersistent_flags+=("--display-name")
+    local_nonpersistent_flags+=("--display-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-type=")
+    two_word_flags+=("--gateway-type")
+    local_nonpersistent_flags+=("--gateway-type")
+    local_nonpersistent_flags+=("--gateway-type=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--vhost=")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_add_help()
 {
     last_command="apictl_add_help"
@@ -439,6 +655,72 @@ _apictl_add_help()
     noun_aliases=()
 }
 
+_apictl_add_subscription()
+{
+    last_command="apictl_add_subscription"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--tier=")
+    two_word_flags+=("--tier")
+    local_nonpersistent_flags+=("--tier")
+    local_nonpersistent_flags+=("--tier=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--app=")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_add()
 {
     last_command="apictl_add"
@@ -446,8 +728,12 @@ _apictl_add()
     command_aliases=()
 
     commands=()
+    commands+=("app")
+    commands+=("doc")
     commands+=("env")
+    commands+=("gateway-env")
     commands+=("help")
+    commands+=("subscription")
 
     flags=()
     two_word_flags=()
@@ -762,9 +1048,9 @@ _apictl_ai()
     noun_aliases=()
 }
 
-_apictl_aws_help()
+_apictl_apply_help()
 {
-    last_command="apictl_aws_help"
+    last_command="apictl_apply_help"
 
     command_aliases=()
 
@@ -786,9 +1072,9 @@ _apictl_aws_help()
     noun_aliases=()
 }
 
-_apictl_aws_init()
+_apictl_apply_throttling-policies()
 {
-    last_command="apictl_aws_init"
+    last_command="apictl_apply_throttling-policies"
 
     command_aliases=()
 
@@ -800,48 +1086,52 @@ _apictl_aws_init()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--force")
-    flags+=("-f")
-    local_nonpersistent_flags+=("--force")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
     local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--stage=")
-    two_word_flags+=("--stage")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--stage")
-    local_nonpersistent_flags+=("--stage=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--prune")
+    local_nonpersistent_flags+=("--prune")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--stage=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_aws()
+_apictl_apply()
 {
-    last_command="apictl_aws"
+    last_command="apictl_apply"
 
     command_aliases=()
 
     commands=()
     commands+=("help")
-    commands+=("init")
+    commands+=("throttling-policies")
 
     flags=()
     two_word_flags=()
@@ -849,22 +1139,56 @@ _apictl_aws()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--prune")
+    local_nonpersistent_flags+=("--prune")
+    flags+=("--selector=")
+    two_word_flags+=("--selector")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--selector")
+    local_nonpersistent_flags+=("--selector=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_bundle()
+_apictl_aws_help()
 {
-    last_command="apictl_bundle"
+    last_command="apictl_aws_help"
 
     command_aliases=()
 
@@ -876,36 +1200,19 @@ _apictl_bundle()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_change-status_api()
+_apictl_aws_init()
 {
-    last_command="apictl_change-status_api"
+    last_command="apictl_aws_init"
 
     command_aliases=()
 
@@ -917,18 +1224,10 @@ _apictl_change-status_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--action=")
-    two_word_flags+=("--action")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--action")
-    local_nonpersistent_flags+=("--action=")
-    local_nonpersistent_flags+=("-a")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -939,42 +1238,34 @@ _apictl_change-status_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--stage=")
+    two_word_flags+=("--stage")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--stage")
+    local_nonpersistent_flags+=("--stage=")
+    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--action=")
-    must_have_one_flag+=("-a")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
+    must_have_one_flag+=("--stage=")
+    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_change-status_api-product()
+_apictl_aws()
 {
-    last_command="apictl_change-status_api-product"
+    last_command="apictl_aws"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("init")
 
     flags=()
     two_word_flags=()
@@ -982,12 +1273,43 @@ _apictl_change-status_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--action=")
-    two_word_flags+=("--action")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--action")
-    local_nonpersistent_flags+=("--action=")
-    local_nonpersistent_flags+=("-a")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_bundle_api-product()
+{
+    last_command="apictl_bundle_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--apis-dir=")
+    two_word_flags+=("--apis-dir")
+    local_nonpersistent_flags+=("--apis-dir")
+    local_nonpersistent_flags+=("--apis-dir=")
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -998,44 +1320,18 @@ _apictl_change-status_api-product()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--action=")
-    must_have_one_flag+=("-a")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_change-status_help()
+_apictl_bundle_help()
 {
-    last_command="apictl_change-status_help"
+    last_command="apictl_bundle_help"
 
     command_aliases=()
 
@@ -1057,14 +1353,13 @@ _apictl_change-status_help()
     noun_aliases=()
 }
 
-_apictl_change-status()
+_apictl_bundle()
 {
-    last_command="apictl_change-status"
+    last_command="apictl_bundle"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
     commands+=("api-product")
     commands+=("help")
 
@@ -1074,22 +1369,36 @@ _apictl_change-status()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_api()
+_apictl_change-status_api()
 {
-    last_command="apictl_delete_api"
+    last_command="apictl_change-status_api"
 
     command_aliases=()
 
@@ -1101,6 +1410,12 @@ _apictl_delete_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--action=")
+    two_word_flags+=("--action")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--action")
+    local_nonpersistent_flags+=("--action=")
+    local_nonpersistent_flags+=("-a")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -1134,6 +1449,8 @@ _apictl_delete_api()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--action=")
+    must_have_one_flag+=("-a")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
@@ -1144,9 +1461,9 @@ _apictl_delete_api()
     noun_aliases=()
 }
 
-_apictl_delete_api-product()
+_apictl_change-status_api-product()
 {
-    last_command="apictl_delete_api-product"
+    last_command="apictl_change-status_api-product"
 
     command_aliases=()
 
@@ -1158,6 +1475,12 @@ _apictl_delete_api-product()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--action=")
+    two_word_flags+=("--action")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--action")
+    local_nonpersistent_flags+=("--action=")
+    local_nonpersistent_flags+=("-a")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -1191,6 +1514,8 @@ _apictl_delete_api-product()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--action=")
+    must_have_one_flag+=("-a")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
@@ -1201,9 +1526,9 @@ _apictl_delete_api-product()
     noun_aliases=()
 }
 
-_apictl_delete_app()
+_apictl_change-status_apis()
 {
-    last_command="apictl_delete_app"
+    last_command="apictl_change-status_apis"
 
     command_aliases=()
 
@@ -1215,44 +1540,58 @@ _apictl_delete_app()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--action=")
+    two_word_flags+=("--action")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--action")
+    local_nonpersistent_flags+=("--action=")
+    local_nonpersistent_flags+=("-a")
+    flags+=("--concurrency=")
+    two_word_flags+=("--concurrency")
+    local_nonpersistent_flags+=("--concurrency")
+    local_nonpersistent_flags+=("--concurrency=")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--action=")
+    must_have_one_flag+=("-a")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_help()
+_apictl_change-status_help()
 {
-    last_command="apictl_delete_help"
+    last_command="apictl_change-status_help"
 
     command_aliases=()
 
@@ -1274,13 +1613,17 @@ _apictl_delete_help()
     noun_aliases=()
 }
 
-_apictl_delete_policy_api()
+_apictl_change-status()
 {
-    last_command="apictl_delete_policy_api"
+    last_command="apictl_change-status"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("apis")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1288,46 +1631,22 @@ _apictl_delete_policy_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_policy_help()
+_apictl_create_help()
 {
-    last_command="apictl_delete_policy_help"
+    last_command="apictl_create_help"
 
     command_aliases=()
 
@@ -1349,9 +1668,9 @@ _apictl_delete_policy_help()
     noun_aliases=()
 }
 
-_apictl_delete_policy_rate-limiting()
+_apictl_create_revision()
 {
-    last_command="apictl_delete_policy_rate-limiting"
+    last_command="apictl_create_revision"
 
     command_aliases=()
 
@@ -1363,6 +1682,12 @@ _apictl_delete_policy_rate-limiting()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--description=")
+    two_word_flags+=("--description")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--description")
+    local_nonpersistent_flags+=("--description=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -1379,12 +1704,24 @@ _apictl_delete_policy_rate-limiting()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
     flags+=("--type=")
     two_word_flags+=("--type")
     two_word_flags+=("-t")
     local_nonpersistent_flags+=("--type")
     local_nonpersistent_flags+=("--type=")
     local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1394,22 +1731,21 @@ _apictl_delete_policy_rate-limiting()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--type=")
-    must_have_one_flag+=("-t")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_policy()
+_apictl_create()
 {
-    last_command="apictl_delete_policy"
+    last_command="apictl_create"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
     commands+=("help")
-    commands+=("rate-limiting")
+    commands+=("revision")
 
     flags=()
     two_word_flags=()
@@ -1430,18 +1766,13 @@ _apictl_delete_policy()
     noun_aliases=()
 }
 
-_apictl_delete()
+_apictl_delete_api()
 {
-    last_command="apictl_delete"
+    last_command="apictl_delete_api"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -1449,22 +1780,54 @@ _apictl_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_api()
+_apictl_delete_api-product()
 {
-    last_command="apictl_export_api"
+    last_command="apictl_delete_api-product"
 
     command_aliases=()
 
@@ -1482,34 +1845,24 @@ _apictl_export_api()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -1531,9 +1884,9 @@ _apictl_export_api()
     noun_aliases=()
 }
 
-_apictl_export_api-product()
+_apictl_delete_api-products()
 {
-    last_command="apictl_export_api-product"
+    last_command="apictl_delete_api-products"
 
     command_aliases=()
 
@@ -1545,46 +1898,32 @@ _apictl_export_api-product()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--undeploy")
+    local_nonpersistent_flags+=("--undeploy")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1592,17 +1931,15 @@ _apictl_export_api-product()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
+    must_have_one_flag+=("--query=")
+    must_have_one_flag+=("-q")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_apis()
+_apictl_delete_apis()
 {
-    last_command="apictl_export_apis"
+    last_command="apictl_delete_apis"
 
     command_aliases=()
 
@@ -1614,25 +1951,32 @@ _apictl_export_apis()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--all")
-    local_nonpersistent_flags+=("--all")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--force")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--undeploy")
+    local_nonpersistent_flags+=("--undeploy")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1640,13 +1984,15 @@ _apictl_export_apis()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--query=")
+    must_have_one_flag+=("-q")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_app()
+_apictl_delete_app()
 {
-    last_command="apictl_export_app"
+    last_command="apictl_delete_app"
 
     command_aliases=()
 
@@ -1664,10 +2010,6 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1684,8 +2026,8 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--owner")
     local_nonpersistent_flags+=("--owner=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--with-keys")
-    local_nonpersistent_flags+=("--with-keys")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1695,15 +2037,13 @@ _apictl_export_app()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--owner=")
-    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_help()
+_apictl_delete_apps()
 {
-    last_command="apictl_export_help"
+    last_command="apictl_delete_apps"
 
     command_aliases=()
 
@@ -1715,19 +2055,46 @@ _apictl_export_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--query=")
+    must_have_one_flag+=("-q")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_export_policy_api()
+_apictl_delete_doc()
 {
-    last_command="apictl_export_policy_api"
+    last_command="apictl_delete_doc"
 
     command_aliases=()
 
@@ -1739,16 +2106,20 @@ _apictl_export_policy_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--artifact-type=")
+    two_word_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type=")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1759,17 +2130,28 @@ _apictl_export_policy_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
     local_nonpersistent_flags+=("--version")
     local_nonpersistent_flags+=("--version=")
     local_nonpersistent_flags+=("-v")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--doc-name=")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
@@ -1780,33 +2162,9 @@ _apictl_export_policy_api()
     noun_aliases=()
 }
 
-_apictl_export_policy_help()
-{
-    last_command="apictl_export_policy_help"
-
-    command_aliases=()
-
-    commands=()
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_noun=()
-    has_completion_function=1
-    noun_aliases=()
-}
-
-_apictl_export_policy_rate-limiting()
+_apictl_delete_gateway-env()
 {
-    last_command="apictl_export_policy_rate-limiting"
+    last_command="apictl_delete_gateway-env"
 
     command_aliases=()
 
@@ -1824,10 +2182,6 @@ _apictl_export_policy_rate-limiting()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1838,12 +2192,6 @@ _apictl_export_policy_rate-limiting()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--type=")
-    two_word_flags+=("--type")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--type")
-    local_nonpersistent_flags+=("--type=")
-    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -1857,16 +2205,13 @@ _apictl_export_policy_rate-limiting()
     noun_aliases=()
 }
 
-_apictl_export_policy()
+_apictl_delete_help()
 {
-    last_command="apictl_export_policy"
+    last_command="apictl_delete_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
-    commands+=("rate-limiting")
 
     flags=()
     two_word_flags=()
@@ -1874,32 +2219,23 @@ _apictl_export_policy()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_export()
+_apictl_delete_key-manager()
 {
-    last_command="apictl_export"
+    last_command="apictl_delete_key-manager"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("apis")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -1907,22 +2243,38 @@ _apictl_export()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_deployment-dir()
+_apictl_delete_policy_api()
 {
-    last_command="apictl_gen_deployment-dir"
+    last_command="apictl_delete_policy_api"
 
     command_aliases=()
 
@@ -1934,36 +2286,48 @@ _apictl_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_help()
+_apictl_delete_policy_help()
 {
-    last_command="apictl_gen_help"
+    last_command="apictl_delete_policy_help"
 
     command_aliases=()
 
@@ -1985,15 +2349,69 @@ _apictl_gen_help()
     noun_aliases=()
 }
 
-_apictl_gen()
+_apictl_delete_policy_rate-limiting()
 {
-    last_command="apictl_gen"
+    last_command="apictl_delete_policy_rate-limiting"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--type=")
+    must_have_one_flag+=("-t")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_delete_policy()
+{
+    last_command="apictl_delete_policy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
     commands+=("help")
+    commands+=("rate-limiting")
 
     flags=()
     two_word_flags=()
@@ -2014,9 +2432,9 @@ _apictl_gen()
     noun_aliases=()
 }
 
-_apictl_get_api-logging()
+_apictl_delete_scope()
 {
-    last_command="apictl_get_api-logging"
+    last_command="apictl_delete_scope"
 
     command_aliases=()
 
@@ -2028,30 +2446,24 @@ _apictl_get_api-logging()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--api-id=")
-    two_word_flags+=("--api-id")
-    two_word_flags+=("-i")
-    local_nonpersistent_flags+=("--api-id")
-    local_nonpersistent_flags+=("--api-id=")
-    local_nonpersistent_flags+=("-i")
+    flags+=("--check-usage")
+    local_nonpersistent_flags+=("--check-usage")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--tenant-domain=")
-    two_word_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2059,13 +2471,15 @@ _apictl_get_api-logging()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-product-revisions()
+_apictl_delete_subscription()
 {
-    last_command="apictl_get_api-product-revisions"
+    last_command="apictl_delete_subscription"
 
     command_aliases=()
 
@@ -2077,16 +2491,16 @@ _apictl_get_api-product-revisions()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2103,12 +2517,6 @@ _apictl_get_api-product-revisions()
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -2120,6 +2528,7 @@ _apictl_get_api-product-revisions()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--app=")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
@@ -2130,13 +2539,26 @@ _apictl_get_api-product-revisions()
     noun_aliases=()
 }
 
-_apictl_get_api-products()
+_apictl_delete()
 {
-    last_command="apictl_get_api-products"
+    last_command="apictl_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("api-products")
+    commands+=("apis")
+    commands+=("app")
+    commands+=("apps")
+    commands+=("doc")
+    commands+=("gateway-env")
+    commands+=("help")
+    commands+=("key-manager")
+    commands+=("policy")
+    commands+=("scope")
+    commands+=("subscription")
 
     flags=()
     two_word_flags=()
@@ -2144,46 +2566,46 @@ _apictl_get_api-products()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-revisions()
+_apictl_deploy_help()
 {
-    last_command="apictl_get_api-revisions"
+    last_command="apictl_deploy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_deploy_revision()
+{
+    last_command="apictl_deploy_revision"
 
     command_aliases=()
 
@@ -2201,14 +2623,18 @@ _apictl_get_api-revisions()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--hide-on-devportal")
+    local_nonpersistent_flags+=("--hide-on-devportal")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
@@ -2221,18 +2647,26 @@ _apictl_get_api-revisions()
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
     local_nonpersistent_flags+=("--version")
     local_nonpersistent_flags+=("--version=")
     local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2240,17 +2674,49 @@ _apictl_get_api-revisions()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--gateway-env=")
+    must_have_one_flag+=("-g")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
+    must_have_one_flag+=("--rev=")
     must_have_one_flag+=("--version=")
     must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apis()
+_apictl_deploy()
 {
-    last_command="apictl_get_apis"
+    last_command="apictl_deploy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("revision")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_export_api()
+{
+    last_command="apictl_export_api"
 
     command_aliases=()
 
@@ -2276,18 +2742,32 @@ _apictl_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2295,13 +2775,17 @@ _apictl_get_apis()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apps()
+_apictl_export_api-product()
 {
-    last_command="apictl_get_apps"
+    last_command="apictl_export_api-product"
 
     command_aliases=()
 
@@ -2327,18 +2811,32 @@ _apictl_get_apps()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2346,13 +2844,17 @@ _apictl_get_apps()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_correlation-logging()
+_apictl_export_apis()
 {
-    last_command="apictl_get_correlation-logging"
+    last_command="apictl_export_apis"
 
     command_aliases=()
 
@@ -2364,12 +2866,15 @@ _apictl_get_correlation-logging()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--force")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -2378,6 +2883,10 @@ _apictl_get_correlation-logging()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--shared-scopes")
+    local_nonpersistent_flags+=("--shared-scopes")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2389,9 +2898,9 @@ _apictl_get_correlation-logging()
     noun_aliases=()
 }
 
-_apictl_get_envs()
+_apictl_export_app()
 {
-    last_command="apictl_get_envs"
+    last_command="apictl_export_app"
 
     command_aliases=()
 
@@ -2403,6 +2912,12 @@ _apictl_get_envs()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -2411,18 +2926,38 @@ _apictl_get_envs()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--with-keys")
+    local_nonpersistent_flags+=("--with-keys")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--owner=")
+    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_help()
+_apictl_export_help()
 {
-    last_command="apictl_get_help"
+    last_command="apictl_export_help"
 
     command_aliases=()
 
@@ -2444,9 +2979,9 @@ _apictl_get_help()
     noun_aliases=()
 }
 
-_apictl_get_keys()
+_apictl_export_key-manager()
 {
-    last_command="apictl_get_keys"
+    last_command="apictl_export_key-manager"
 
     command_aliases=()
 
@@ -2464,6 +2999,10 @@ _apictl_get_keys()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2474,24 +3013,6 @@ _apictl_get_keys()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    local_nonpersistent_flags+=("-t")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2505,9 +3026,9 @@ _apictl_get_keys()
     noun_aliases=()
 }
 
-_apictl_get_policies_api()
+_apictl_export_policy_api()
 {
-    last_command="apictl_get_policies_api"
+    last_command="apictl_export_policy_api"
 
     command_aliases=()
 
@@ -2519,8 +3040,6 @@ _apictl_get_policies_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--all")
-    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -2535,12 +3054,18 @@ _apictl_get_policies_api()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2548,13 +3073,17 @@ _apictl_get_policies_api()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_policies_help()
+_apictl_export_policy_help()
 {
-    last_command="apictl_get_policies_help"
+    last_command="apictl_export_policy_help"
 
     command_aliases=()
 
@@ -2576,9 +3105,9 @@ _apictl_get_policies_help()
     noun_aliases=()
 }
 
-_apictl_get_policies_rate-limiting()
+_apictl_export_policy_rate-limiting()
 {
-    last_command="apictl_get_policies_rate-limiting"
+    last_command="apictl_export_policy_rate-limiting"
 
     command_aliases=()
 
@@ -2604,12 +3133,18 @@ _apictl_get_policies_rate-limiting()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -2617,13 +3152,15 @@ _apictl_get_policies_rate-limiting()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_policies()
+_apictl_export_policy()
 {
-    last_command="apictl_get_policies"
+    last_command="apictl_export_policy"
 
     command_aliases=()
 
@@ -2651,24 +3188,13 @@ _apictl_get_policies()
     noun_aliases=()
 }
 
-_apictl_get()
+_apictl_export_scope()
 {
-    last_command="apictl_get"
+    last_command="apictl_export_scope"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-logging")
-    commands+=("api-product-revisions")
-    commands+=("api-products")
-    commands+=("api-revisions")
-    commands+=("apis")
-    commands+=("apps")
-    commands+=("correlation-logging")
-    commands+=("envs")
-    commands+=("help")
-    commands+=("keys")
-    commands+=("policies")
 
     flags=()
     two_word_flags=()
@@ -2676,26 +3202,54 @@ _apictl_get()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_help()
+_apictl_export()
 {
-    last_command="apictl_help"
+    last_command="apictl_export"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("apis")
+    commands+=("app")
+    commands+=("help")
+    commands+=("key-manager")
+    commands+=("policy")
+    commands+=("scope")
 
     flags=()
     two_word_flags=()
@@ -2703,19 +3257,22 @@ _apictl_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_api()
+_apictl_gen_deployment-dir()
 {
-    last_command="apictl_import_api"
+    last_command="apictl_gen_deployment-dir"
 
     command_aliases=()
 
@@ -2727,52 +3284,36 @@ _apictl_import_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_api-product()
+_apictl_gen_help()
 {
-    last_command="apictl_import_api-product"
+    last_command="apictl_gen_help"
 
     command_aliases=()
 
@@ -2784,60 +3325,25 @@ _apictl_import_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--import-apis")
-    local_nonpersistent_flags+=("--import-apis")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update-api-product")
-    local_nonpersistent_flags+=("--update-api-product")
-    flags+=("--update-apis")
-    local_nonpersistent_flags+=("--update-apis")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_app()
+_apictl_gen()
 {
-    last_command="apictl_import_app"
+    last_command="apictl_gen"
 
     command_aliases=()
 
     commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2845,56 +3351,22 @@ _apictl_import_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
-    flags+=("--preserve-owner")
-    local_nonpersistent_flags+=("--preserve-owner")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-keys")
-    local_nonpersistent_flags+=("--skip-keys")
-    flags+=("--skip-subscriptions")
-    flags+=("-s")
-    local_nonpersistent_flags+=("--skip-subscriptions")
-    local_nonpersistent_flags+=("-s")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_help()
+_apictl_generate_help()
 {
-    last_command="apictl_import_help"
+    last_command="apictl_generate_help"
 
     command_aliases=()
 
@@ -2916,9 +3388,9 @@ _apictl_import_help()
     noun_aliases=()
 }
 
-_apictl_import_policy_api()
+_apictl_generate_keys()
 {
-    last_command="apictl_import_policy_api"
+    last_command="apictl_generate_keys"
 
     command_aliases=()
 
@@ -2930,42 +3402,61 @@ _apictl_import_policy_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--callback-url=")
+    two_word_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url")
+    local_nonpersistent_flags+=("--callback-url=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--grant-types=")
+    two_word_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types")
+    local_nonpersistent_flags+=("--grant-types=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--validity-period=")
+    two_word_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--app=")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_policy_help()
+_apictl_generate()
 {
-    last_command="apictl_import_policy_help"
+    last_command="apictl_generate"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("keys")
 
     flags=()
     two_word_flags=()
@@ -2973,19 +3464,22 @@ _apictl_import_policy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_policy_rate-limiting()
+_apictl_get_api-logging()
 {
-    last_command="apictl_import_policy_rate-limiting"
+    last_command="apictl_get_api-logging"
 
     command_aliases=()
 
@@ -2997,26 +3491,30 @@ _apictl_import_policy_rate-limiting()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--api-id=")
+    two_word_flags+=("--api-id")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--api-id")
+    local_nonpersistent_flags+=("--api-id=")
+    local_nonpersistent_flags+=("-i")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--update")
-    flags+=("-u")
-    local_nonpersistent_flags+=("--update")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--tenant-domain=")
+    two_word_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3024,22 +3522,17 @@ _apictl_import_policy_rate-limiting()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_policy()
+_apictl_get_api-product-deps()
 {
-    last_command="apictl_import_policy"
+    last_command="apictl_get_api-product-deps"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
-    commands+=("rate-limiting")
 
     flags=()
     two_word_flags=()
@@ -3047,10 +3540,30 @@ _apictl_import_policy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--project=")
+    two_word_flags+=("--project")
+    local_nonpersistent_flags+=("--project")
+    local_nonpersistent_flags+=("--project=")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3060,18 +3573,13 @@ _apictl_import_policy()
     noun_aliases=()
 }
 
-_apictl_import()
+_apictl_get_api-product-revisions()
 {
-    last_command="apictl_import"
+    last_command="apictl_get_api-product-revisions"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -3079,26 +3587,2790 @@ _apictl_import()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-products()
+{
+    last_command="apictl_get_api-products"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-revisions()
+{
+    last_command="apictl_get_api-revisions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apis()
+{
+    last_command="apictl_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apps()
+{
+    last_command="apictl_get_apps"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_correlation-logging()
+{
+    last_command="apictl_get_correlation-logging"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_docs()
+{
+    last_command="apictl_get_docs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--artifact-type=")
+    two_word_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_envs()
+{
+    last_command="apictl_get_envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_gateway-envs()
+{
+    last_command="apictl_get_gateway-envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_help()
+{
+    last_command="apictl_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_key-managers()
+{
+    last_command="apictl_get_key-managers"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_keys()
+{
+    last_command="apictl_get_keys"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--api-key")
+    local_nonpersistent_flags+=("--api-key")
+    flags+=("--callback-port=")
+    two_word_flags+=("--callback-port")
+    local_nonpersistent_flags+=("--callback-port")
+    local_nonpersistent_flags+=("--callback-port=")
+    flags+=("--decode")
+    local_nonpersistent_flags+=("--decode")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--grant-type=")
+    two_word_flags+=("--grant-type")
+    local_nonpersistent_flags+=("--grant-type")
+    local_nonpersistent_flags+=("--grant-type=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--jwt=")
+    two_word_flags+=("--jwt")
+    local_nonpersistent_flags+=("--jwt")
+    local_nonpersistent_flags+=("--jwt=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--refresh-token=")
+    two_word_flags+=("--refresh-token")
+    local_nonpersistent_flags+=("--refresh-token")
+    local_nonpersistent_flags+=("--refresh-token=")
+    flags+=("--scopes=")
+    two_word_flags+=("--scopes")
+    local_nonpersistent_flags+=("--scopes")
+    local_nonpersistent_flags+=("--scopes=")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--validity-period=")
+    two_word_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period")
+    local_nonpersistent_flags+=("--validity-period=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies_api()
+{
+    last_command="apictl_get_policies_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies_help()
+{
+    last_command="apictl_get_policies_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_policies_rate-limiting()
+{
+    last_command="apictl_get_policies_rate-limiting"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies()
+{
+    last_command="apictl_get_policies"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+    commands+=("rate-limiting")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_scopes()
+{
+    last_command="apictl_get_scopes"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_subscriptions()
+{
+    last_command="apictl_get_subscriptions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--app=")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get()
+{
+    last_command="apictl_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-logging")
+    commands+=("api-product-deps")
+    commands+=("api-product-revisions")
+    commands+=("api-products")
+    commands+=("api-revisions")
+    commands+=("apis")
+    commands+=("apps")
+    commands+=("correlation-logging")
+    commands+=("docs")
+    commands+=("envs")
+    commands+=("gateway-envs")
+    commands+=("help")
+    commands+=("key-managers")
+    commands+=("keys")
+    commands+=("policies")
+    commands+=("scopes")
+    commands+=("subscriptions")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_help()
+{
+    last_command="apictl_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_api()
+{
+    last_command="apictl_import_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--identity-map=")
+    two_word_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map=")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_api-product()
+{
+    last_command="apictl_import_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--identity-map=")
+    two_word_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map=")
+    flags+=("--import-apis")
+    local_nonpersistent_flags+=("--import-apis")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update-api-product")
+    local_nonpersistent_flags+=("--update-api-product")
+    flags+=("--update-apis")
+    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_app()
+{
+    last_command="apictl_import_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--identity-map=")
+    two_word_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map")
+    local_nonpersistent_flags+=("--identity-map=")
+    flags+=("--key-manager-map=")
+    two_word_flags+=("--key-manager-map")
+    local_nonpersistent_flags+=("--key-manager-map")
+    local_nonpersistent_flags+=("--key-manager-map=")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-owner")
+    local_nonpersistent_flags+=("--preserve-owner")
+    flags+=("--regenerate-keys")
+    local_nonpersistent_flags+=("--regenerate-keys")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-keys")
+    local_nonpersistent_flags+=("--skip-keys")
+    flags+=("--skip-subscriptions")
+    flags+=("-s")
+    local_nonpersistent_flags+=("--skip-subscriptions")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_help()
+{
+    last_command="apictl_import_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_key-manager()
+{
+    last_command="apictl_import_key-manager"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy_api()
+{
+    last_command="apictl_import_policy_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy_help()
+{
+    last_command="apictl_import_policy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_policy_rate-limiting()
+{
+    last_command="apictl_import_policy_rate-limiting"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy()
+{
+    last_command="apictl_import_policy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+    commands+=("rate-limiting")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_scope()
+{
+    last_command="apictl_import_scope"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import()
+{
+    last_command="apictl_import"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
+    commands+=("key-manager")
+    commands+=("policy")
+    commands+=("scope")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_init_help()
+{
+    last_command="apictl_init_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_init_policy()
+{
+    last_command="apictl_init_policy"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--flows=")
+    two_word_flags+=("--flows")
+    local_nonpersistent_flags+=("--flows")
+    local_nonpersistent_flags+=("--flows=")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--gateways=")
+    two_word_flags+=("--gateways")
+    local_nonpersistent_flags+=("--gateways")
+    local_nonpersistent_flags+=("--gateways=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_init()
+{
+    last_command="apictl_init"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("policy")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--definition=")
+    two_word_flags+=("--definition")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--initial-state=")
+    two_word_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state=")
+    flags+=("--oas=")
+    two_word_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_api()
+{
+    last_command="apictl_k8s_add_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_help()
+{
+    last_command="apictl_k8s_add_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_add()
+{
+    last_command="apictl_k8s_add"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_api()
+{
+    last_command="apictl_k8s_delete_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_help()
+{
+    last_command="apictl_k8s_delete_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_delete()
+{
+    last_command="apictl_k8s_delete"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_deployment-dir()
+{
+    last_command="apictl_k8s_gen_deployment-dir"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_help()
+{
+    last_command="apictl_k8s_gen_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_gen()
+{
+    last_command="apictl_k8s_gen"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_help()
+{
+    last_command="apictl_k8s_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_update_api()
+{
+    last_command="apictl_k8s_update_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_update_help()
+{
+    last_command="apictl_k8s_update_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_update()
+{
+    last_command="apictl_k8s_update"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s()
+{
+    last_command="apictl_k8s"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("add")
+    commands+=("delete")
+    commands+=("gen")
+    commands+=("help")
+    commands+=("update")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_login()
+{
+    last_command="apictl_login"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_logout()
+{
+    last_command="apictl_logout"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_add_env()
+{
+    last_command="apictl_mg_add_env"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--adapter=")
+    two_word_flags+=("--adapter")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--adapter")
+    local_nonpersistent_flags+=("--adapter=")
+    local_nonpersistent_flags+=("-a")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--adapter=")
+    must_have_one_flag+=("-a")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_add_help()
+{
+    last_command="apictl_mg_add_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_add()
+{
+    last_command="apictl_mg_add"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("env")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_deploy_api()
+{
+    last_command="apictl_mg_deploy_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--override")
+    flags+=("-o")
+    local_nonpersistent_flags+=("--override")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_deploy_help()
+{
+    last_command="apictl_mg_deploy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_deploy()
+{
+    last_command="apictl_mg_deploy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_get_apis()
+{
+    last_command="apictl_mg_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_get_help()
+{
+    last_command="apictl_mg_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_get()
+{
+    last_command="apictl_mg_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("apis")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_help()
+{
+    last_command="apictl_mg_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_login()
+{
+    last_command="apictl_mg_login"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_logout()
+{
+    last_command="apictl_mg_logout"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_remove_env()
+{
+    last_command="apictl_mg_remove_env"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_remove_help()
+{
+    last_command="apictl_mg_remove_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_remove()
+{
+    last_command="apictl_mg_remove"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("env")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_undeploy_api()
+{
+    last_command="apictl_mg_undeploy_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_undeploy_help()
+{
+    last_command="apictl_mg_undeploy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mg_undeploy()
+{
+    last_command="apictl_mg_undeploy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg()
+{
+    last_command="apictl_mg"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("add")
+    commands+=("deploy")
+    commands+=("get")
+    commands+=("help")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("remove")
+    commands+=("undeploy")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_activate_endpoint()
+{
+    last_command="apictl_mi_activate_endpoint"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_activate_help()
+{
+    last_command="apictl_mi_activate_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mi_activate_message-processor()
+{
+    last_command="apictl_mi_activate_message-processor"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_activate_proxy-service()
+{
+    last_command="apictl_mi_activate_proxy-service"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_init()
+_apictl_mi_activate()
 {
-    last_command="apictl_init"
+    last_command="apictl_mi_activate"
 
     command_aliases=()
 
     commands=()
+    commands+=("endpoint")
+    commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -3106,28 +6378,10 @@ _apictl_init()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--definition=")
-    two_word_flags+=("--definition")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--definition")
-    local_nonpersistent_flags+=("--definition=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--force")
-    flags+=("-f")
-    local_nonpersistent_flags+=("--force")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--initial-state=")
-    two_word_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state=")
-    flags+=("--oas=")
-    two_word_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3137,9 +6391,9 @@ _apictl_init()
     noun_aliases=()
 }
 
-_apictl_k8s_add_api()
+_apictl_mi_add_help()
 {
-    last_command="apictl_k8s_add_api"
+    last_command="apictl_mi_add_help"
 
     command_aliases=()
 
@@ -3151,42 +6405,54 @@ _apictl_k8s_add_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mi_add_log-level()
+{
+    last_command="apictl_mi_add_log-level"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_add_help()
+_apictl_mi_add_role()
 {
-    last_command="apictl_k8s_add_help"
+    last_command="apictl_mi_add_role"
 
     command_aliases=()
 
@@ -3198,25 +6464,73 @@ _apictl_k8s_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_add()
+_apictl_mi_add_user()
 {
-    last_command="apictl_k8s_add"
+    last_command="apictl_mi_add_user"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_add()
+{
+    last_command="apictl_mi_add"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
     commands+=("help")
+    commands+=("log-level")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3237,9 +6551,9 @@ _apictl_k8s_add()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_api()
+_apictl_mi_deactivate_endpoint()
 {
-    last_command="apictl_k8s_delete_api"
+    last_command="apictl_mi_deactivate_endpoint"
 
     command_aliases=()
 
@@ -3251,30 +6565,30 @@ _apictl_k8s_delete_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_help()
+_apictl_mi_deactivate_help()
 {
-    last_command="apictl_k8s_delete_help"
+    last_command="apictl_mi_deactivate_help"
 
     command_aliases=()
 
@@ -3296,15 +6610,13 @@ _apictl_k8s_delete_help()
     noun_aliases=()
 }
 
-_apictl_k8s_delete()
+_apictl_mi_deactivate_message-processor()
 {
-    last_command="apictl_k8s_delete"
+    last_command="apictl_mi_deactivate_message-processor"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3312,6 +6624,12 @@ _apictl_k8s_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3321,13 +6639,15 @@ _apictl_k8s_delete()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_deployment-dir()
+_apictl_mi_deactivate_proxy-service()
 {
-    last_command="apictl_k8s_gen_deployment-dir"
+    last_command="apictl_mi_deactivate_proxy-service"
 
     command_aliases=()
 
@@ -3339,36 +6659,61 @@ _apictl_k8s_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_deactivate()
+{
+    last_command="apictl_mi_deactivate"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("endpoint")
+    commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_help()
+_apictl_mi_delete_help()
 {
-    last_command="apictl_k8s_gen_help"
+    last_command="apictl_mi_delete_help"
 
     command_aliases=()
 
@@ -3390,15 +6735,13 @@ _apictl_k8s_gen_help()
     noun_aliases=()
 }
 
-_apictl_k8s_gen()
+_apictl_mi_delete_role()
 {
-    last_command="apictl_k8s_gen"
+    last_command="apictl_mi_delete_role"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3406,6 +6749,18 @@ _apictl_k8s_gen()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3415,13 +6770,15 @@ _apictl_k8s_gen()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_help()
+_apictl_mi_delete_user()
 {
-    last_command="apictl_k8s_help"
+    last_command="apictl_mi_delete_user"
 
     command_aliases=()
 
@@ -3433,23 +6790,43 @@ _apictl_k8s_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_update_api()
+_apictl_mi_delete()
 {
-    last_command="apictl_k8s_update_api"
+    last_command="apictl_mi_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3457,42 +6834,22 @@ _apictl_k8s_update_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_update_help()
+_apictl_mi_get_apis()
 {
-    last_command="apictl_k8s_update_help"
+    last_command="apictl_mi_get_apis"
 
     command_aliases=()
 
@@ -3504,25 +6861,38 @@ _apictl_k8s_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_update()
+_apictl_mi_get_composite-apps()
 {
-    last_command="apictl_k8s_update"
+    last_command="apictl_mi_get_composite-apps"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3530,6 +6900,16 @@ _apictl_k8s_update()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3539,22 +6919,19 @@ _apictl_k8s_update()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s()
+_apictl_mi_get_connectors()
 {
-    last_command="apictl_k8s"
+    last_command="apictl_mi_get_connectors"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("delete")
-    commands+=("gen")
-    commands+=("help")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -3562,6 +6939,16 @@ _apictl_k8s()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3571,13 +6958,15 @@ _apictl_k8s()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_login()
+_apictl_mi_get_data-services()
 {
-    last_command="apictl_login"
+    last_command="apictl_mi_get_data-services"
 
     command_aliases=()
 
@@ -3589,40 +6978,34 @@ _apictl_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_logout()
+_apictl_mi_get_endpoints()
 {
-    last_command="apictl_logout"
+    last_command="apictl_mi_get_endpoints"
 
     command_aliases=()
 
@@ -3634,6 +7017,16 @@ _apictl_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3643,13 +7036,15 @@ _apictl_logout()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_add_env()
+_apictl_mi_get_help()
 {
-    last_command="apictl_mg_add_env"
+    last_command="apictl_mi_get_help"
 
     command_aliases=()
 
@@ -3661,30 +7056,19 @@ _apictl_mg_add_env()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--adapter=")
-    two_word_flags+=("--adapter")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--adapter")
-    local_nonpersistent_flags+=("--adapter=")
-    local_nonpersistent_flags+=("-a")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--adapter=")
-    must_have_one_flag+=("-a")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_add_help()
+_apictl_mi_get_inbound-endpoints()
 {
-    last_command="apictl_mg_add_help"
+    last_command="apictl_mi_get_inbound-endpoints"
 
     command_aliases=()
 
@@ -3696,25 +7080,38 @@ _apictl_mg_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_add()
+_apictl_mi_get_local-entries()
 {
-    last_command="apictl_mg_add"
+    last_command="apictl_mi_get_local-entries"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3722,6 +7119,16 @@ _apictl_mg_add()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3731,13 +7138,15 @@ _apictl_mg_add()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_api()
+_apictl_mi_get_log-levels()
 {
-    last_command="apictl_mg_deploy_api"
+    last_command="apictl_mi_get_log-levels"
 
     command_aliases=()
 
@@ -3754,23 +7163,15 @@ _apictl_mg_deploy_api()
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--override")
-    flags+=("-o")
-    local_nonpersistent_flags+=("--override")
-    local_nonpersistent_flags+=("-o")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3778,15 +7179,13 @@ _apictl_mg_deploy_api()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_help()
+_apictl_mi_get_logs()
 {
-    last_command="apictl_mg_deploy_help"
+    last_command="apictl_mi_get_logs"
 
     command_aliases=()
 
@@ -3798,25 +7197,44 @@ _apictl_mg_deploy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_deploy()
+_apictl_mi_get_message-processors()
 {
-    last_command="apictl_mg_deploy"
+    last_command="apictl_mi_get_message-processors"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3824,6 +7242,16 @@ _apictl_mg_deploy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3833,13 +7261,15 @@ _apictl_mg_deploy()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_get_apis()
+_apictl_mi_get_message-stores()
 {
-    last_command="apictl_mg_get_apis"
+    last_command="apictl_mi_get_message-stores"
 
     command_aliases=()
 
@@ -3857,22 +7287,14 @@ _apictl_mg_get_apis()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3884,9 +7306,9 @@ _apictl_mg_get_apis()
     noun_aliases=()
 }
 
-_apictl_mg_get_help()
+_apictl_mi_get_proxy-services()
 {
-    last_command="apictl_mg_get_help"
+    last_command="apictl_mi_get_proxy-services"
 
     command_aliases=()
 
@@ -3898,25 +7320,38 @@ _apictl_mg_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_get()
+_apictl_mi_get_roles()
 {
-    last_command="apictl_mg_get"
+    last_command="apictl_mi_get_roles"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3924,6 +7359,22 @@ _apictl_mg_get()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3933,13 +7384,15 @@ _apictl_mg_get()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_help()
+_apictl_mi_get_sequences()
 {
-    last_command="apictl_mg_help"
+    last_command="apictl_mi_get_sequences"
 
     command_aliases=()
 
@@ -3951,19 +7404,34 @@ _apictl_mg_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_login()
+_apictl_mi_get_tasks()
 {
-    last_command="apictl_mg_login"
+    last_command="apictl_mi_get_tasks"
 
     command_aliases=()
 
@@ -3975,36 +7443,34 @@ _apictl_mg_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_logout()
+_apictl_mi_get_templates()
 {
-    last_command="apictl_mg_logout"
+    last_command="apictl_mi_get_templates"
 
     command_aliases=()
 
@@ -4016,6 +7482,16 @@ _apictl_mg_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4025,13 +7501,15 @@ _apictl_mg_logout()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_remove_env()
+_apictl_mi_get_transaction-counts()
 {
-    last_command="apictl_mg_remove_env"
+    last_command="apictl_mi_get_transaction-counts"
 
     command_aliases=()
 
@@ -4043,6 +7521,16 @@ _apictl_mg_remove_env()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4052,13 +7540,15 @@ _apictl_mg_remove_env()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_remove_help()
+_apictl_mi_get_transaction-reports()
 {
-    last_command="apictl_mg_remove_help"
+    last_command="apictl_mi_get_transaction-reports"
 
     command_aliases=()
 
@@ -4070,25 +7560,40 @@ _apictl_mg_remove_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_remove()
+_apictl_mi_get_users()
 {
-    last_command="apictl_mg_remove"
+    last_command="apictl_mi_get_users"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -4096,26 +7601,76 @@ _apictl_mg_remove()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--role=")
+    two_word_flags+=("--role")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--role")
+    local_nonpersistent_flags+=("--role=")
+    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_api()
+_apictl_mi_get()
 {
-    last_command="apictl_mg_undeploy_api"
+    last_command="apictl_mi_get"
 
     command_aliases=()
 
     commands=()
+    commands+=("apis")
+    commands+=("composite-apps")
+    commands+=("connectors")
+    commands+=("data-services")
+    commands+=("endpoints")
+    commands+=("help")
+    commands+=("inbound-endpoints")
+    commands+=("local-entries")
+    commands+=("log-levels")
+    commands+=("logs")
+    commands+=("message-processors")
+    commands+=("message-stores")
+    commands+=("proxy-services")
+    commands+=("roles")
+    commands+=("sequences")
+    commands+=("tasks")
+    commands+=("templates")
+    commands+=("transaction-counts")
+    commands+=("transaction-reports")
+    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -4123,58 +7678,22 @@ _apictl_mg_undeploy_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--gateway-env=")
-    two_word_flags+=("--gateway-env")
-    two_word_flags+=("-g")
-    local_nonpersistent_flags+=("--gateway-env")
-    local_nonpersistent_flags+=("--gateway-env=")
-    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
-    flags+=("--vhost=")
-    two_word_flags+=("--vhost")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--vhost")
-    local_nonpersistent_flags+=("--vhost=")
-    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_help()
+_apictl_mi_help()
 {
-    last_command="apictl_mg_undeploy_help"
+    last_command="apictl_mi_help"
 
     command_aliases=()
 
@@ -4196,15 +7715,13 @@ _apictl_mg_undeploy_help()
     noun_aliases=()
 }
 
-_apictl_mg_undeploy()
+_apictl_mi_login()
 {
-    last_command="apictl_mg_undeploy"
+    last_command="apictl_mi_login"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -4216,6 +7733,20 @@ _apictl_mg_undeploy()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4225,21 +7756,13 @@ _apictl_mg_undeploy()
     noun_aliases=()
 }
 
-_apictl_mg()
+_apictl_mi_logout()
 {
-    last_command="apictl_mg"
+    last_command="apictl_mi_logout"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("deploy")
-    commands+=("get")
-    commands+=("help")
-    commands+=("login")
-    commands+=("logout")
-    commands+=("remove")
-    commands+=("undeploy")
 
     flags=()
     two_word_flags=()
@@ -4260,9 +7783,9 @@ _apictl_mg()
     noun_aliases=()
 }
 
-_apictl_mi_activate_endpoint()
+_apictl_mi_update_hashicorp-secret()
 {
-    last_command="apictl_mi_activate_endpoint"
+    last_command="apictl_mi_update_hashicorp-secret"
 
     command_aliases=()
 
@@ -4295,9 +7818,9 @@ _apictl_mi_activate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_activate_help()
+_apictl_mi_update_help()
 {
-    last_command="apictl_mi_activate_help"
+    last_command="apictl_mi_update_help"
 
     command_aliases=()
 
@@ -4319,9 +7842,9 @@ _apictl_mi_activate_help()
     noun_aliases=()
 }
 
-_apictl_mi_activate_message-processor()
+_apictl_mi_update_log-level()
 {
-    last_command="apictl_mi_activate_message-processor"
+    last_command="apictl_mi_update_log-level"
 
     command_aliases=()
 
@@ -4354,9 +7877,9 @@ _apictl_mi_activate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_activate_proxy-service()
+_apictl_mi_update_user()
 {
-    last_command="apictl_mi_activate_proxy-service"
+    last_command="apictl_mi_update_user"
 
     command_aliases=()
 
@@ -4389,17 +7912,17 @@ _apictl_mi_activate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_activate()
+_apictl_mi_update()
 {
-    last_command="apictl_mi_activate"
+    last_command="apictl_mi_update"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
+    commands+=("hashicorp-secret")
     commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
+    commands+=("log-level")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4420,9 +7943,45 @@ _apictl_mi_activate()
     noun_aliases=()
 }
 
-_apictl_mi_add_help()
+_apictl_mi()
 {
-    last_command="apictl_mi_add_help"
+    last_command="apictl_mi"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("activate")
+    commands+=("add")
+    commands+=("deactivate")
+    commands+=("delete")
+    commands+=("get")
+    commands+=("help")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("update")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_migrate_help()
+{
+    last_command="apictl_migrate_help"
 
     command_aliases=()
 
@@ -4444,9 +8003,9 @@ _apictl_mi_add_help()
     noun_aliases=()
 }
 
-_apictl_mi_add_log-level()
+_apictl_migrate_project()
 {
-    last_command="apictl_mi_add_log-level"
+    last_command="apictl_migrate_project"
 
     command_aliases=()
 
@@ -4458,34 +8017,35 @@ _apictl_mi_add_log-level()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--to=")
+    two_word_flags+=("--to")
+    local_nonpersistent_flags+=("--to")
+    local_nonpersistent_flags+=("--to=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--to=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add_role()
+_apictl_migrate()
 {
-    last_command="apictl_mi_add_role"
+    last_command="apictl_migrate"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("project")
 
     flags=()
     two_word_flags=()
@@ -4493,12 +8053,6 @@ _apictl_mi_add_role()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4508,15 +8062,13 @@ _apictl_mi_add_role()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add_user()
+_apictl_mock_api()
 {
-    last_command="apictl_mi_add_user"
+    last_command="apictl_mock_api"
 
     command_aliases=()
 
@@ -4528,38 +8080,58 @@ _apictl_mi_add_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--port=")
+    two_word_flags+=("--port")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--port")
+    local_nonpersistent_flags+=("--port=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add()
+_apictl_mock_help()
 {
-    last_command="apictl_mi_add"
+    last_command="apictl_mock_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mock()
+{
+    last_command="apictl_mock"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
     commands+=("help")
-    commands+=("log-level")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4580,9 +8152,9 @@ _apictl_mi_add()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_endpoint()
+_apictl_new-version_api()
 {
-    last_command="apictl_mi_deactivate_endpoint"
+    last_command="apictl_new-version_api"
 
     command_aliases=()
 
@@ -4594,6 +8166,14 @@ _apictl_mi_deactivate_endpoint()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--copy-docs")
+    local_nonpersistent_flags+=("--copy-docs")
+    flags+=("--copy-policies")
+    local_nonpersistent_flags+=("--copy-policies")
+    flags+=("--default-version")
+    local_nonpersistent_flags+=("--default-version")
+    flags+=("--deprecate-old")
+    local_nonpersistent_flags+=("--deprecate-old")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4604,6 +8184,34 @@ _apictl_mi_deactivate_endpoint()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--new-version=")
+    two_word_flags+=("--new-version")
+    local_nonpersistent_flags+=("--new-version")
+    local_nonpersistent_flags+=("--new-version=")
+    flags+=("--project=")
+    two_word_flags+=("--project")
+    local_nonpersistent_flags+=("--project")
+    local_nonpersistent_flags+=("--project=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--publish")
+    local_nonpersistent_flags+=("--publish")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4611,13 +8219,18 @@ _apictl_mi_deactivate_endpoint()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--new-version=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_help()
+_apictl_new-version_help()
 {
-    last_command="apictl_mi_deactivate_help"
+    last_command="apictl_new-version_help"
 
     command_aliases=()
 
@@ -4639,13 +8252,15 @@ _apictl_mi_deactivate_help()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_message-processor()
+_apictl_new-version()
 {
-    last_command="apictl_mi_deactivate_message-processor"
+    last_command="apictl_new-version"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -4653,12 +8268,6 @@ _apictl_mi_deactivate_message-processor()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4668,15 +8277,13 @@ _apictl_mi_deactivate_message-processor()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_proxy-service()
+_apictl_prune_help()
 {
-    last_command="apictl_mi_deactivate_proxy-service"
+    last_command="apictl_prune_help"
 
     command_aliases=()
 
@@ -4688,38 +8295,23 @@ _apictl_mi_deactivate_proxy-service()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_deactivate()
+_apictl_prune_revisions()
 {
-    last_command="apictl_mi_deactivate"
+    last_command="apictl_prune_revisions"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -4727,26 +8319,71 @@ _apictl_mi_deactivate()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--keep=")
+    two_word_flags+=("--keep")
+    local_nonpersistent_flags+=("--keep")
+    local_nonpersistent_flags+=("--keep=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--keep=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_delete_help()
+_apictl_prune()
 {
-    last_command="apictl_mi_delete_help"
+    last_command="apictl_prune"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("revisions")
 
     flags=()
     two_word_flags=()
@@ -4754,19 +8391,22 @@ _apictl_mi_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_delete_role()
+_apictl_pull()
 {
-    last_command="apictl_mi_delete_role"
+    last_command="apictl_pull"
 
     command_aliases=()
 
@@ -4778,36 +8418,38 @@ _apictl_mi_delete_role()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
     two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
     local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    flags+=("--plain-http")
+    local_nonpersistent_flags+=("--plain-http")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_delete_user()
+_apictl_push_api()
 {
-    last_command="apictl_mi_delete_user"
+    last_command="apictl_push_api"
 
     command_aliases=()
 
@@ -4819,43 +8461,36 @@ _apictl_mi_delete_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    flags+=("--plain-http")
+    local_nonpersistent_flags+=("--plain-http")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_delete()
+_apictl_push_api-product()
 {
-    last_command="apictl_mi_delete"
+    last_command="apictl_push_api-product"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4867,6 +8502,16 @@ _apictl_mi_delete()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    flags+=("--plain-http")
+    local_nonpersistent_flags+=("--plain-http")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4876,9 +8521,9 @@ _apictl_mi_delete()
     noun_aliases=()
 }
 
-_apictl_mi_get_apis()
+_apictl_push_app()
 {
-    last_command="apictl_mi_get_apis"
+    last_command="apictl_push_app"
 
     command_aliases=()
 
@@ -4890,34 +8535,32 @@ _apictl_mi_get_apis()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    flags+=("--plain-http")
+    local_nonpersistent_flags+=("--plain-http")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_composite-apps()
+_apictl_push_help()
 {
-    last_command="apictl_mi_get_composite-apps"
+    last_command="apictl_push_help"
 
     command_aliases=()
 
@@ -4929,38 +8572,27 @@ _apictl_mi_get_composite-apps()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_connectors()
+_apictl_push()
 {
-    last_command="apictl_mi_get_connectors"
+    last_command="apictl_push"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -4968,16 +8600,6 @@ _apictl_mi_get_connectors()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4987,15 +8609,13 @@ _apictl_mi_get_connectors()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_data-services()
+_apictl_recycle-bin_help()
 {
-    last_command="apictl_mi_get_data-services"
+    last_command="apictl_recycle-bin_help"
 
     command_aliases=()
 
@@ -5007,34 +8627,19 @@ _apictl_mi_get_data-services()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_endpoints()
+_apictl_recycle-bin_list()
 {
-    last_command="apictl_mi_get_endpoints"
+    last_command="apictl_recycle-bin_list"
 
     command_aliases=()
 
@@ -5046,12 +8651,6 @@ _apictl_mi_get_endpoints()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -5065,19 +8664,19 @@ _apictl_mi_get_endpoints()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_help()
+_apictl_recycle-bin()
 {
-    last_command="apictl_mi_get_help"
+    last_command="apictl_recycle-bin"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("list")
 
     flags=()
     two_word_flags=()
@@ -5085,19 +8684,22 @@ _apictl_mi_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_inbound-endpoints()
+_apictl_remove_env()
 {
-    last_command="apictl_mi_get_inbound-endpoints"
+    last_command="apictl_remove_env"
 
     command_aliases=()
 
@@ -5109,16 +8711,6 @@ _apictl_mi_get_inbound-endpoints()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5128,15 +8720,13 @@ _apictl_mi_get_inbound-endpoints()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_local-entries()
+_apictl_remove_help()
 {
-    last_command="apictl_mi_get_local-entries"
+    last_command="apictl_remove_help"
 
     command_aliases=()
 
@@ -5148,38 +8738,25 @@ _apictl_mi_get_local-entries()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_log-levels()
+_apictl_remove()
 {
-    last_command="apictl_mi_get_log-levels"
+    last_command="apictl_remove"
 
     command_aliases=()
 
     commands=()
+    commands+=("env")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -5187,16 +8764,6 @@ _apictl_mi_get_log-levels()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5206,15 +8773,13 @@ _apictl_mi_get_log-levels()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_logs()
+_apictl_render_help()
 {
-    last_command="apictl_mi_get_logs"
+    last_command="apictl_render_help"
 
     command_aliases=()
 
@@ -5226,40 +8791,19 @@ _apictl_mi_get_logs()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_message-processors()
+_apictl_render_policy()
 {
-    last_command="apictl_mi_get_message-processors"
+    last_command="apictl_render_policy"
 
     command_aliases=()
 
@@ -5271,38 +8815,44 @@ _apictl_mi_get_message-processors()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--gateway=")
+    two_word_flags+=("--gateway")
+    local_nonpersistent_flags+=("--gateway")
+    local_nonpersistent_flags+=("--gateway=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-stores()
+_apictl_render()
 {
-    last_command="apictl_mi_get_message-stores"
+    last_command="apictl_render"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -5310,16 +8860,6 @@ _apictl_mi_get_message-stores()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5329,15 +8869,13 @@ _apictl_mi_get_message-stores()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_proxy-services()
+_apictl_restore_help()
 {
-    last_command="apictl_mi_get_proxy-services"
+    last_command="apictl_restore_help"
 
     command_aliases=()
 
@@ -5349,34 +8887,19 @@ _apictl_mi_get_proxy-services()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_roles()
+_apictl_restore_revision()
 {
-    last_command="apictl_mi_get_roles"
+    last_command="apictl_restore_revision"
 
     command_aliases=()
 
@@ -5388,26 +8911,44 @@ _apictl_mi_get_roles()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -5415,17 +8956,24 @@ _apictl_mi_get_roles()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--rev=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_sequences()
+_apictl_restore()
 {
-    last_command="apictl_mi_get_sequences"
+    last_command="apictl_restore"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("revision")
 
     flags=()
     two_word_flags=()
@@ -5433,16 +8981,6 @@ _apictl_mi_get_sequences()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5452,15 +8990,13 @@ _apictl_mi_get_sequences()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_tasks()
+_apictl_rollout_api()
 {
-    last_command="apictl_mi_get_tasks"
+    last_command="apictl_rollout_api"
 
     command_aliases=()
 
@@ -5472,20 +9008,60 @@ _apictl_mi_get_tasks()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--abort")
+    local_nonpersistent_flags+=("--abort")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--resume")
+    local_nonpersistent_flags+=("--resume")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--stages=")
+    two_word_flags+=("--stages")
+    local_nonpersistent_flags+=("--stages")
+    local_nonpersistent_flags+=("--stages=")
+    flags+=("--status")
+    local_nonpersistent_flags+=("--status")
+    flags+=("--verify=")
+    two_word_flags+=("--verify")
+    local_nonpersistent_flags+=("--verify")
+    local_nonpersistent_flags+=("--verify=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    flags+=("--wait=")
+    two_word_flags+=("--wait")
+    local_nonpersistent_flags+=("--wait")
+    local_nonpersistent_flags+=("--wait=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -5493,13 +9069,17 @@ _apictl_mi_get_tasks()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_templates()
+_apictl_rollout_help()
 {
-    last_command="apictl_mi_get_templates"
+    last_command="apictl_rollout_help"
 
     command_aliases=()
 
@@ -5511,55 +9091,32 @@ _apictl_mi_get_templates()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-counts()
+_apictl_rollout()
 {
-    last_command="apictl_mi_get_transaction-counts"
+    last_command="apictl_rollout"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
 
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5569,15 +9126,13 @@ _apictl_mi_get_transaction-counts()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-reports()
+_apictl_rotate_app-keys()
 {
-    last_command="apictl_mi_get_transaction-reports"
+    last_command="apictl_rotate_app-keys"
 
     command_aliases=()
 
@@ -5589,22 +9144,68 @@ _apictl_mi_get_transaction-reports()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--all-apps")
+    local_nonpersistent_flags+=("--all-apps")
+    flags+=("--api-key-validity-period=")
+    two_word_flags+=("--api-key-validity-period")
+    local_nonpersistent_flags+=("--api-key-validity-period")
+    local_nonpersistent_flags+=("--api-key-validity-period=")
+    flags+=("--api-keys")
+    local_nonpersistent_flags+=("--api-keys")
+    flags+=("--app=")
+    two_word_flags+=("--app")
+    local_nonpersistent_flags+=("--app")
+    local_nonpersistent_flags+=("--app=")
+    flags+=("--audit-log=")
+    two_word_flags+=("--audit-log")
+    local_nonpersistent_flags+=("--audit-log")
+    local_nonpersistent_flags+=("--audit-log=")
+    flags+=("--cipher=")
+    two_word_flags+=("--cipher")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--cipher")
+    local_nonpersistent_flags+=("--cipher=")
+    local_nonpersistent_flags+=("-c")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    flags+=("--grace-period=")
+    two_word_flags+=("--grace-period")
+    local_nonpersistent_flags+=("--grace-period")
+    local_nonpersistent_flags+=("--grace-period=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--secret-name=")
+    two_word_flags+=("--secret-name")
+    local_nonpersistent_flags+=("--secret-name")
+    local_nonpersistent_flags+=("--secret-name=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -5616,9 +9217,9 @@ _apictl_mi_get_transaction-reports()
     noun_aliases=()
 }
 
-_apictl_mi_get_users()
+_apictl_rotate_help()
 {
-    last_command="apictl_mi_get_users"
+    last_command="apictl_rotate_help"
 
     command_aliases=()
 
@@ -5630,76 +9231,25 @@ _apictl_mi_get_users()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--pattern=")
-    two_word_flags+=("--pattern")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--pattern")
-    local_nonpersistent_flags+=("--pattern=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--role=")
-    two_word_flags+=("--role")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--role")
-    local_nonpersistent_flags+=("--role=")
-    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get()
+_apictl_rotate()
 {
-    last_command="apictl_mi_get"
+    last_command="apictl_rotate"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
-    commands+=("composite-apps")
-    commands+=("connectors")
-    commands+=("data-services")
-    commands+=("endpoints")
+    commands+=("app-keys")
     commands+=("help")
-    commands+=("inbound-endpoints")
-    commands+=("local-entries")
-    commands+=("log-levels")
-    commands+=("logs")
-    commands+=("message-processors")
-    commands+=("message-stores")
-    commands+=("proxy-services")
-    commands+=("roles")
-    commands+=("sequences")
-    commands+=("tasks")
-    commands+=("templates")
-    commands+=("transaction-counts")
-    commands+=("transaction-reports")
-    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -5720,9 +9270,9 @@ _apictl_mi_get()
     noun_aliases=()
 }
 
-_apictl_mi_help()
+_apictl_search()
 {
-    last_command="apictl_mi_help"
+    last_command="apictl_search"
 
     command_aliases=()
 
@@ -5734,19 +9284,40 @@ _apictl_mi_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_login()
+_apictl_secret_create()
 {
-    last_command="apictl_mi_login"
+    last_command="apictl_secret_create"
 
     command_aliases=()
 
@@ -5758,24 +9329,28 @@ _apictl_mi_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cipher=")
+    two_word_flags+=("--cipher")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--cipher")
+    local_nonpersistent_flags+=("--cipher=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -5785,9 +9360,9 @@ _apictl_mi_login()
     noun_aliases=()
 }
 
-_apictl_mi_logout()
+_apictl_secret_help()
 {
-    last_command="apictl_mi_logout"
+    last_command="apictl_secret_help"
 
     command_aliases=()
 
@@ -5799,22 +9374,19 @@ _apictl_mi_logout()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_update_hashicorp-secret()
+_apictl_secret_init()
 {
-    last_command="apictl_mi_update_hashicorp-secret"
+    last_command="apictl_secret_init"
 
     command_aliases=()
 
@@ -5826,12 +9398,6 @@ _apictl_mi_update_hashicorp-secret()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5841,19 +9407,20 @@ _apictl_mi_update_hashicorp-secret()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_help()
+_apictl_secret()
 {
-    last_command="apictl_mi_update_help"
+    last_command="apictl_secret"
 
     command_aliases=()
 
     commands=()
+    commands+=("create")
+    commands+=("help")
+    commands+=("init")
 
     flags=()
     two_word_flags=()
@@ -5861,19 +9428,22 @@ _apictl_mi_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_update_log-level()
+_apictl_set_api-logging()
 {
-    last_command="apictl_mi_update_log-level"
+    last_command="apictl_set_api-logging"
 
     command_aliases=()
 
@@ -5885,6 +9455,12 @@ _apictl_mi_update_log-level()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--api-id=")
+    two_word_flags+=("--api-id")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--api-id")
+    local_nonpersistent_flags+=("--api-id=")
+    local_nonpersistent_flags+=("-i")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -5895,20 +9471,31 @@ _apictl_mi_update_log-level()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--log-level=")
+    two_word_flags+=("--log-level")
+    local_nonpersistent_flags+=("--log-level")
+    local_nonpersistent_flags+=("--log-level=")
+    flags+=("--tenant-domain=")
+    two_word_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--api-id=")
+    must_have_one_flag+=("-i")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--log-level=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_user()
+_apictl_set_correlation-logging()
 {
-    last_command="apictl_mi_update_user"
+    last_command="apictl_set_correlation-logging"
 
     command_aliases=()
 
@@ -5920,6 +9507,20 @@ _apictl_mi_update_user()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--component-name=")
+    two_word_flags+=("--component-name")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--component-name")
+    local_nonpersistent_flags+=("--component-name=")
+    local_nonpersistent_flags+=("-i")
+    flags+=("--denied-threads=")
+    two_word_flags+=("--denied-threads")
+    local_nonpersistent_flags+=("--denied-threads")
+    local_nonpersistent_flags+=("--denied-threads=")
+    flags+=("--enable=")
+    two_word_flags+=("--enable")
+    local_nonpersistent_flags+=("--enable")
+    local_nonpersistent_flags+=("--enable=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -5935,23 +9536,21 @@ _apictl_mi_update_user()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--component-name=")
+    must_have_one_flag+=("-i")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update()
+_apictl_set_help()
 {
-    last_command="apictl_mi_update"
+    last_command="apictl_set_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("hashicorp-secret")
-    commands+=("help")
-    commands+=("log-level")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -5959,35 +9558,26 @@ _apictl_mi_update()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi()
+_apictl_set()
 {
-    last_command="apictl_mi"
+    last_command="apictl_set"
 
     command_aliases=()
 
     commands=()
-    commands+=("activate")
-    commands+=("add")
-    commands+=("deactivate")
-    commands+=("delete")
-    commands+=("get")
+    commands+=("api-logging")
+    commands+=("correlation-logging")
     commands+=("help")
-    commands+=("login")
-    commands+=("logout")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -5995,10 +9585,48 @@ _apictl_mi()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--ai-thread-count=")
+    two_word_flags+=("--ai-thread-count")
+    local_nonpersistent_flags+=("--ai-thread-count")
+    local_nonpersistent_flags+=("--ai-thread-count=")
+    flags+=("--ai-token=")
+    two_word_flags+=("--ai-token")
+    local_nonpersistent_flags+=("--ai-token")
+    local_nonpersistent_flags+=("--ai-token=")
+    flags+=("--export-directory=")
+    two_word_flags+=("--export-directory")
+    local_nonpersistent_flags+=("--export-directory")
+    local_nonpersistent_flags+=("--export-directory=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--http-request-timeout=")
+    two_word_flags+=("--http-request-timeout")
+    local_nonpersistent_flags+=("--http-request-timeout")
+    local_nonpersistent_flags+=("--http-request-timeout=")
+    flags+=("--recycle-bin-max-size=")
+    two_word_flags+=("--recycle-bin-max-size")
+    local_nonpersistent_flags+=("--recycle-bin-max-size")
+    local_nonpersistent_flags+=("--recycle-bin-max-size=")
+    flags+=("--tls-renegotiation-mode=")
+    two_word_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode=")
+    flags+=("--vcs-config-path=")
+    two_word_flags+=("--vcs-config-path")
+    local_nonpersistent_flags+=("--vcs-config-path")
+    local_nonpersistent_flags+=("--vcs-config-path=")
+    flags+=("--vcs-deletion-enabled")
+    local_nonpersistent_flags+=("--vcs-deletion-enabled")
+    flags+=("--vcs-deployment-repo-path=")
+    two_word_flags+=("--vcs-deployment-repo-path")
+    local_nonpersistent_flags+=("--vcs-deployment-repo-path")
+    local_nonpersistent_flags+=("--vcs-deployment-repo-path=")
+    flags+=("--vcs-source-repo-path=")
+    two_word_flags+=("--vcs-source-repo-path")
+    local_nonpersistent_flags+=("--vcs-source-repo-path")
+    local_nonpersistent_flags+=("--vcs-source-repo-path=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -6008,9 +9636,9 @@ _apictl_mi()
     noun_aliases=()
 }
 
-_apictl_remove_env()
+_apictl_sync_docs()
 {
-    last_command="apictl_remove_env"
+    last_command="apictl_sync_docs"
 
     command_aliases=()
 
@@ -6022,22 +9650,72 @@ _apictl_remove_env()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--artifact-type=")
+    two_word_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type=")
+    flags+=("--dir=")
+    two_word_flags+=("--dir")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--dir")
+    local_nonpersistent_flags+=("--dir=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--prune")
+    local_nonpersistent_flags+=("--prune")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--yes")
+    flags+=("-y")
+    local_nonpersistent_flags+=("--yes")
+    local_nonpersistent_flags+=("-y")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--dir=")
+    must_have_one_flag+=("-d")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_remove_help()
+_apictl_sync_help()
 {
-    last_command="apictl_remove_help"
+    last_command="apictl_sync_help"
 
     command_aliases=()
 
@@ -6059,14 +9737,14 @@ _apictl_remove_help()
     noun_aliases=()
 }
 
-_apictl_remove()
+_apictl_sync()
 {
-    last_command="apictl_remove"
+    last_command="apictl_sync"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
+    commands+=("docs")
     commands+=("help")
 
     flags=()
@@ -6088,9 +9766,9 @@ _apictl_remove()
     noun_aliases=()
 }
 
-_apictl_secret_create()
+_apictl_test_api()
 {
-    last_command="apictl_secret_create"
+    last_command="apictl_test_api"
 
     command_aliases=()
 
@@ -6102,28 +9780,60 @@ _apictl_secret_create()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--cipher=")
-    two_word_flags+=("--cipher")
-    two_word_flags+=("-c")
-    local_nonpersistent_flags+=("--cipher")
-    local_nonpersistent_flags+=("--cipher=")
-    local_nonpersistent_flags+=("-c")
-    flags+=("--from-file=")
-    two_word_flags+=("--from-file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--from-file")
-    local_nonpersistent_flags+=("--from-file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--access-token=")
+    two_word_flags+=("--access-token")
+    local_nonpersistent_flags+=("--access-token")
+    local_nonpersistent_flags+=("--access-token=")
+    flags+=("--definition=")
+    two_word_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    flags+=("--gateway-url=")
+    two_word_flags+=("--gateway-url")
+    local_nonpersistent_flags+=("--gateway-url")
+    local_nonpersistent_flags+=("--gateway-url=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--output=")
-    two_word_flags+=("--output")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--output")
-    local_nonpersistent_flags+=("--output=")
-    local_nonpersistent_flags+=("-o")
+    flags+=("--junit=")
+    two_word_flags+=("--junit")
+    local_nonpersistent_flags+=("--junit")
+    local_nonpersistent_flags+=("--junit=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -6133,9 +9843,9 @@ _apictl_secret_create()
     noun_aliases=()
 }
 
-_apictl_secret_help()
+_apictl_test_help()
 {
-    last_command="apictl_secret_help"
+    last_command="apictl_test_help"
 
     command_aliases=()
 
@@ -6157,13 +9867,15 @@ _apictl_secret_help()
     noun_aliases=()
 }
 
-_apictl_secret_init()
+_apictl_test()
 {
-    last_command="apictl_secret_init"
+    last_command="apictl_test"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -6184,16 +9896,13 @@ _apictl_secret_init()
     noun_aliases=()
 }
 
-_apictl_secret()
+_apictl_undeploy_api()
 {
-    last_command="apictl_secret"
+    last_command="apictl_undeploy_api"
 
     command_aliases=()
 
     commands=()
-    commands+=("create")
-    commands+=("help")
-    commands+=("init")
 
     flags=()
     two_word_flags=()
@@ -6201,22 +9910,65 @@ _apictl_secret()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--rev=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_set_api-logging()
+_apictl_undeploy_api-product()
 {
-    last_command="apictl_set_api-logging"
+    last_command="apictl_undeploy_api-product"
 
     command_aliases=()
 
@@ -6228,51 +9980,96 @@ _apictl_set_api-logging()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--api-id=")
-    two_word_flags+=("--api-id")
-    two_word_flags+=("-i")
-    local_nonpersistent_flags+=("--api-id")
-    local_nonpersistent_flags+=("--api-id=")
-    local_nonpersistent_flags+=("-i")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--log-level=")
-    two_word_flags+=("--log-level")
-    local_nonpersistent_flags+=("--log-level")
-    local_nonpersistent_flags+=("--log-level=")
-    flags+=("--tenant-domain=")
-    two_word_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--skip-backup")
+    local_nonpersistent_flags+=("--skip-backup")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--rev=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_undeploy_help()
+{
+    last_command="apictl_undeploy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--api-id=")
-    must_have_one_flag+=("-i")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--log-level=")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_set_correlation-logging()
+_apictl_undeploy()
 {
-    last_command="apictl_set_correlation-logging"
+    last_command="apictl_undeploy"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -6280,26 +10077,6 @@ _apictl_set_correlation-logging()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--component-name=")
-    two_word_flags+=("--component-name")
-    two_word_flags+=("-i")
-    local_nonpersistent_flags+=("--component-name")
-    local_nonpersistent_flags+=("--component-name=")
-    local_nonpersistent_flags+=("-i")
-    flags+=("--denied-threads=")
-    two_word_flags+=("--denied-threads")
-    local_nonpersistent_flags+=("--denied-threads")
-    local_nonpersistent_flags+=("--denied-threads=")
-    flags+=("--enable=")
-    two_word_flags+=("--enable")
-    local_nonpersistent_flags+=("--enable")
-    local_nonpersistent_flags+=("--enable=")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -6309,17 +10086,13 @@ _apictl_set_correlation-logging()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--component-name=")
-    must_have_one_flag+=("-i")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_set_help()
+_apictl_undo()
 {
-    last_command="apictl_set_help"
+    last_command="apictl_undo"
 
     command_aliases=()
 
@@ -6331,26 +10104,26 @@ _apictl_set_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_set()
+_apictl_update_app()
 {
-    last_command="apictl_set"
+    last_command="apictl_update_app"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-logging")
-    commands+=("correlation-logging")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -6358,56 +10131,54 @@ _apictl_set()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--ai-thread-count=")
-    two_word_flags+=("--ai-thread-count")
-    local_nonpersistent_flags+=("--ai-thread-count")
-    local_nonpersistent_flags+=("--ai-thread-count=")
-    flags+=("--ai-token=")
-    two_word_flags+=("--ai-token")
-    local_nonpersistent_flags+=("--ai-token")
-    local_nonpersistent_flags+=("--ai-token=")
-    flags+=("--export-directory=")
-    two_word_flags+=("--export-directory")
-    local_nonpersistent_flags+=("--export-directory")
-    local_nonpersistent_flags+=("--export-directory=")
+    flags+=("--description=")
+    two_word_flags+=("--description")
+    local_nonpersistent_flags+=("--description")
+    local_nonpersistent_flags+=("--description=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--http-request-timeout=")
-    two_word_flags+=("--http-request-timeout")
-    local_nonpersistent_flags+=("--http-request-timeout")
-    local_nonpersistent_flags+=("--http-request-timeout=")
-    flags+=("--tls-renegotiation-mode=")
-    two_word_flags+=("--tls-renegotiation-mode")
-    local_nonpersistent_flags+=("--tls-renegotiation-mode")
-    local_nonpersistent_flags+=("--tls-renegotiation-mode=")
-    flags+=("--vcs-config-path=")
-    two_word_flags+=("--vcs-config-path")
-    local_nonpersistent_flags+=("--vcs-config-path")
-    local_nonpersistent_flags+=("--vcs-config-path=")
-    flags+=("--vcs-deletion-enabled")
-    local_nonpersistent_flags+=("--vcs-deletion-enabled")
-    flags+=("--vcs-deployment-repo-path=")
-    two_word_flags+=("--vcs-deployment-repo-path")
-    local_nonpersistent_flags+=("--vcs-deployment-repo-path")
-    local_nonpersistent_flags+=("--vcs-deployment-repo-path=")
-    flags+=("--vcs-source-repo-path=")
-    two_word_flags+=("--vcs-source-repo-path")
-    local_nonpersistent_flags+=("--vcs-source-repo-path")
-    local_nonpersistent_flags+=("--vcs-source-repo-path=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--new-name=")
+    two_word_flags+=("--new-name")
+    local_nonpersistent_flags+=("--new-name")
+    local_nonpersistent_flags+=("--new-name=")
+    flags+=("--throttling-policy=")
+    two_word_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy=")
+    flags+=("--token-type=")
+    two_word_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type")
+    local_nonpersistent_flags+=("--token-type=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_undeploy_api()
+_apictl_update_doc()
 {
-    last_command="apictl_undeploy_api"
+    last_command="apictl_update_doc"
 
     command_aliases=()
 
@@ -6419,18 +10190,26 @@ _apictl_undeploy_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--artifact-type=")
+    two_word_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type")
+    local_nonpersistent_flags+=("--artifact-type=")
+    flags+=("--doc-name=")
+    two_word_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name")
+    local_nonpersistent_flags+=("--doc-name=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--gateway-env=")
-    two_word_flags+=("--gateway-env")
-    two_word_flags+=("-g")
-    local_nonpersistent_flags+=("--gateway-env")
-    local_nonpersistent_flags+=("--gateway-env=")
-    local_nonpersistent_flags+=("-g")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -6441,41 +10220,61 @@ _apictl_undeploy_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--other-type-name=")
+    two_word_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name")
+    local_nonpersistent_flags+=("--other-type-name=")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
+    flags+=("--source-type=")
+    two_word_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type")
+    local_nonpersistent_flags+=("--source-type=")
+    flags+=("--source-url=")
+    two_word_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url")
+    local_nonpersistent_flags+=("--source-url=")
+    flags+=("--summary=")
+    two_word_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary")
+    local_nonpersistent_flags+=("--summary=")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
     local_nonpersistent_flags+=("--version")
     local_nonpersistent_flags+=("--version=")
     local_nonpersistent_flags+=("-v")
+    flags+=("--visibility=")
+    two_word_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility")
+    local_nonpersistent_flags+=("--visibility=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--doc-name=")
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--rev=")
     must_have_one_flag+=("--version=")
     must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_undeploy_api-product()
+_apictl_update_gateway-env()
 {
-    last_command="apictl_undeploy_api-product"
+    last_command="apictl_update_gateway-env"
 
     command_aliases=()
 
@@ -6487,18 +10286,22 @@ _apictl_undeploy_api-product()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--description=")
+    two_word_flags+=("--description")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--description")
+    local_nonpersistent_flags+=("--description=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--display-name=")
+    two_word_flags+=("--display-name")
+    local_nonpersistent_flags+=("--display-name")
+    local_nonpersistent_flags+=("--display-name=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--gateway-env=")
-    two_word_flags+=("--gateway-env")
-    two_word_flags+=("-g")
-    local_nonpersistent_flags+=("--gateway-env")
-    local_nonpersistent_flags+=("--gateway-env=")
-    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -6509,22 +10312,10 @@ _apictl_undeploy_api-product()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -6534,16 +10325,13 @@ _apictl_undeploy_api-product()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--rev=")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_undeploy_help()
+_apictl_update_help()
 {
-    last_command="apictl_undeploy_help"
+    last_command="apictl_update_help"
 
     command_aliases=()
 
@@ -6565,16 +10353,105 @@ _apictl_undeploy_help()
     noun_aliases=()
 }
 
-_apictl_undeploy()
+_apictl_update()
 {
-    last_command="apictl_undeploy"
+    last_command="apictl_update"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("app")
+    commands+=("doc")
+    commands+=("gateway-env")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_validate_help()
+{
+    last_command="apictl_validate_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_validate_policy()
+{
+    last_command="apictl_validate_policy"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_validate()
+{
+    last_command="apictl_validate"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
     commands+=("help")
+    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -6793,12 +10670,16 @@ _apictl_root_command()
     commands=()
     commands+=("add")
     commands+=("ai")
+    commands+=("apply")
     commands+=("aws")
     commands+=("bundle")
     commands+=("change-status")
+    commands+=("create")
     commands+=("delete")
+    commands+=("deploy")
     commands+=("export")
     commands+=("gen")
+    commands+=("generate")
     commands+=("get")
     commands+=("help")
     commands+=("import")
@@ -6808,10 +10689,27 @@ _apictl_root_command()
     commands+=("logout")
     commands+=("mg")
     commands+=("mi")
+    commands+=("migrate")
+    commands+=("mock")
+    commands+=("new-version")
+    commands+=("prune")
+    commands+=("pull")
+    commands+=("push")
+    commands+=("recycle-bin")
     commands+=("remove")
+    commands+=("render")
+    commands+=("restore")
+    commands+=("rollout")
+    commands+=("rotate")
+    commands+=("search")
     commands+=("secret")
     commands+=("set")
+    commands+=("sync")
+    commands+=("test")
     commands+=("undeploy")
+    commands+=("undo")
+    commands+=("update")
+    commands+=("validate")
     commands+=("vcs")
     commands+=("version")
 
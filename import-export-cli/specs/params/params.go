@@ -70,12 +70,41 @@ type ApiParams struct {
 	Deploy       APIVCSParams  `yaml:"deploy"`
 }
 
+// KeyManagerParams represents the environment specific values of a key manager
+type KeyManagerParams struct {
+	// Environments contains all environments in a configuration
+	Environments []Environment `yaml:"environments"`
+}
+
 type ApiProductParams struct {
 	Deploy ApiProductVCSParams `yaml:"deploy"`
 }
 
 type ApplicationParams struct {
-	Deploy ApplicationVCSParams `yaml:"deploy"`
+	// Environments contains the environment specific values of the application
+	Environments []ApplicationEnvironment `yaml:"environments"`
+	Deploy       ApplicationVCSParams     `yaml:"deploy"`
+}
+
+// ApplicationEnvironment represents the values of an application specific to an environment
+type ApplicationEnvironment struct {
+	Name    string                        `yaml:"name"`
+	Configs ApplicationEnvironmentConfigs `yaml:"configs"`
+}
+
+// ApplicationEnvironmentConfigs contains the configs of an application specific to an environment
+type ApplicationEnvironmentConfigs struct {
+	// Keys contains the values of the keys of the application
+	Keys []ApplicationKeyParams `yaml:"keys"`
+}
+
+// ApplicationKeyParams represents the values of the keys of an application. The values are applied to the keys
+// of the given key manager and key type, or to all the keys if they are not given.
+type ApplicationKeyParams struct {
+	KeyManager  string   `yaml:"keyManager,omitempty"`
+	KeyType     string   `yaml:"keyType,omitempty"`
+	CallbackURL string   `yaml:"callbackUrl,omitempty"`
+	GrantTypes  []string `yaml:"grantTypes,omitempty"`
 }
 
 // ------------------- Structs for VCS Import Params ----------------------------------
@@ -237,6 +266,24 @@ func LoadApplicationParamsFromFile(path string) (*ApplicationParams, error) {
 	return apiParams, err
 }
 
+// LoadKeyManagerParamsFromFile loads a key manager configuration YAML file located in path.
+//
+//	It returns an error or a valid KeyManagerParams
+func LoadKeyManagerParamsFromFile(path string) (*KeyManagerParams, error) {
+	fileContent, err := GetEnvSubstitutedFileContent(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyManagerParams := &KeyManagerParams{}
+	err = yaml.Unmarshal([]byte(fileContent), &keyManagerParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyManagerParams, err
+}
+
 // ExtractAPIEndpointConfig extracts API endpoint information from a slice of byte b
 func ExtractAPIEndpointConfig(b []byte) (string, error) {
 	apiConfig := &APIEndpointConfig{}
@@ -257,3 +304,23 @@ func (config ApiParams) GetEnv(key string) *Environment {
 	}
 	return nil
 }
+
+// GetEnv returns the ApplicationEnvironment associated for key in the ApplicationParams, if not found returns nil
+func (config ApplicationParams) GetEnv(key string) *ApplicationEnvironment {
+	for index, env := range config.Environments {
+		if env.Name == key {
+			return &config.Environments[index]
+		}
+	}
+	return nil
+}
+
+// GetEnv returns the Environment associated for key in the KeyManagerParams, if not found returns nil
+func (config KeyManagerParams) GetEnv(key string) *Environment {
+	for index, env := range config.Environments {
+		if env.Name == key {
+			return &config.Environments[index]
+		}
+	}
+	return nil
+}
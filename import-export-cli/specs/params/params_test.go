@@ -59,3 +59,18 @@ func TestAPIConfig_ContainsEnv(t *testing.T) {
 	assert.NotNil(t, configData.GetEnv("dev"), "Should contain correct environment")
 	assert.Nil(t, configData.GetEnv("prod"), "Should not contain undefined environment")
 }
+
+func TestApplicationParams_GetEnv(t *testing.T) {
+	configData, err := LoadApplicationParamsFromFile("testdata/app_params.yml")
+	assert.Nil(t, err, "Error should be nil for correct yaml loading")
+
+	env := configData.GetEnv("prod")
+	assert.NotNil(t, env, "Should contain correct environment")
+	assert.Equal(t, []ApplicationKeyParams{
+		{KeyType: "PRODUCTION", CallbackURL: "https://prod.foo.com/callback",
+			GrantTypes: []string{"client_credentials", "refresh_token"}},
+		{KeyManager: "okta", CallbackURL: "https://okta.foo.com/callback"},
+	}, env.Configs.Keys, "Should load the keys of the environment")
+	assert.True(t, configData.Deploy.Import.PreserveOwner, "Should load the import params")
+	assert.Nil(t, configData.GetEnv("dev"), "Should not contain undefined environment")
+}
@@ -23,10 +23,12 @@ import (
 	"crypto/cipher"
 	"crypto/md5"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"os"
 )
 
 // Returns md5 hash of a given string
@@ -36,6 +38,21 @@ func GetMD5Hash(text string) string {
 	return hex.EncodeToString(hasher.Sum(nil))
 }
 
+// Returns the hex encoded sha256 hash of the content of a given file
+func GetSHA256HashOfFile(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
 // Encrypt string to base64 crypto using AES
 func Encrypt(key []byte, text string) string {
 	// key := []byte(keyText)
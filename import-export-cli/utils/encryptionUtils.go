@@ -83,18 +83,8 @@ func IsValidKeyStoreConfig(config *KeyStoreConfig) bool {
 
 // EncryptSecrets encrypts the secrets using the keystore and write them to a file or console depending on the config map argument
 func EncryptSecrets(keyStoreConfig *KeyStoreConfig, secretConfig SecretConfig) error {
-	encryptionKey, err := getEncryptionKey(keyStoreConfig)
-	if err != nil {
-		return err
-	}
-	var encryptedSecrets map[string]string
-	plainTextSecrets := getPlainTextSecrets(secretConfig)
-
-	if IsPKCS1Encryption(secretConfig.Algorithm) {
-		encryptedSecrets, err = encrypt(encryptionKey, plainTextSecrets, encryptPKCS1v15)
-	} else {
-		encryptedSecrets, err = encrypt(encryptionKey, plainTextSecrets, encryptOAEP)
-	}
+	encryptedSecrets, err := EncryptSecretValues(keyStoreConfig, secretConfig.Algorithm,
+		getPlainTextSecrets(secretConfig))
 	if err != nil {
 		return err
 	}
@@ -108,6 +98,19 @@ func EncryptSecrets(keyStoreConfig *KeyStoreConfig, secretConfig SecretConfig) e
 	return nil
 }
 
+// EncryptSecretValues encrypts the values of the plain text secrets using the key of the keystore
+func EncryptSecretValues(keyStoreConfig *KeyStoreConfig, algorithm string,
+	plainTextSecrets map[string]string) (map[string]string, error) {
+	encryptionKey, err := getEncryptionKey(keyStoreConfig)
+	if err != nil {
+		return nil, err
+	}
+	if IsPKCS1Encryption(algorithm) {
+		return encrypt(encryptionKey, plainTextSecrets, encryptPKCS1v15)
+	}
+	return encrypt(encryptionKey, plainTextSecrets, encryptOAEP)
+}
+
 // WritePropertiesToFile write a map to a .properties file
 func WritePropertiesToFile(variables map[string]string, fileName string) {
 	props := properties.LoadMap(variables)
@@ -276,6 +276,19 @@ func GetDevPortalThrottlingPoliciesEndpointOfEnv(env, filePath string) string {
 	}
 }
 
+// Get KeyManagersEndpoint of the DevPortal of a given environment
+func GetDevPortalKeyManagersEndpointOfEnv(env, filePath string) string {
+	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
+	if !(envEndpoints.DevPortalEndpoint == "" || envEndpoints == nil) {
+		envEndpoints.DevPortalEndpoint = AppendSlashToString(envEndpoints.DevPortalEndpoint)
+		return envEndpoints.DevPortalEndpoint + defaultDevPortalKeyManagersEndpointSuffix
+	} else {
+		apiManagerEndpoint := GetApiManagerEndpointOfEnv(env, filePath)
+		apiManagerEndpoint = AppendSlashToString(apiManagerEndpoint)
+		return apiManagerEndpoint + defaultDevPortalKeyManagersEndpointSuffix
+	}
+}
+
 // Get TokenEndpoint of a given environment
 func GetTokenEndpointOfEnv(env, filePath string) string {
 	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
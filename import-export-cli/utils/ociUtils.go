@@ -0,0 +1,406 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// OCIReference represents a reference to an artifact in an OCI registry. eg: oci://localhost:5000/apis/petstore:1.0.0
+type OCIReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// OCIDescriptor describes the content of a blob in an OCI registry
+type OCIDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// OCIManifest represents an OCI image manifest which is used to store apictl projects as artifacts
+type OCIManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        OCIDescriptor     `json:"config"`
+	Layers        []OCIDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// OCIRegistryClient calls the OCI distribution API of a registry
+type OCIRegistryClient struct {
+	Reference *OCIReference
+	Username  string
+	Password  string
+	PlainHTTP bool
+	token     string
+	// tokenScope is the scope the token was issued for, such as repository:apis/petstore:pull
+	tokenScope string
+}
+
+var reOCIBearerParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// IsOCIReference checks whether the given path refers to an artifact in an OCI registry
+func IsOCIReference(path string) bool {
+	return strings.HasPrefix(path, OCIReferencePrefix)
+}
+
+// ParseOCIReference parses a reference of the form oci://registry/repository[:tag|@digest]. When neither a tag nor a
+// digest is given, the tag "latest" will be used.
+func ParseOCIReference(reference string) (*OCIReference, error) {
+	if !IsOCIReference(reference) {
+		return nil, fmt.Errorf("invalid OCI reference %s. The reference should start with %s", reference,
+			OCIReferencePrefix)
+	}
+	remainder := strings.TrimPrefix(reference, OCIReferencePrefix)
+	slashIndex := strings.Index(remainder, "/")
+	if slashIndex <= 0 || slashIndex == len(remainder)-1 {
+		return nil, fmt.Errorf("invalid OCI reference %s. The reference should be of the form "+
+			"%sregistry/repository:tag", reference, OCIReferencePrefix)
+	}
+	ref := &OCIReference{Registry: remainder[:slashIndex]}
+	repository := remainder[slashIndex+1:]
+
+	if atIndex := strings.Index(repository, "@"); atIndex >= 0 {
+		ref.Digest = repository[atIndex+1:]
+		repository = repository[:atIndex]
+		if !strings.HasPrefix(ref.Digest, OCIDigestAlgorithmPrefix) {
+			return nil, fmt.Errorf("unsupported digest %s in OCI reference %s", ref.Digest, reference)
+		}
+	} else if colonIndex := strings.LastIndex(repository, ":"); colonIndex > strings.LastIndex(repository, "/") {
+		ref.Tag = repository[colonIndex+1:]
+		repository = repository[:colonIndex]
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = OCIDefaultTag
+	}
+	if repository == "" || repository != strings.ToLower(repository) {
+		return nil, fmt.Errorf("invalid repository name in OCI reference %s. Repository names should be lower case",
+			reference)
+	}
+	ref.Repository = repository
+	return ref, nil
+}
+
+// String returns the reference in the form oci://registry/repository:tag or oci://registry/repository@digest
+func (ref *OCIReference) String() string {
+	if ref.Digest != "" {
+		return OCIReferencePrefix + ref.Registry + "/" + ref.Repository + "@" + ref.Digest
+	}
+	return OCIReferencePrefix + ref.Registry + "/" + ref.Repository + ":" + ref.Tag
+}
+
+// ManifestReference returns the digest of the reference if present, the tag otherwise
+func (ref *OCIReference) ManifestReference() string {
+	if ref.Digest != "" {
+		return ref.Digest
+	}
+	return ref.Tag
+}
+
+// GetOCIDigest returns the digest of the given content in the form sha256:<hex>
+func GetOCIDigest(content []byte) string {
+	hash := sha256.Sum256(content)
+	return OCIDigestAlgorithmPrefix + hex.EncodeToString(hash[:])
+}
+
+// NewOCIRegistryClient creates a client for the registry of the given reference. Plain HTTP is used for registries
+// running on the local host or when plainHTTP is set.
+func NewOCIRegistryClient(reference *OCIReference, username, password string, plainHTTP bool) *OCIRegistryClient {
+	host := strings.Split(reference.Registry, ":")[0]
+	if host == "localhost" || host == "127.0.0.1" {
+		plainHTTP = true
+	}
+	return &OCIRegistryClient{Reference: reference, Username: username, Password: password, PlainHTTP: plainHTTP}
+}
+
+// baseURL returns the base URL of the repository in the registry
+func (c *OCIRegistryClient) baseURL() string {
+	scheme := "https"
+	if c.PlainHTTP {
+		scheme = "http"
+	}
+	return scheme + "://" + c.Reference.Registry + "/v2/" + c.Reference.Repository
+}
+
+// newRequest creates a request with the TLS settings of apictl and the credentials of the registry
+func (c *OCIRegistryClient) newRequest() *resty.Request {
+	request := c.newUnauthenticatedRequest()
+	if c.token != "" {
+		request.SetHeader(HeaderAuthorization, HeaderValueAuthBearerPrefix+" "+c.token)
+	} else if c.Username != "" {
+		request.SetBasicAuth(c.Username, c.Password)
+	}
+	return request
+}
+
+// newUnauthenticatedRequest creates a request with the TLS settings of apictl
+func (c *OCIRegistryClient) newUnauthenticatedRequest() *resty.Request {
+	client := resty.New()
+
+	if Insecure {
+		client.SetTLSClientConfig(
+			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+				Renegotiation: TLSRenegotiationMode})
+	} else {
+		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
+	}
+
+	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	return client.R()
+}
+
+// execute executes the request built by the given function. If the registry asks for a bearer token, and there is no
+// token or the token was issued for another scope (eg: a pull token used to push a blob), a token is obtained from
+// the advertised token service and the request is retried once.
+func (c *OCIRegistryClient) execute(build func(request *resty.Request) (*resty.Response, error)) (*resty.Response,
+	error) {
+	resp, err := build(c.newRequest())
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		return resp, nil
+	}
+	challenge := resp.Header().Get("WWW-Authenticate")
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return resp, nil
+	}
+	params := parseOCIBearerChallenge(challenge)
+	if c.token != "" && params["scope"] == c.tokenScope {
+		// The token of the requested scope was rejected, hence another token would not be accepted either
+		return resp, nil
+	}
+	err = c.fetchBearerToken(params)
+	if err != nil {
+		return nil, err
+	}
+	return build(c.newRequest())
+}
+
+// parseOCIBearerChallenge returns the parameters (realm, service and scope) of a WWW-Authenticate bearer challenge
+func parseOCIBearerChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+	for _, match := range reOCIBearerParam.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	return params
+}
+
+// fetchBearerToken obtains a token from the token service given in the parameters of a WWW-Authenticate challenge.
+// The credentials of the registry are sent to the token service rather than the current token.
+func (c *OCIRegistryClient) fetchBearerToken(params map[string]string) error {
+	scope := params["scope"]
+	realm := params["realm"]
+	if realm == "" {
+		return errors.New("registry requested a bearer token without a token service")
+	}
+	queryParams := map[string]string{}
+	for key, value := range params {
+		if key != "realm" {
+			queryParams[key] = value
+		}
+	}
+	Logln(LogPrefixInfo+"Requesting a registry token from", realm, "for the scope", scope)
+
+	request := c.newUnauthenticatedRequest().SetQueryParams(queryParams)
+	if c.Username != "" {
+		request.SetBasicAuth(c.Username, c.Password)
+	}
+	resp, err := request.Get(realm)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return errors.New("authentication with the registry failed. Status: " + resp.Status())
+	}
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	err = json.Unmarshal(resp.Body(), &tokenResponse)
+	if err != nil {
+		return err
+	}
+	token := tokenResponse.Token
+	if token == "" {
+		token = tokenResponse.AccessToken
+	}
+	if token == "" {
+		return errors.New("token service of the registry did not return a token")
+	}
+	c.token = token
+	c.tokenScope = scope
+	return nil
+}
+
+// BlobExists checks whether a blob with the given digest is already in the repository
+func (c *OCIRegistryClient) BlobExists(digest string) (bool, error) {
+	resp, err := c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.Head(c.baseURL() + "/blobs/" + digest)
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.StatusCode() == http.StatusOK, nil
+}
+
+// PushBlob uploads the given content to the repository as a blob, unless it is already there. Returns the digest.
+func (c *OCIRegistryClient) PushBlob(content []byte) (string, error) {
+	digest := GetOCIDigest(content)
+	exists, err := c.BlobExists(digest)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		Logln(LogPrefixInfo+"Blob already exists in the registry:", digest)
+		return digest, nil
+	}
+
+	resp, err := c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.Post(c.baseURL() + "/blobs/uploads/")
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		return "", newOCIRegistryError("initiating the blob upload", resp)
+	}
+	uploadURL, err := c.resolveLocation(resp.Header().Get("Location"))
+	if err != nil {
+		return "", err
+	}
+	query := uploadURL.Query()
+	query.Set("digest", digest)
+	uploadURL.RawQuery = query.Encode()
+
+	resp, err = c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.SetHeader(HeaderContentType, "application/octet-stream").SetBody(content).
+			Put(uploadURL.String())
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", newOCIRegistryError("uploading the blob "+digest, resp)
+	}
+	return digest, nil
+}
+
+// PushManifest uploads the manifest and tags it with the tag of the reference. Returns the digest of the manifest.
+func (c *OCIRegistryClient) PushManifest(manifest *OCIManifest) (string, error) {
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.SetHeader(HeaderContentType, manifest.MediaType).SetBody(content).
+			Put(c.baseURL() + "/manifests/" + c.Reference.ManifestReference())
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", newOCIRegistryError("uploading the manifest", resp)
+	}
+	return GetOCIDigest(content), nil
+}
+
+// FetchManifest downloads the manifest of the reference
+func (c *OCIRegistryClient) FetchManifest() (*OCIManifest, error) {
+	resp, err := c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.SetHeader(HeaderAccept, OCIManifestMediaType).
+			Get(c.baseURL() + "/manifests/" + c.Reference.ManifestReference())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newOCIRegistryError("fetching the manifest of "+c.Reference.String(), resp)
+	}
+	if c.Reference.Digest != "" && GetOCIDigest(resp.Body()) != c.Reference.Digest {
+		return nil, errors.New("digest of the manifest does not match " + c.Reference.Digest)
+	}
+	manifest := &OCIManifest{}
+	err = json.Unmarshal(resp.Body(), manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// FetchBlob downloads the blob of the given descriptor and verifies its digest
+func (c *OCIRegistryClient) FetchBlob(descriptor OCIDescriptor) ([]byte, error) {
+	resp, err := c.execute(func(request *resty.Request) (*resty.Response, error) {
+		return request.Get(c.baseURL() + "/blobs/" + descriptor.Digest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newOCIRegistryError("fetching the blob "+descriptor.Digest, resp)
+	}
+	content := resp.Body()
+	if GetOCIDigest(content) != descriptor.Digest {
+		return nil, errors.New("digest of the downloaded content does not match " + descriptor.Digest)
+	}
+	return content, nil
+}
+
+// resolveLocation resolves a Location header returned by the registry, which can be relative to the registry
+func (c *OCIRegistryClient) resolveLocation(location string) (*url.URL, error) {
+	if location == "" {
+		return nil, errors.New("registry did not return an upload location")
+	}
+	base, err := url.Parse(c.baseURL())
+	if err != nil {
+		return nil, err
+	}
+	locationURL, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	return base.ResolveReference(locationURL), nil
+}
+
+// newOCIRegistryError creates an error from an unexpected response of the registry
+func newOCIRegistryError(action string, resp *resty.Response) error {
+	Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden {
+		return fmt.Errorf("authorization failed while %s. Status: %s", action, resp.Status())
+	}
+	return fmt.Errorf("registry responded with an error while %s. Status: %s", action, resp.Status())
+}
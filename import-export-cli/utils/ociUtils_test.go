@@ -0,0 +1,112 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	ref, err := ParseOCIReference("oci://localhost:5000/apis/petstore:1.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, "localhost:5000", ref.Registry)
+	assert.Equal(t, "apis/petstore", ref.Repository)
+	assert.Equal(t, "1.0.0", ref.Tag)
+	assert.Equal(t, "1.0.0", ref.ManifestReference())
+
+	ref, err = ParseOCIReference("oci://registry.example.com/apis/petstore")
+	assert.Nil(t, err)
+	assert.Equal(t, OCIDefaultTag, ref.Tag)
+
+	digest := "sha256:5b0bcabd1ed22e9fb1310cf6c2dec7cdef19f0ad69efa1f392e94a4333501270"
+	ref, err = ParseOCIReference("oci://registry.example.com/apis/petstore@" + digest)
+	assert.Nil(t, err)
+	assert.Equal(t, digest, ref.ManifestReference())
+	assert.Equal(t, "oci://registry.example.com/apis/petstore@"+digest, ref.String())
+}
+
+func TestParseInvalidOCIReference(t *testing.T) {
+	for _, reference := range []string{
+		"localhost:5000/apis/petstore:1.0.0",
+		"oci://localhost:5000",
+		"oci://localhost:5000/",
+		"oci://localhost:5000/apis/PetStore:1.0.0",
+		"oci://localhost:5000/apis/petstore@md5:abc",
+	} {
+		_, err := ParseOCIReference(reference)
+		assert.NotNil(t, err, "Should return an error for "+reference)
+	}
+}
+
+func TestNewOCIRegistryClientUsesPlainHTTPForLocalhost(t *testing.T) {
+	ref, _ := ParseOCIReference("oci://localhost:5000/apis/petstore:1.0.0")
+	assert.True(t, NewOCIRegistryClient(ref, "", "", false).PlainHTTP)
+	ref, _ = ParseOCIReference("oci://registry.example.com/apis/petstore:1.0.0")
+	assert.False(t, NewOCIRegistryClient(ref, "", "", false).PlainHTTP)
+}
+
+func TestOCIRegistryClientRefreshesTokenForPushScope(t *testing.T) {
+	var tokenScopes []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "alice" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			scope := r.URL.Query().Get("scope")
+			tokenScopes = append(tokenScopes, scope)
+			_, _ = w.Write([]byte(`{"token": "token-` + scope + `"}`))
+			return
+		}
+		scope := "repository:apis/petstore:pull"
+		if r.Method != http.MethodHead && r.Method != http.MethodGet {
+			scope = "repository:apis/petstore:pull,push"
+		}
+		if r.Header.Get(HeaderAuthorization) != HeaderValueAuthBearerPrefix+" token-"+scope {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="registry",scope="`+
+				scope+`"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost:
+			w.Header().Set("Location", r.URL.Path+"upload-1")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	ref, err := ParseOCIReference("oci://" + strings.TrimPrefix(server.URL, "http://") + "/apis/petstore:1.0.0")
+	assert.Nil(t, err)
+	client := NewOCIRegistryClient(ref, "alice", "secret", true)
+	_, err = client.PushBlob([]byte("content"))
+	assert.Nil(t, err, "Should obtain a push token after the pull token")
+	assert.Equal(t, []string{"repository:apis/petstore:pull", "repository:apis/petstore:pull,push"}, tokenScopes)
+}
@@ -50,6 +50,7 @@ type Config struct {
 	TLSRenegotiationMode  string `yaml:"tls-renegotiation-mode"`
 	AIThreadCount         int    `yaml:"ai_thread_count"`
 	AIToken               string `yaml:"ai_token"`
+	RecycleBinMaxSize     int    `yaml:"recycle_bin_max_size"`
 }
 
 type EnvKeys struct {
@@ -137,8 +138,13 @@ type TokenResponse struct {
 }
 
 type APIListResponse struct {
-	Count int32 `json:"count"`
-	List  []API `json:"list"`
+	Count      int32 `json:"count"`
+	List       []API `json:"list"`
+	Pagination struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Total  int `json:"total"`
+	} `json:"pagination"`
 }
 
 type APILoggerListResponse struct {
@@ -173,12 +179,15 @@ type HttpErrorResponse struct {
 // Key generation request
 type KeygenRequest struct {
 	KeyType                 string   `json:"keyType"`
+	KeyManager              string   `json:"keyManager,omitempty"`
 	GrantTypesToBeSupported []string `json:"grantTypesToBeSupported"`
+	CallbackURL             string   `json:"callbackUrl,omitempty"`
 	ValidityTime            int      `json:"validityTime"`
 }
 
 // Key generation response
 type KeygenResponse struct {
+	KeyMappingId        string      `json:"keyMappingId"`
 	CallbackURL         interface{} `json:"callbackUrl"`
 	ConsumerKey         string      `json:"consumerKey"`
 	ConsumerSecret      string      `json:"consumerSecret"`
@@ -203,6 +212,8 @@ type AppKeyList struct {
 type ConsumerSecretRegenResponse struct {
 	ConsumerKey    string `json:"consumerKey"`
 	ConsumerSecret string `json:"consumerSecret"`
+	// Only returned by servers which keep the previous secret valid for a grace period
+	PreviousSecretExpiresAt string `json:"previousSecretExpiresAt,omitempty"`
 }
 
 // Applications get response structure
@@ -254,12 +265,14 @@ type AppDetails struct {
 
 // Application key details
 type ApplicationKey struct {
+	KeyMappingId        string      `json:"keyMappingId,omitempty"`
 	ConsumerKey         string      `json:"consumerKey"`
 	ConsumerSecret      string      `json:"consumerSecret"`
 	SupportedGrantTypes []string    `json:"supportedGrantTypes"`
 	CallbackURL         interface{} `json:"callbackUrl"`
 	KeyState            string      `json:"keyState"`
 	KeyType             string      `json:"keyType"`
+	KeyManager          string      `json:"keyManager,omitempty"`
 }
 
 // Application creation request
@@ -353,6 +366,25 @@ type ThrottlingPolicy struct {
 	throttlingPolicyPermissions interface{}
 }
 
+// DocumentList is the list of the documents of an API or API Product
+type DocumentList struct {
+	Count int        `json:"count"`
+	List  []Document `json:"list"`
+}
+
+// Document is a document of an API or API Product
+type Document struct {
+	DocumentId    string `json:"documentId,omitempty"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Summary       string `json:"summary,omitempty"`
+	SourceType    string `json:"sourceType"`
+	SourceUrl     string `json:"sourceUrl,omitempty"`
+	FileName      string `json:"fileName,omitempty"`
+	OtherTypeName string `json:"otherTypeName,omitempty"`
+	Visibility    string `json:"visibility"`
+}
+
 type APIPoliciesList struct {
 	Count int         `json:"count"`
 	List  []APIPolicy `json:"list"`
@@ -409,6 +441,34 @@ type ApiSearch struct {
 	} `json:"pagination"`
 }
 
+// SearchResultList is a page of the results of the unified search of the publisher
+type SearchResultList struct {
+	Count      int            `json:"count"`
+	List       []SearchResult `json:"list"`
+	Pagination struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Total  int `json:"total"`
+	} `json:"pagination"`
+}
+
+// SearchResult is an API, API Product, document or API definition matching a unified search query
+type SearchResult struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Context        string `json:"context"`
+	Version        string `json:"version"`
+	Provider       string `json:"provider"`
+	Status         string `json:"status"`
+	AssociatedType string `json:"associatedType"`
+	APIName        string `json:"apiName"`
+	APIVersion     string `json:"apiVersion"`
+	APIProvider    string `json:"apiProvider"`
+	APIContext     string `json:"apiContext"`
+	APIUUID        string `json:"apiUUID"`
+}
+
 // get detailed API response
 type APIData struct {
 	ID                  string      `json:"id"`
@@ -420,6 +480,7 @@ type APIData struct {
 	LifeCycleStatus     string      `json:"lifeCycleStatus"`
 	HasThumbnail        interface{} `json:"hasThumbnail"`
 	Policies            []string    `json:"policies"`
+	SecurityScheme      []string    `json:"securityScheme"`
 	BusinessInformation struct {
 		BusinessOwner       string `json:"businessOwner"`
 		BusinessOwnerEmail  string `json:"businessOwnerEmail"`
@@ -479,8 +540,78 @@ type Revisions struct {
 	GatewayEnvs    []string
 }
 
+// Scope is a shared scope of the publisher
+type Scope struct {
+	ID          string   `json:"id,omitempty" yaml:"-"`
+	Name        string   `json:"name" yaml:"name"`
+	DisplayName string   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Bindings    []string `json:"bindings" yaml:"bindings"`
+	UsageCount  int      `json:"usageCount,omitempty" yaml:"-"`
+}
+
+type ScopeListResponse struct {
+	Count int32   `json:"count"`
+	List  []Scope `json:"list"`
+}
+
+// ScopeUsage holds the APIs a shared scope is used in
+type ScopeUsage struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	UsedAPIList []ScopeUsageAPIInfo `json:"usedApiList"`
+}
+
+type ScopeUsageAPIInfo struct {
+	Name     string `json:"name"`
+	Context  string `json:"context"`
+	Version  string `json:"version"`
+	Provider string `json:"provider"`
+}
+
+type KeyManagerListResponse struct {
+	Count int32            `json:"count"`
+	List  []KeyManagerInfo `json:"list"`
+}
+
+type KeyManagerInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type GatewayEnvironmentListResponse struct {
+	Count int32                `json:"count"`
+	List  []GatewayEnvironment `json:"list"`
+}
+
+type GatewayEnvironment struct {
+	ID          string         `json:"id,omitempty"`
+	Name        string         `json:"name"`
+	DisplayName string         `json:"displayName"`
+	Description string         `json:"description"`
+	Provider    string         `json:"provider,omitempty"`
+	Type        string         `json:"type,omitempty"`
+	GatewayType string         `json:"gatewayType,omitempty"`
+	IsReadOnly  bool           `json:"isReadOnly,omitempty"`
+	Vhosts      []GatewayVhost `json:"vhosts"`
+}
+
+type GatewayVhost struct {
+	Host        string `json:"host"`
+	HttpContext string `json:"httpContext,omitempty"`
+	HttpPort    int    `json:"httpPort,omitempty"`
+	HttpsPort   int    `json:"httpsPort,omitempty"`
+	WsPort      int    `json:"wsPort,omitempty"`
+	WssPort     int    `json:"wssPort,omitempty"`
+}
+
 type Deployment struct {
 	Name               string `json:"name"`
+	Vhost              string `json:"vhost,omitempty"`
 	DisplayOnDevportal bool   `json:"displayOnDevportal"`
 }
 
@@ -276,6 +276,26 @@ func InvokePATCHRequest(url string, headers map[string]string, body map[string]s
 	return client.R().SetHeaders(headers).SetBody(body).Patch(url)
 }
 
+// Invoke http request of the given method with query parameters and an optional body using go-resty
+func InvokeRequest(method, url string, headers, queryParams map[string]string, body string) (*resty.Response, error) {
+	client := resty.New()
+
+	if Insecure {
+		client.SetTLSClientConfig(
+			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+				Renegotiation: TLSRenegotiationMode})
+	} else {
+		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
+	}
+
+	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	request := client.R().SetHeaders(headers).SetQueryParams(queryParams)
+	if body != "" {
+		request.SetBody(body)
+	}
+	return request.Execute(method, url)
+}
+
 func PromptForUsername() string {
 	reader := bufio.NewReader(os.Stdin)
 
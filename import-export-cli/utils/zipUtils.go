@@ -20,24 +20,27 @@ package utils
 
 import (
 	"archive/zip"
+	"compress/flate"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
-// Zip will create an archive from source and store it in target
-func Zip(source, target string) error {
-	zipFile, err := os.Create(target)
-	if err != nil {
-		return err
-	}
-	defer zipFile.Close() // close the archive when exit
-
-	archive := zip.NewWriter(zipFile)
-	defer archive.Close()
+// zipEntry holds a file or directory to be added to an archive
+type zipEntry struct {
+	path string
+	info os.FileInfo
+}
 
+// Zip will create an archive from source and store it in target. The archive is reproducible: entries are added in
+// sorted order with normalised timestamps and permissions, and files matching the ignore patterns (defaults plus the
+// patterns in the .apictlignore file of the source) are excluded.
+func Zip(source, target string) error {
 	fileInfo, err := os.Stat(source)
 	if err != nil {
 		return err
@@ -45,28 +48,76 @@ func Zip(source, target string) error {
 
 	// Get base directory if this is a directory
 	var baseDir string
+	var ignorePatterns []string
 	if fileInfo.IsDir() {
 		baseDir = filepath.Base(source)
-	}
-
-	// Walk through the source path to generate an archive
-	// Walk accepts a WalkFn which has signature of func(path string, info os.FileInfo, err error) error
-	// Walk will return any error to err while walking
-	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		ignorePatterns, err = ReadIgnorePatterns(source)
 		if err != nil {
 			return err
 		}
+	}
+
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return err
+	}
 
-		// Create a partial zip header from current file or directory
-		header, err := zip.FileInfoHeader(info)
+	// Collect the entries first, so that the order of the entries does not depend on the file system
+	var entries []zipEntry
+	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if baseDir != "" && path != source {
+			relPath, err := filepath.Rel(source, path)
+			if err != nil {
+				return err
+			}
+			if IsIgnoredPath(filepath.ToSlash(relPath), info.IsDir(), ignorePatterns) {
+				Logln(LogPrefixInfo+"Skipping:", relPath)
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		// Skip the target archive if it is being created inside the source directory
+		if absPath, err := filepath.Abs(path); err == nil && absPath == absTarget {
+			return nil
+		}
+		entries = append(entries, zipEntry{path: path, info: info})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return filepath.ToSlash(entries[i].path) < filepath.ToSlash(entries[j].path)
+	})
 
+	zipFile, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer zipFile.Close() // close the archive when exit
+
+	archive := zip.NewWriter(zipFile)
+	defer archive.Close()
+	// Use a fixed compression level, so that the same content always produces the same bytes
+	archive.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+		return flate.NewWriter(out, flate.DefaultCompression)
+	})
+
+	for _, entry := range entries {
+		path, info := entry.path, entry.info
+		header := &zip.FileHeader{
+			Name:     filepath.Base(path),
+			Modified: ZipEntryModifiedTime,
+		}
 		// If baseDir is not empty it means we need to strip source from path, so we can get a relative filename from
 		// base.
 		if baseDir != "" {
-			// Replace system specific path seperator with forward slash '/' as the separator as required by the 
+			// Replace system specific path seperator with forward slash '/' as the separator as required by the
 			// ZIP spec (4.4.17.1) https://pkware.cachefly.net/webdocs/casestudies/APPNOTE.TXT
 			var resource string = filepath.Join(baseDir, strings.TrimPrefix(path, source))
 			header.Name = strings.ReplaceAll(resource, string(filepath.Separator), "/")
@@ -74,11 +125,14 @@ func Zip(source, target string) error {
 		if info.IsDir() {
 			// add directory to zip archive
 			header.Name += "/"
+			header.Method = zip.Store
+			header.SetMode(os.ModeDir | ZipEntryDirMode)
 		} else {
 			// add a file to zip archive using deflate algorithm
 			header.Method = zip.Deflate
+			header.SetMode(ZipEntryFileMode)
 		}
-		Logln(LogPrefixInfo+ "Creating:", header.Name)
+		Logln(LogPrefixInfo+"Creating:", header.Name)
 
 		// Create an archive writer
 		writer, err := archive.CreateHeader(header)
@@ -87,24 +141,76 @@ func Zip(source, target string) error {
 		}
 		// if this is a directory we don't copy, we only add header
 		if info.IsDir() {
-			return nil
+			continue
 		}
 
-		// open the file for reading
-		file, err := os.Open(path)
+		err = copyFileToWriter(path, writer)
 		if err != nil {
 			return err
 		}
-		defer file.Close()
+	}
+
+	return nil
+}
 
-		// copy contents of the file to the archive
-		_, err = io.Copy(writer, file)
+// copyFileToWriter copies the content of the file in the given path to the writer
+func copyFileToWriter(path string, writer io.Writer) error {
+	file, err := os.Open(path)
+	if err != nil {
 		return err
-	})
+	}
+	defer file.Close()
 
+	_, err = io.Copy(writer, file)
 	return err
 }
 
+// ReadIgnorePatterns returns the default ignore patterns together with the patterns defined in the .apictlignore file
+// of the given directory, if there is one. Empty lines and lines starting with '#' are skipped.
+func ReadIgnorePatterns(dir string) ([]string, error) {
+	patterns := append([]string{}, DefaultIgnorePatterns...)
+	ignoreFilePath := filepath.Join(dir, ApictlIgnoreFileName)
+	if !IsFileExist(ignoreFilePath) {
+		return patterns, nil
+	}
+	content, err := ioutil.ReadFile(ignoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns, nil
+}
+
+// IsIgnoredPath checks whether the given slash separated path (relative to the project root) matches any of the
+// ignore patterns. A pattern ending with '/' only matches directories. A pattern without a '/' is matched against
+// every path element, while a pattern with a '/' is matched against the path from the project root.
+func IsIgnoredPath(relPath string, isDir bool, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "/") {
+			if !isDir {
+				continue
+			}
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		if strings.Contains(pattern, "/") {
+			if matched, _ := path.Match(strings.TrimPrefix(pattern, "/"), relPath); matched {
+				return true
+			}
+			continue
+		}
+		if matched, _ := path.Match(pattern, path.Base(relPath)); matched {
+			return true
+		}
+	}
+	return false
+}
+
 // Unzip will decompress a zip archive, moving all files and folders
 // within the zip file (parameter 1) to an output directory (parameter 2).
 // returns a slice of extracted files with relative paths(dest is not appended)
@@ -19,9 +19,14 @@
 package utils
 
 import (
+	"archive/zip"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestZipDirError(t *testing.T) {
@@ -114,3 +119,91 @@ func TestZipDirOK(t *testing.T) {
 		t.Errorf("Error deleting directory: %s\n", err)
 	}
 }
+
+func createZipTestProject(t *testing.T, dir string) {
+	files := map[string]string{
+		"api.yaml":                 "type: api\n",
+		"Definitions/swagger.yaml": "openapi: 3.0.1\n",
+		"Docs/guide.md":            "# Guide\n",
+		"Docs/guide.md~":           "# Backup\n",
+		".git/HEAD":                "ref: refs/heads/main\n",
+		"tmp/scratch.txt":          "scratch\n",
+		ApictlIgnoreFileName:       "# local files\ntmp/\n",
+	}
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestZipIsReproducible(t *testing.T) {
+	projectDir := filepath.Join(t.TempDir(), "SampleAPI-1.0.0")
+	createZipTestProject(t, projectDir)
+
+	firstZip := filepath.Join(t.TempDir(), "first.zip")
+	if err := Zip(projectDir, firstZip); err != nil {
+		t.Fatalf("Error compressing directory: %s\n", err)
+	}
+
+	// Change the modification times and permissions, which should not affect the archive
+	later := time.Now().Add(time.Hour)
+	_ = os.Chtimes(filepath.Join(projectDir, "api.yaml"), later, later)
+	_ = os.Chmod(filepath.Join(projectDir, "Docs", "guide.md"), 0755)
+
+	secondZip := filepath.Join(t.TempDir(), "second.zip")
+	if err := Zip(projectDir, secondZip); err != nil {
+		t.Fatalf("Error compressing directory: %s\n", err)
+	}
+
+	firstHash, err := GetSHA256HashOfFile(firstZip)
+	assert.Nil(t, err)
+	secondHash, err := GetSHA256HashOfFile(secondZip)
+	assert.Nil(t, err)
+	assert.Equal(t, firstHash, secondHash, "Archives of the same content should be identical")
+}
+
+func TestZipExcludesIgnoredFiles(t *testing.T) {
+	projectDir := filepath.Join(t.TempDir(), "SampleAPI-1.0.0")
+	createZipTestProject(t, projectDir)
+
+	zipFile := filepath.Join(t.TempDir(), "project.zip")
+	if err := Zip(projectDir, zipFile); err != nil {
+		t.Fatalf("Error compressing directory: %s\n", err)
+	}
+
+	reader, err := zip.OpenReader(zipFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	var names []string
+	for _, f := range reader.File {
+		names = append(names, f.Name)
+		assert.Equal(t, ZipEntryModifiedTime.Unix(), f.Modified.Unix(), "Modified time should be normalised")
+	}
+	assert.Equal(t, []string{
+		"SampleAPI-1.0.0/",
+		"SampleAPI-1.0.0/Definitions/",
+		"SampleAPI-1.0.0/Definitions/swagger.yaml",
+		"SampleAPI-1.0.0/Docs/",
+		"SampleAPI-1.0.0/Docs/guide.md",
+		"SampleAPI-1.0.0/api.yaml",
+	}, names)
+}
+
+func TestIsIgnoredPath(t *testing.T) {
+	patterns := []string{".git/", "*.swp", "Docs/*.tmp", "/build"}
+	assert.True(t, IsIgnoredPath(".git", true, patterns))
+	assert.False(t, IsIgnoredPath(".git", false, patterns))
+	assert.True(t, IsIgnoredPath("Docs/.guide.md.swp", false, patterns))
+	assert.True(t, IsIgnoredPath("Docs/notes.tmp", false, patterns))
+	assert.False(t, IsIgnoredPath("notes.tmp", false, patterns))
+	assert.True(t, IsIgnoredPath("build", true, patterns))
+	assert.False(t, IsIgnoredPath("Docs/build", true, patterns))
+}